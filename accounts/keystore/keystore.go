@@ -232,7 +232,7 @@ func (ks *KeyStore) SignTx(a accounts.Account, tx *types.Transaction, chainID *b
 	}
 	// Depending on the presence of the chain ID, sign with EIP155 or homestead
 	if chainID != nil {
-		return types.SignTx(tx, types.NewEIP155Signer(chainID), unlockedKey.PrivateKey)
+		return types.SignTx(tx, types.NewLondonSigner(chainID), unlockedKey.PrivateKey)
 	}
 	return types.SignTx(tx, types.HomesteadSigner{}, unlockedKey.PrivateKey)
 }
@@ -249,7 +249,7 @@ func (ks *KeyStore) SignEthTx(a accounts.Account, tx *types.EthTransaction, chai
 	}
 	// Depending on the presence of the chain ID, sign with EIP155 or homestead
 	if chainID != nil {
-		return types.SignEthTx(tx, types.NewEIP155Signer(chainID), unlockedKey.PrivateKey)
+		return types.SignEthTx(tx, types.NewLondonSigner(chainID), unlockedKey.PrivateKey)
 	}
 	return types.SignEthTx(tx, types.HomesteadSigner{}, unlockedKey.PrivateKey)
 }
@@ -291,7 +291,7 @@ func (ks *KeyStore) SignTxWithPassphrase(a accounts.Account, passphrase string,
 
 	// Depending on the presence of the chain ID, sign with EIP155 or homestead
 	if chainID != nil {
-		return types.SignTx(tx, types.NewEIP155Signer(chainID), key.PrivateKey)
+		return types.SignTx(tx, types.NewLondonSigner(chainID), key.PrivateKey)
 	}
 	return types.SignTx(tx, types.HomesteadSigner{}, key.PrivateKey)
 }
@@ -307,7 +307,7 @@ func (ks *KeyStore) SignEthTxWithPassphrase(a accounts.Account, passphrase strin
 
 	// Depending on the presence of the chain ID, sign with EIP155 or homestead
 	if chainID != nil {
-		return types.SignEthTx(tx, types.NewEIP155Signer(chainID), key.PrivateKey)
+		return types.SignEthTx(tx, types.NewLondonSigner(chainID), key.PrivateKey)
 	}
 	return types.SignEthTx(tx, types.HomesteadSigner{}, key.PrivateKey)
 }
@@ -0,0 +1,281 @@
+package cx_receipts_pulling
+
+import (
+	"context"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/harmony-one/harmony/core"
+	"github.com/harmony-one/harmony/core/rawdb"
+	"github.com/harmony-one/harmony/core/types"
+	nodeconfig "github.com/harmony-one/harmony/internal/configs/node"
+	"github.com/harmony-one/harmony/internal/utils"
+	"github.com/harmony-one/harmony/p2p"
+	syncproto "github.com/harmony-one/harmony/p2p/stream/protocols/sync"
+	sttypes "github.com/harmony-one/harmony/p2p/stream/types"
+	"github.com/harmony-one/harmony/shard"
+	"github.com/pkg/errors"
+	"github.com/rs/zerolog"
+)
+
+const (
+	// pullInterval is the interval between two rounds of pulling
+	pullInterval = 20 * time.Second
+	// requestTimeout is the timeout of a single GetCXReceiptsProof request
+	requestTimeout = 10 * time.Second
+	// maxRequestsPerShard is the max number of requests to a source shard in one round
+	maxRequestsPerShard = 10
+	// lookbackBlocks is the number of source shard blocks before the last crosslink
+	// to pull at the first round
+	lookbackBlocks = 256
+
+	// stream manager caps of the client only protocols to the source shards
+	smSoftLowCap = 4
+	smHardLowCap = 2
+	smHiCap      = 8
+	smDiscBatch  = 4
+)
+
+type crosslinkReader interface {
+	ReadShardLastCrossLink(shardID uint32) (*types.CrossLink, error)
+	ReadCrossLink(shardID uint32, blockNum uint64) (*types.CrossLink, error)
+}
+
+type receiptsChain interface {
+	ShardID() uint32
+	IsSpent(cxp *types.CXReceiptsProof) bool
+}
+
+type receiptsAdder interface {
+	AddPendingReceipts(receipts *types.CXReceiptsProof)
+}
+
+type proofsProtocol interface {
+	GetCXReceiptsProofs(ctx context.Context, fromShardID, toShardID uint32, bns []uint64, opts ...syncproto.Option) ([]*types.CXReceiptsProof, sttypes.StreamID, error)
+	NumStreamsWithCXReceiptsProof() int
+	StreamFailed(stID sttypes.StreamID, reason string)
+	StreamInvalidResponse(stID sttypes.StreamID, reason string)
+}
+
+// Service pulls the cross shard receipts sent to the local shard from the nodes
+// of the source shards over the sync stream protocol. The proofs of the source
+// shard blocks with a crosslink are verified against the crosslink, and added to
+// the pending receipts to be included by the next proposed block. The receipts
+// already delivered by the pubsub messages are spent or pending, so the pulling
+// only recovers the messages missed by the shard.
+type Service struct {
+	node       receiptsAdder
+	bc         receiptsChain
+	crosslinks crosslinkReader
+	verify     func(cxp *types.CXReceiptsProof) error
+	protocols  map[uint32]proofsProtocol
+	cursors    map[uint32]uint64 // last pulled block number of each source shard
+
+	ctx      context.Context
+	cancel   func()
+	closeCh  chan struct{}
+	stopOnce sync.Once
+	logger   zerolog.Logger
+}
+
+// New creates the service pulling the cross shard receipts of bc. The crosslinks
+// are read from the beacon chain. A client only sync protocol is added to the host
+// for each source shard.
+func New(host p2p.Host, node receiptsAdder, bc core.BlockChain, beacon crosslinkReader, network nodeconfig.NetworkType) *Service {
+	protocols := make(map[uint32]proofsProtocol)
+	numShards := shard.Schedule.InstanceForEpoch(bc.CurrentHeader().Epoch()).NumShards()
+	for shardID := uint32(0); shardID < numShards; shardID++ {
+		if shardID == bc.ShardID() {
+			continue
+		}
+		sp := syncproto.NewProtocol(syncproto.Config{
+			Host:         host.GetP2PHost(),
+			Discovery:    host.GetDiscovery(),
+			ShardID:      nodeconfig.ShardID(shardID),
+			Network:      network,
+			SmSoftLowCap: smSoftLowCap,
+			SmHardLowCap: smHardLowCap,
+			SmHiCap:      smHiCap,
+			DiscBatch:    smDiscBatch,
+		})
+		host.AddStreamProtocol(sp)
+		protocols[shardID] = sp
+	}
+	return newService(node, bc, beacon, core.NewBlockValidator(bc).ValidateCXReceiptsProof, protocols)
+}
+
+func newService(node receiptsAdder, bc receiptsChain, crosslinks crosslinkReader,
+	verify func(cxp *types.CXReceiptsProof) error, protocols map[uint32]proofsProtocol) *Service {
+
+	ctx, cancel := context.WithCancel(context.Background())
+	return &Service{
+		node:       node,
+		bc:         bc,
+		crosslinks: crosslinks,
+		verify:     verify,
+		protocols:  protocols,
+		cursors:    make(map[uint32]uint64),
+		ctx:        ctx,
+		cancel:     cancel,
+		closeCh:    make(chan struct{}),
+		logger: utils.Logger().With().
+			Str("module", "cx receipts pulling").
+			Uint32("ShardID", bc.ShardID()).Logger(),
+	}
+}
+
+// Start starts service.
+func (s *Service) Start() error {
+	go s.run()
+	return nil
+}
+
+func (s *Service) run() {
+	ticker := time.NewTicker(pullInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			s.pull()
+		case <-s.closeCh:
+			return
+		}
+	}
+}
+
+// Stop stops service.
+func (s *Service) Stop() error {
+	s.stopOnce.Do(func() {
+		s.cancel()
+		close(s.closeCh)
+	})
+	return nil
+}
+
+func (s *Service) pull() {
+	for shardID, sp := range s.protocols {
+		if sp.NumStreamsWithCXReceiptsProof() == 0 {
+			continue
+		}
+		if err := s.pullShard(shardID, sp); err != nil {
+			s.logger.Warn().Err(err).Uint32("fromShardID", shardID).
+				Msg("pull cx receipts proofs failed")
+		}
+	}
+}
+
+// pullShard pulls the proofs of the source shard blocks after the cursor up to
+// the last crosslink of the shard.
+func (s *Service) pullShard(fromShardID uint32, sp proofsProtocol) error {
+	last, err := s.crosslinks.ReadShardLastCrossLink(fromShardID)
+	if err != nil || last == nil {
+		// no crosslink of the shard seen yet
+		return nil
+	}
+	head := last.BlockNum()
+	cursor, ok := s.cursors[fromShardID]
+	if !ok && head > lookbackBlocks {
+		cursor = head - lookbackBlocks
+	}
+	for i := 0; i < maxRequestsPerShard && cursor < head; i++ {
+		bns, hashes := s.nextBlocks(fromShardID, cursor, head)
+		if len(bns) == 0 {
+			break
+		}
+		n, err := s.pullBlocks(fromShardID, sp, bns, hashes)
+		cursor += uint64(n)
+		s.cursors[fromShardID] = cursor
+		if err != nil {
+			return err
+		}
+		if n < len(bns) {
+			break
+		}
+	}
+	return nil
+}
+
+// nextBlocks returns the numbers and crosslink hashes of the next source shard
+// blocks to pull, which stop at the first block without a crosslink.
+func (s *Service) nextBlocks(fromShardID uint32, cursor, head uint64) ([]uint64, []common.Hash) {
+	bns := make([]uint64, 0, syncproto.GetCXReceiptsProofCap)
+	hashes := make([]common.Hash, 0, syncproto.GetCXReceiptsProofCap)
+	for bn := cursor + 1; bn <= head && len(bns) < syncproto.GetCXReceiptsProofCap; bn++ {
+		cl, err := s.crosslinks.ReadCrossLink(fromShardID, bn)
+		if err != nil || cl == nil {
+			break
+		}
+		bns = append(bns, bn)
+		hashes = append(hashes, cl.Hash())
+	}
+	return bns, hashes
+}
+
+// pullBlocks requests the proofs of the given blocks, and returns the number of
+// blocks handled.
+func (s *Service) pullBlocks(fromShardID uint32, sp proofsProtocol, bns []uint64, hashes []common.Hash) (int, error) {
+	ctx, cancel := context.WithTimeout(s.ctx, requestTimeout)
+	defer cancel()
+
+	proofs, stid, err := sp.GetCXReceiptsProofs(ctx, fromShardID, s.bc.ShardID(), bns)
+	if err != nil {
+		if stid != "" {
+			sp.StreamFailed(stid, "getCXReceiptsProof request failed")
+		}
+		return 0, errors.Wrap(err, "[GetCXReceiptsProofs]")
+	}
+	for i, proof := range proofs {
+		if proof == nil {
+			// no receipts to the local shard
+			continue
+		}
+		if err := s.verifyProof(proof, hashes[i]); err != nil {
+			if errors.Is(err, errUnknownCommittee) {
+				// stop at the proof, which is pulled again in the next round
+				s.logger.Debug().Uint32("fromShardID", fromShardID).
+					Uint64("blockNum", bns[i]).
+					Msg("committee of cx receipts proof not known yet")
+				return i, nil
+			}
+			sp.StreamInvalidResponse(stid, err.Error())
+			return i, errors.Wrapf(err, "invalid proof of block %v", bns[i])
+		}
+		if s.bc.IsSpent(proof) {
+			continue
+		}
+		s.logger.Info().Uint32("fromShardID", fromShardID).
+			Uint64("blockNum", bns[i]).
+			Int("receipts", len(proof.Receipts)).
+			Msg("pulled missing cx receipts proof")
+		s.node.AddPendingReceipts(proof)
+	}
+	return len(proofs), nil
+}
+
+// errUnknownCommittee is the error of a proof whose source shard committee is
+// not known by the local node yet.
+var errUnknownCommittee = errors.New("committee of the proof not known yet")
+
+// verifyProof checks the proof is of the block with the crosslink, and has valid
+// receipts to the local shard.
+func (s *Service) verifyProof(proof *types.CXReceiptsProof, hash common.Hash) error {
+	if proof.Header.Hash() != hash {
+		return errors.New("proof header does not match the crosslink")
+	}
+	toShardID, err := proof.GetToShardID()
+	if err != nil {
+		return err
+	}
+	if toShardID != s.bc.ShardID() {
+		return errors.Errorf("proof of receipts to shard %v", toShardID)
+	}
+	if err := s.verify(proof); err != nil {
+		if strings.Contains(err.Error(), rawdb.MsgNoShardStateFromDB) {
+			return errUnknownCommittee
+		}
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,245 @@
+package cx_receipts_pulling
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/harmony-one/harmony/block"
+	blockfactory "github.com/harmony-one/harmony/block/factory"
+	"github.com/harmony-one/harmony/core/rawdb"
+	"github.com/harmony-one/harmony/core/types"
+	syncproto "github.com/harmony-one/harmony/p2p/stream/protocols/sync"
+	sttypes "github.com/harmony-one/harmony/p2p/stream/types"
+)
+
+const (
+	testFromShardID uint32 = 1
+	testToShardID   uint32 = 0
+)
+
+func TestService_PullShard(t *testing.T) {
+	tests := []struct {
+		name       string
+		head       uint64
+		invalid    map[uint64]bool // blocks served with an invalid proof
+		spent      map[uint64]bool
+		expCursor  uint64
+		expAdded   []uint64 // the first added proofs
+		expInvalid int
+	}{
+		{
+			name:      "pull to the last crosslink",
+			head:      25,
+			expCursor: 25,
+			expAdded:  []uint64{2, 4, 6, 8, 10, 12, 14, 16, 18, 20, 22, 24},
+		},
+		{
+			name:      "spent proofs skipped",
+			head:      8,
+			spent:     map[uint64]bool{2: true, 6: true},
+			expCursor: 8,
+			expAdded:  []uint64{4, 8},
+		},
+		{
+			name:       "stop at invalid proof",
+			head:       8,
+			invalid:    map[uint64]bool{6: true},
+			expCursor:  5,
+			expAdded:   []uint64{2, 4},
+			expInvalid: 1,
+		},
+		{
+			name:      "lookback from the last crosslink",
+			head:      lookbackBlocks + 4,
+			expCursor: 4 + maxRequestsPerShard*syncproto.GetCXReceiptsProofCap,
+			expAdded:  []uint64{6, 8},
+		},
+	}
+	for _, test := range tests {
+		var added []uint64
+		node := &testReceiptsAdder{added: &added}
+		bc := &testReceiptsChain{spent: test.spent}
+		crosslinks := newTestCrosslinkReader(test.head)
+		sp := &testProofsProtocol{headers: crosslinks.headers, invalid: test.invalid}
+		s := newService(node, bc, crosslinks, func(*types.CXReceiptsProof) error { return nil },
+			map[uint32]proofsProtocol{testFromShardID: sp})
+
+		if err := s.pullShard(testFromShardID, sp); err != nil && test.expInvalid == 0 {
+			t.Errorf("Test %v: unexpected error: %v", test.name, err)
+		}
+		if cursor := s.cursors[testFromShardID]; cursor != test.expCursor {
+			t.Errorf("Test %v: unexpected cursor: %v / %v", test.name, cursor, test.expCursor)
+		}
+		if len(added) < len(test.expAdded) || !equalNumbers(added[:len(test.expAdded)], test.expAdded) {
+			t.Errorf("Test %v: unexpected added proofs: %v / %v", test.name, added, test.expAdded)
+		}
+		if sp.numInvalid != test.expInvalid {
+			t.Errorf("Test %v: unexpected invalid responses: %v / %v", test.name, sp.numInvalid, test.expInvalid)
+		}
+	}
+}
+
+func TestService_PullShard_NoCrosslink(t *testing.T) {
+	var added []uint64
+	sp := &testProofsProtocol{}
+	s := newService(&testReceiptsAdder{added: &added}, &testReceiptsChain{}, &testCrosslinkReader{},
+		func(*types.CXReceiptsProof) error { return nil }, map[uint32]proofsProtocol{testFromShardID: sp})
+
+	if err := s.pullShard(testFromShardID, sp); err != nil {
+		t.Fatal(err)
+	}
+	if sp.numRequests != 0 || len(added) != 0 {
+		t.Errorf("unexpected pulling without crosslink: %v requests, %v proofs", sp.numRequests, len(added))
+	}
+}
+
+func TestService_PullShard_UnknownCommittee(t *testing.T) {
+	var added []uint64
+	crosslinks := newTestCrosslinkReader(8)
+	sp := &testProofsProtocol{headers: crosslinks.headers}
+	verify := func(cxp *types.CXReceiptsProof) error {
+		if cxp.Header.Number().Uint64() == 6 {
+			return errors.New(rawdb.MsgNoShardStateFromDB)
+		}
+		return nil
+	}
+	s := newService(&testReceiptsAdder{added: &added}, &testReceiptsChain{}, crosslinks, verify,
+		map[uint32]proofsProtocol{testFromShardID: sp})
+
+	if err := s.pullShard(testFromShardID, sp); err != nil {
+		t.Fatal(err)
+	}
+	if cursor := s.cursors[testFromShardID]; cursor != 5 {
+		t.Errorf("unexpected cursor: %v / %v", cursor, 5)
+	}
+	if !equalNumbers(added, []uint64{2, 4}) {
+		t.Errorf("unexpected added proofs: %v", added)
+	}
+	if sp.numInvalid != 0 {
+		t.Errorf("unexpected invalid responses: %v", sp.numInvalid)
+	}
+}
+
+func TestService_StopTwice(t *testing.T) {
+	s := newService(&testReceiptsAdder{}, &testReceiptsChain{}, &testCrosslinkReader{},
+		func(*types.CXReceiptsProof) error { return nil }, map[uint32]proofsProtocol{})
+
+	if err := s.Start(); err != nil {
+		t.Fatal(err)
+	}
+	if err := s.Stop(); err != nil {
+		t.Fatal(err)
+	}
+	if err := s.Stop(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+type testReceiptsAdder struct {
+	added *[]uint64
+}
+
+func (adder *testReceiptsAdder) AddPendingReceipts(receipts *types.CXReceiptsProof) {
+	*adder.added = append(*adder.added, receipts.Header.Number().Uint64())
+}
+
+type testReceiptsChain struct {
+	spent map[uint64]bool
+}
+
+func (bc *testReceiptsChain) ShardID() uint32 { return testToShardID }
+
+func (bc *testReceiptsChain) IsSpent(cxp *types.CXReceiptsProof) bool {
+	return bc.spent[cxp.Header.Number().Uint64()]
+}
+
+type testCrosslinkReader struct {
+	headers map[uint64]*block.Header
+	head    uint64
+}
+
+func newTestCrosslinkReader(head uint64) *testCrosslinkReader {
+	headers := make(map[uint64]*block.Header)
+	for bn := uint64(1); bn <= head; bn++ {
+		headers[bn] = blockfactory.NewTestHeader().With().
+			ShardID(testFromShardID).
+			Number(new(big.Int).SetUint64(bn)).
+			Header()
+	}
+	return &testCrosslinkReader{headers: headers, head: head}
+}
+
+func (cr *testCrosslinkReader) ReadShardLastCrossLink(shardID uint32) (*types.CrossLink, error) {
+	return cr.ReadCrossLink(shardID, cr.head)
+}
+
+func (cr *testCrosslinkReader) ReadCrossLink(shardID uint32, blockNum uint64) (*types.CrossLink, error) {
+	header, ok := cr.headers[blockNum]
+	if !ok || shardID != testFromShardID {
+		return nil, errors.New("crosslink not found")
+	}
+	return &types.CrossLink{
+		HashF:        header.Hash(),
+		BlockNumberF: header.Number(),
+		ShardIDF:     shardID,
+	}, nil
+}
+
+// testProofsProtocol serves a proof of each even block, and no receipts of
+// the odd blocks
+type testProofsProtocol struct {
+	headers     map[uint64]*block.Header
+	invalid     map[uint64]bool
+	numRequests int
+	numInvalid  int
+}
+
+func (sp *testProofsProtocol) GetCXReceiptsProofs(ctx context.Context, fromShardID, toShardID uint32, bns []uint64, opts ...syncproto.Option) ([]*types.CXReceiptsProof, sttypes.StreamID, error) {
+	sp.numRequests++
+	proofs := make([]*types.CXReceiptsProof, 0, len(bns))
+	for _, bn := range bns {
+		if bn%2 == 1 {
+			proofs = append(proofs, nil)
+			continue
+		}
+		header := sp.headers[bn]
+		if sp.invalid[bn] {
+			header = blockfactory.NewTestHeader().With().
+				ShardID(fromShardID).
+				Number(new(big.Int).SetUint64(bn)).
+				Epoch(big.NewInt(1)).
+				Header()
+		}
+		proofs = append(proofs, &types.CXReceiptsProof{
+			Receipts: types.CXReceipts{
+				{ShardID: fromShardID, ToShardID: toShardID, Amount: big.NewInt(1)},
+			},
+			MerkleProof: &types.CXMerkleProof{},
+			Header:      header,
+			CommitSig:   []byte{1},
+		})
+	}
+	return proofs, "test stream", nil
+}
+
+func (sp *testProofsProtocol) NumStreamsWithCXReceiptsProof() int { return 1 }
+
+func (sp *testProofsProtocol) StreamFailed(stID sttypes.StreamID, reason string) {}
+
+func (sp *testProofsProtocol) StreamInvalidResponse(stID sttypes.StreamID, reason string) {
+	sp.numInvalid++
+}
+
+func equalNumbers(a, b []uint64) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
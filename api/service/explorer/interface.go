@@ -6,6 +6,7 @@ import (
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/ethdb"
 	"github.com/ethereum/go-ethereum/ethdb/leveldb"
+	"github.com/harmony-one/harmony/core/types"
 	tikvCommon "github.com/harmony-one/harmony/internal/tikv/common"
 	"github.com/harmony-one/harmony/internal/tikv/prefix"
 	"github.com/harmony-one/harmony/internal/tikv/remote"
@@ -26,6 +27,7 @@ type databaseReader interface {
 	Get(key []byte) ([]byte, error)
 	Has(key []byte) (bool, error)
 	NewPrefixIterator(prefix []byte) iterator
+	NewPrefixIteratorFrom(prefix, start []byte) iterator
 	NewSizedIterator(start []byte, size int) iterator
 }
 
@@ -84,6 +86,12 @@ func (db *explorerDB) NewPrefixIterator(prefix []byte) iterator {
 	return it
 }
 
+// NewPrefixIteratorFrom iterates the keys at the prefix starting from the
+// key of the prefix followed by start
+func (db *explorerDB) NewPrefixIteratorFrom(prefix, start []byte) iterator {
+	return db.db.NewIterator(prefix, start)
+}
+
 func (db *explorerDB) NewSizedIterator(start []byte, size int) iterator {
 	return db.newSizedIterator(start, size)
 }
@@ -129,3 +137,11 @@ type iterator interface {
 type blockChainTxIndexer interface {
 	ReadTxLookupEntry(txID common.Hash) (common.Hash, uint64, uint64)
 }
+
+// blockChainTokenIndexer is the interface to read the blocks and receipts
+// needed by token transfer indexing. Implemented by core.BlockChain
+type blockChainTokenIndexer interface {
+	blockChainTxIndexer
+	GetBlockByNumber(number uint64) *types.Block
+	GetReceiptsByHash(hash common.Hash) types.Receipts
+}
@@ -146,16 +146,21 @@ func (db *memDB) NewBatch() batch {
 }
 
 func (db *memDB) NewPrefixIterator(prefix []byte) iterator {
+	return db.NewPrefixIteratorFrom(prefix, nil)
+}
+
+func (db *memDB) NewPrefixIteratorFrom(prefix, start []byte) iterator {
 	db.lock.Lock()
 	defer db.lock.Unlock()
 
 	var (
 		pr     = hex.EncodeToString(prefix)
+		st     = pr + hex.EncodeToString(start)
 		keys   = make([]string, 0, len(db.keyValues))
 		values = make([][]byte, 0, len(db.keyValues))
 	)
 	for key := range db.keyValues {
-		if strings.HasPrefix(key, pr) {
+		if strings.HasPrefix(key, pr) && key >= st {
 			keys = append(keys, key)
 		}
 	}
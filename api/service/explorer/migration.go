@@ -8,7 +8,10 @@ import (
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/rlp"
 	"github.com/harmony-one/abool"
+	"github.com/harmony-one/harmony/core/types"
+	"github.com/harmony-one/harmony/hmy/tracers"
 	"github.com/harmony-one/harmony/internal/utils"
+	goversion "github.com/hashicorp/go-version"
 	"github.com/pkg/errors"
 	"github.com/rs/zerolog"
 )
@@ -309,3 +312,156 @@ func (m *migrationV100) checkMigratedTx(legTx *LegTxRecord) error {
 	}
 	return nil
 }
+
+// migrateToV120 builds the indexes introduced since 1.1.0 in one pass over the
+// blocks: the token transfers of the blocks processed before 1.1.0, and the
+// internal transactions of the call traces stored before 1.2.0.
+func (s *storage) migrateToV120() error {
+	isV110, err := isVersionV110(s.db)
+	if err != nil {
+		return err
+	}
+	var migrates []func(btc batch, b *types.Block) error
+	if !isV110 {
+		migrates = append(migrates, s.migrateTokenTransfers)
+	}
+	migrates = append(migrates, s.migrateInternalTxs)
+	return s.newBlockMigration(versionV120, migrates...).do()
+}
+
+// migrateTokenTransfers indexes the token transfers of the block
+func (s *storage) migrateTokenTransfers(btc batch, b *types.Block) error {
+	if len(b.Transactions()) != 0 {
+		computeTokenTransfers(btc, b, s.bc.GetReceiptsByHash(b.Hash()))
+	}
+	return nil
+}
+
+// migrateInternalTxs indexes the internal transactions of the block trace
+func (s *storage) migrateInternalTxs(btc batch, b *types.Block) error {
+	// traces are only stored by nodes with tracing enabled
+	exist, err := isTraceResultInDB(s.db, b.Hash().Bytes())
+	if !exist || err != nil {
+		return err
+	}
+	data := &tracers.TraceBlockStorage{Hash: b.Hash()}
+	err = data.FromDB(func(key []byte) ([]byte, error) {
+		return getTraceResult(s.db, key)
+	})
+	if err != nil {
+		return errors.Wrapf(err, "read trace of block %v", b.NumberU64())
+	}
+	return computeInternalTxs(btc, data)
+}
+
+// blockMigration re-processes every block of the checkpoint bitmap to build
+// the indexes introduced by the given version
+type blockMigration struct {
+	db       database
+	bc       blockChainTokenIndexer
+	btc      batch
+	version  *goversion.Version
+	migrates []func(btc batch, b *types.Block) error
+
+	// progress
+	migratedNum uint64
+	totalNum    uint64
+
+	log       zerolog.Logger
+	finishedC chan struct{}
+	closeC    chan struct{}
+}
+
+func (s *storage) newBlockMigration(version *goversion.Version, migrates ...func(btc batch, b *types.Block) error) *blockMigration {
+	return &blockMigration{
+		db:       s.db,
+		bc:       s.bc,
+		btc:      s.db.NewBatch(),
+		version:  version,
+		migrates: migrates,
+		log: utils.Logger().With().
+			Str("module", "explorer DB migration to "+version.String()).Logger(),
+		finishedC: make(chan struct{}),
+		closeC:    s.closeC,
+	}
+}
+
+func (m *blockMigration) do() error {
+	bitmap, err := readCheckpointBitmap(m.db)
+	if err != nil {
+		return errors.Wrap(err, "failed to read checkpoint bitmap")
+	}
+	m.totalNum = bitmap.GetCardinality()
+
+	go m.progressReportLoop()
+	defer close(m.finishedC)
+
+	m.log.Info().Str("progress", fmt.Sprintf("%v / %v", 0, m.totalNum)).
+		Msg("Start migration")
+	it := bitmap.Iterator()
+	for it.HasNext() {
+		select {
+		case <-m.closeC:
+			// Indexing is idempotent, the migration starts over on restart
+			if err := m.btc.Write(); err != nil {
+				return err
+			}
+			return errInterrupted
+		default:
+		}
+		if err := m.migrateBlock(it.Next()); err != nil {
+			return err
+		}
+		atomic.AddUint64(&m.migratedNum, 1)
+	}
+	if err := m.btc.Write(); err != nil {
+		return errors.Wrapf(err, "failed to migrate to V%v", m.version)
+	}
+	m.log.Info().Msg("Finished migration. Start writing version")
+	if err := writeVersion(m.db, m.version); err != nil {
+		return errors.Wrap(err, "write version")
+	}
+	m.log.Info().Msg("Finished migration")
+	return nil
+}
+
+func (m *blockMigration) migrateBlock(bn uint64) error {
+	b := m.bc.GetBlockByNumber(bn)
+	if b == nil {
+		m.log.Warn().Uint64("number", bn).Msg("block not found, skipping")
+		return nil
+	}
+	for _, migrate := range m.migrates {
+		if err := migrate(m.btc, b); err != nil {
+			return err
+		}
+	}
+	if m.btc.ValueSize() > writeThreshold {
+		if err := m.btc.Write(); err != nil {
+			return err
+		}
+		m.btc = m.db.NewBatch()
+	}
+	return nil
+}
+
+func (m *blockMigration) progressReportLoop() {
+	t := time.NewTicker(2 * time.Second)
+	defer t.Stop()
+	for {
+		select {
+		case <-t.C:
+			migrated := atomic.LoadUint64(&m.migratedNum)
+			m.log.Info().Str("progress", fmt.Sprintf("%v / %v", migrated, m.totalNum)).
+				Msg("migration in progress")
+
+		case <-m.finishedC:
+			m.log.Info().Msgf("migration to %v finished", m.version)
+			return
+
+		case <-m.closeC:
+			m.log.Info().Msg("Migration interrupted")
+			return
+		}
+	}
+}
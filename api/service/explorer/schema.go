@@ -3,10 +3,13 @@ package explorer
 import (
 	"encoding/binary"
 	"fmt"
+	"math"
 
 	"github.com/RoaringBitmap/roaring/roaring64"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/rlp"
+	"github.com/harmony-one/harmony/hmy/tokens"
+	"github.com/harmony-one/harmony/hmy/tracers"
 	goversion "github.com/hashicorp/go-version"
 	"github.com/pkg/errors"
 	"github.com/syndtr/goleveldb/leveldb"
@@ -73,10 +76,28 @@ func getTraceResult(db databaseReader, key []byte) ([]byte, error) {
 var (
 	versionKey     = []byte("version")
 	versionV100, _ = goversion.NewVersion("1.0.0")
+	versionV110, _ = goversion.NewVersion("1.1.0")
+	versionV120, _ = goversion.NewVersion("1.2.0")
 )
 
 // isVersionV100 return whether the version is larger than or equal to 1.0.0
 func isVersionV100(db databaseReader) (bool, error) {
+	return isVersionAtLeast(db, versionV100)
+}
+
+// isVersionV110 return whether the version is larger than or equal to 1.1.0,
+// from which token transfers are indexed
+func isVersionV110(db databaseReader) (bool, error) {
+	return isVersionAtLeast(db, versionV110)
+}
+
+// isVersionV120 return whether the version is larger than or equal to 1.2.0,
+// from which internal transactions are indexed
+func isVersionV120(db databaseReader) (bool, error) {
+	return isVersionAtLeast(db, versionV120)
+}
+
+func isVersionAtLeast(db databaseReader, ver *goversion.Version) (bool, error) {
 	curVer, err := readVersion(db)
 	if err != nil {
 		if errors.Is(err, leveldb.ErrNotFound) {
@@ -84,7 +105,7 @@ func isVersionV100(db databaseReader) (bool, error) {
 		}
 		return false, err
 	}
-	return curVer.GreaterThanOrEqual(versionV100), nil
+	return curVer.GreaterThanOrEqual(ver), nil
 }
 
 func readVersion(db databaseReader) (*goversion.Version, error) {
@@ -107,6 +128,9 @@ var (
 	txnPrefix                 = []byte("tx")
 	addrNormalTxnIndexPrefix  = []byte("at")
 	addrStakingTxnIndexPrefix = []byte("stk")
+	addrTokenTransferPrefix   = []byte("tka")
+	tokenTransferPrefix       = []byte("tkc")
+	addrInternalTxnPrefix     = []byte("itx")
 )
 
 // bPool is the sync pool for reusing the memory for allocating db keys
@@ -340,6 +364,240 @@ func writeStakingTxnIndex(db databaseWriter, entry stakingTxnIndex, tt TxType) e
 	return db.Put(key, []byte{byte(tt)})
 }
 
+// tokenTransferIndex is a single entry of the token transfer index. The same
+// transfer is indexed under its sender, its recipient and its token contract.
+// The key of the entry in db is a combination of the prefix, the indexed
+// address, block number, transaction index, log index and the position of
+// the transfer in the log. The value is the RLP encoded transfer.
+type tokenTransferIndex struct {
+	prefix      []byte
+	addr        oneAddress
+	blockNumber uint64
+	txnIndex    uint64
+	logIndex    uint64
+	batchIndex  uint64
+}
+
+func (index tokenTransferIndex) key() []byte {
+	b := bPool.Get()
+	defer b.Free()
+
+	_, _ = b.Write(index.prefix)
+	_, _ = b.Write([]byte(index.addr))
+	_ = binary.Write(b, binary.BigEndian, index.blockNumber)
+	_ = binary.Write(b, binary.BigEndian, index.txnIndex)
+	_ = binary.Write(b, binary.BigEndian, index.logIndex)
+	_ = binary.Write(b, binary.BigEndian, index.batchIndex)
+	return b.Bytes()
+}
+
+func tokenTransferIndexPrefix(prefix []byte, addr oneAddress) []byte {
+	b := bPool.Get()
+	defer b.Free()
+
+	_, _ = b.Write(prefix)
+	_, _ = b.Write([]byte(addr))
+	return b.Bytes()
+}
+
+func writeTokenTransferIndex(db databaseWriter, entry tokenTransferIndex, transfer *tokens.Transfer) error {
+	bs, err := rlp.EncodeToBytes(transfer)
+	if err != nil {
+		return err
+	}
+	return db.Put(entry.key(), bs)
+}
+
+func decodeTokenTransfer(val []byte) (*tokens.Transfer, error) {
+	var transfer tokens.Transfer
+	if err := rlp.DecodeBytes(val, &transfer); err != nil {
+		return nil, err
+	}
+	if transfer.Standard == tokens.StandardERC20 {
+		// RLP does not tell a nil big int from zero
+		transfer.TokenID = nil
+	}
+	return &transfer, nil
+}
+
+// getTokenTransfers returns the page of transfers indexed under the prefix,
+// skipping offset entries from the oldest, or the newest if desc is set
+func getTokenTransfers(db databaseReader, prefix []byte, offset, limit int, desc bool) ([]*tokens.Transfer, error) {
+	var transfers []*tokens.Transfer
+	err := forEachPageAtPrefix(db, prefix, offset, limit, desc, func(val []byte) error {
+		transfer, err := decodeTokenTransfer(val)
+		if err != nil {
+			return err
+		}
+		transfers = append(transfers, transfer)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	if desc {
+		for i, j := 0, len(transfers)-1; i < j; i, j = i+1, j-1 {
+			transfers[i], transfers[j] = transfers[j], transfers[i]
+		}
+	}
+	return transfers, nil
+}
+
+// forEachPageAtPrefix calls f in key order on the values of the page of
+// entries at the prefix. The page skips offset entries from the first key, or
+// from the last key if desc is set.
+func forEachPageAtPrefix(db databaseReader, prefix []byte, offset, limit int, desc bool, f func(val []byte) error) error {
+	if offset < 0 || limit <= 0 {
+		return nil
+	}
+	if desc {
+		return forEachPageAtPrefixDesc(db, prefix, offset, limit, f)
+	}
+	it := db.NewPrefixIterator(prefix)
+	defer it.Release()
+	for pos := 0; pos < offset+limit && it.Next(); pos++ {
+		if pos < offset {
+			continue
+		}
+		if err := f(it.Value()); err != nil {
+			return err
+		}
+	}
+	return it.Error()
+}
+
+// forEachPageAtPrefixDesc is forEachPageAtPrefix counting the offset from the
+// last key. The keys at the prefix start with the block number, so the page is
+// read from the last block number holding enough entries, which is found by
+// seeking instead of walking the whole index.
+func forEachPageAtPrefixDesc(db databaseReader, prefix []byte, offset, limit int, f func(val []byte) error) error {
+	last, err := searchBlockNumberAtPrefix(db, prefix, 1, math.MaxUint64)
+	if err != nil {
+		return err
+	}
+	from, err := searchBlockNumberAtPrefix(db, prefix, offset+limit, last)
+	if err != nil {
+		return err
+	}
+
+	var vals [][]byte
+	it := db.NewPrefixIteratorFrom(prefix, blockNumberKey(from))
+	defer it.Release()
+	for it.Next() {
+		vals = append(vals, common.CopyBytes(it.Value()))
+	}
+	if err := it.Error(); err != nil {
+		return err
+	}
+	start, end := len(vals)-offset-limit, len(vals)-offset
+	if start < 0 {
+		start = 0
+	}
+	for i := start; i < end; i++ {
+		if err := f(vals[i]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// searchBlockNumberAtPrefix returns the largest block number not above upper
+// from which at least n entries are indexed at the prefix, 0 if there is none.
+func searchBlockNumberAtPrefix(db databaseReader, prefix []byte, n int, upper uint64) (uint64, error) {
+	lo, hi := uint64(0), upper
+	for lo < hi {
+		mid := lo + (hi-lo)/2 + 1
+		it := db.NewPrefixIteratorFrom(prefix, blockNumberKey(mid))
+		count := 0
+		for count < n && it.Next() {
+			count++
+		}
+		err := it.Error()
+		it.Release()
+		if err != nil {
+			return 0, err
+		}
+		if count >= n {
+			lo = mid
+		} else {
+			hi = mid - 1
+		}
+	}
+	return lo, nil
+}
+
+func blockNumberKey(bn uint64) []byte {
+	b := make([]byte, 8)
+	binary.BigEndian.PutUint64(b, bn)
+	return b
+}
+
+// internalTxnIndex is a single entry of address-internal transaction index.
+// An internal transaction is indexed under both of its participants. The key
+// of the entry in db is a combination of addrInternalTxnPrefix, account
+// address, block number, index of the parent transaction in the block and
+// the position of the call in the trace. The value is the RLP encoded
+// internal transaction.
+type internalTxnIndex struct {
+	addr        oneAddress
+	blockNumber uint64
+	txnIndex    uint64
+	traceIndex  uint64
+}
+
+func (index internalTxnIndex) key() []byte {
+	b := bPool.Get()
+	defer b.Free()
+
+	_, _ = b.Write(addrInternalTxnPrefix)
+	_, _ = b.Write([]byte(index.addr))
+	_ = binary.Write(b, binary.BigEndian, index.blockNumber)
+	_ = binary.Write(b, binary.BigEndian, index.txnIndex)
+	_ = binary.Write(b, binary.BigEndian, index.traceIndex)
+	return b.Bytes()
+}
+
+func internalTxnIndexPrefixByAddr(addr oneAddress) []byte {
+	b := bPool.Get()
+	defer b.Free()
+
+	_, _ = b.Write(addrInternalTxnPrefix)
+	_, _ = b.Write([]byte(addr))
+	return b.Bytes()
+}
+
+func writeInternalTxnIndex(db databaseWriter, entry internalTxnIndex, itx *tracers.InternalTransaction) error {
+	bs, err := rlp.EncodeToBytes(itx)
+	if err != nil {
+		return err
+	}
+	return db.Put(entry.key(), bs)
+}
+
+// getInternalTxnsByAccount returns the page of internal transactions of the
+// account, skipping offset entries from the oldest, or the newest if desc is set
+func getInternalTxnsByAccount(db databaseReader, addr oneAddress, offset, limit int, desc bool) ([]*tracers.InternalTransaction, error) {
+	var itxs []*tracers.InternalTransaction
+	prefix := internalTxnIndexPrefixByAddr(addr)
+	err := forEachPageAtPrefix(db, prefix, offset, limit, desc, func(val []byte) error {
+		var itx tracers.InternalTransaction
+		if err := rlp.DecodeBytes(val, &itx); err != nil {
+			return err
+		}
+		itxs = append(itxs, &itx)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	if desc {
+		for i, j := 0, len(itxs)-1; i < j; i, j = i+1, j-1 {
+			itxs[i], itxs[j] = itxs[j], itxs[i]
+		}
+	}
+	return itxs, nil
+}
+
 func forEachAtPrefix(db databaseReader, prefix []byte, f func(key, val []byte) error) error {
 	it := db.NewPrefixIterator(prefix)
 	defer it.Release()
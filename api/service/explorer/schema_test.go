@@ -2,11 +2,17 @@ package explorer
 
 import (
 	"bytes"
+	"math/big"
 	"reflect"
 	"sort"
 	"testing"
 	"time"
 
+	"github.com/ethereum/go-ethereum/common"
+	blockfactory "github.com/harmony-one/harmony/block/factory"
+	"github.com/harmony-one/harmony/core/types"
+	"github.com/harmony-one/harmony/hmy/tokens"
+	"github.com/harmony-one/harmony/hmy/tracers"
 	goversion "github.com/hashicorp/go-version"
 )
 
@@ -212,6 +218,223 @@ func TestGetAllAddresses(t *testing.T) {
 	}
 }
 
+func TestVersionV110(t *testing.T) {
+	db := newMemDB()
+	if err := writeVersion(db, versionV100); err != nil {
+		t.Fatal(err)
+	}
+	if is, err := isVersionV110(db); is || err != nil {
+		t.Fatalf("1.0.0 reported as 1.1.0: %v", err)
+	}
+	if err := writeVersion(db, versionV110); err != nil {
+		t.Fatal(err)
+	}
+	if is, err := isVersionV110(db); !is || err != nil {
+		t.Fatalf("1.1.0 not reported as 1.1.0: %v", err)
+	}
+}
+
+func TestComputeTokenTransfers(t *testing.T) {
+	var (
+		contract = common.BytesToAddress([]byte{0xc0})
+		from     = common.BytesToAddress([]byte{0x01})
+		to       = common.BytesToAddress([]byte{0x02})
+		amount   = common.BigToHash(big.NewInt(1000))
+	)
+	txs := []*types.Transaction{
+		types.NewTransaction(1, contract, 0, big.NewInt(0), 100000, big.NewInt(1), nil),
+		types.NewTransaction(2, contract, 0, big.NewInt(0), 100000, big.NewInt(1), nil),
+	}
+	transferLog := func(from, to common.Address) *types.Log {
+		return &types.Log{
+			Address: contract,
+			Topics:  []common.Hash{tokens.TransferTopic, common.BytesToHash(from[:]), common.BytesToHash(to[:])},
+			Data:    amount[:],
+		}
+	}
+	receipts := types.Receipts{
+		// a mint, which is not indexed under the zero address
+		{Logs: []*types.Log{transferLog(common.Address{}, from)}},
+		{Logs: []*types.Log{{Address: contract}, transferLog(from, to)}},
+	}
+	b := types.NewBlock(blockfactory.NewTestHeader().With().Number(big.NewInt(314)).Header(), txs, receipts, nil, nil, nil)
+
+	db := newMemDB()
+	btc := db.NewBatch()
+	computeTokenTransfers(btc, b, receipts)
+	if err := btc.Write(); err != nil {
+		t.Fatal(err)
+	}
+
+	fromTransfers, err := getTokenTransfers(db, tokenTransferIndexPrefix(addrTokenTransferPrefix, ethToOneAddress(from)), 0, 10, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(fromTransfers) != 2 {
+		t.Fatalf("unexpected number of transfers for sender: %v / 2", len(fromTransfers))
+	}
+	transfer := fromTransfers[1]
+	if transfer.TxHash != txs[1].HashByType() || transfer.BlockNumber != 314 ||
+		transfer.TxIndex != 1 || transfer.LogIndex != 2 || transfer.TokenID != nil {
+		t.Errorf("unexpected transfer position: %+v", transfer)
+	}
+	if transfer.From != from || transfer.To != to || transfer.Value.Cmp(big.NewInt(1000)) != 0 {
+		t.Errorf("unexpected transfer: %+v", transfer)
+	}
+	for addr, exp := range map[common.Address]int{to: 1, {}: 0} {
+		got, err := getTokenTransfers(db, tokenTransferIndexPrefix(addrTokenTransferPrefix, ethToOneAddress(addr)), 0, 10, false)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(got) != exp {
+			t.Errorf("unexpected number of transfers for %v: %v / %v", addr.Hex(), len(got), exp)
+		}
+	}
+	contractTransfers, err := getTokenTransfers(db, tokenTransferIndexPrefix(tokenTransferPrefix, ethToOneAddress(contract)), 0, 10, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(contractTransfers) != 2 {
+		t.Fatalf("unexpected number of transfers for contract: %v / 2", len(contractTransfers))
+	}
+}
+
+func TestGetTokenTransfersPagination(t *testing.T) {
+	db := newMemDB()
+	addr := makeOneAddress(1)
+	for i := 0; i != 5; i++ {
+		index := tokenTransferIndex{
+			prefix:      addrTokenTransferPrefix,
+			addr:        addr,
+			blockNumber: uint64(i),
+		}
+		transfer := &tokens.Transfer{Standard: tokens.StandardERC20, Value: big.NewInt(1), BlockNumber: uint64(i)}
+		if err := writeTokenTransferIndex(db, index, transfer); err != nil {
+			t.Fatal(err)
+		}
+	}
+	prefix := tokenTransferIndexPrefix(addrTokenTransferPrefix, addr)
+	tests := []struct {
+		offset, limit int
+		desc          bool
+		exp           []uint64
+	}{
+		{0, 2, false, []uint64{0, 1}},
+		{2, 2, false, []uint64{2, 3}},
+		{4, 2, false, []uint64{4}},
+		{6, 2, false, nil},
+		{0, 2, true, []uint64{4, 3}},
+		{4, 2, true, []uint64{0}},
+		{3, 10, true, []uint64{1, 0}},
+	}
+	for i, test := range tests {
+		transfers, err := getTokenTransfers(db, prefix, test.offset, test.limit, test.desc)
+		if err != nil {
+			t.Fatal(err)
+		}
+		var got []uint64
+		for _, transfer := range transfers {
+			got = append(got, transfer.BlockNumber)
+		}
+		if !reflect.DeepEqual(got, test.exp) {
+			t.Errorf("Test %v: unexpected page %v / %v", i, got, test.exp)
+		}
+	}
+}
+
+func TestGetTokenTransfersPagination_DescSparse(t *testing.T) {
+	db := newMemDB()
+	addr := makeOneAddress(1)
+	bns := []uint64{3, 1000, 1000, 1000, 1 << 40}
+	for i, bn := range bns {
+		index := tokenTransferIndex{
+			prefix:      addrTokenTransferPrefix,
+			addr:        addr,
+			blockNumber: bn,
+			logIndex:    uint64(i),
+		}
+		transfer := &tokens.Transfer{Standard: tokens.StandardERC20, Value: big.NewInt(1), BlockNumber: bn, LogIndex: uint64(i)}
+		if err := writeTokenTransferIndex(db, index, transfer); err != nil {
+			t.Fatal(err)
+		}
+	}
+	prefix := tokenTransferIndexPrefix(addrTokenTransferPrefix, addr)
+	tests := []struct {
+		offset, limit int
+		exp           []uint64
+	}{
+		{0, 1, []uint64{4}},
+		{1, 2, []uint64{3, 2}},
+		{2, 2, []uint64{2, 1}},
+		{3, 5, []uint64{1, 0}},
+		{5, 1, nil},
+	}
+	for i, test := range tests {
+		transfers, err := getTokenTransfers(db, prefix, test.offset, test.limit, true)
+		if err != nil {
+			t.Fatal(err)
+		}
+		var got []uint64
+		for _, transfer := range transfers {
+			got = append(got, transfer.LogIndex)
+		}
+		if !reflect.DeepEqual(got, test.exp) {
+			t.Errorf("Test %v: unexpected page %v / %v", i, got, test.exp)
+		}
+	}
+}
+
+func TestGetInternalTxnsByAccount(t *testing.T) {
+	db := newMemDB()
+	addr := makeOneAddress(1)
+	for i := 0; i != 3; i++ {
+		for j := 0; j != 2; j++ {
+			index := internalTxnIndex{
+				addr:        addr,
+				blockNumber: uint64(i),
+				traceIndex:  uint64(j + 1),
+			}
+			itx := &tracers.InternalTransaction{
+				Type:         "call",
+				Value:        big.NewInt(int64(j + 1)),
+				TxHash:       makeTestTxHash(i),
+				BlockNumber:  uint64(i),
+				TraceIndex:   uint64(j + 1),
+				TraceAddress: []uint{uint(j)},
+				Depth:        1,
+			}
+			if err := writeInternalTxnIndex(db, index, itx); err != nil {
+				t.Fatal(err)
+			}
+		}
+	}
+	// entries of another account must not leak into the page
+	other := internalTxnIndex{addr: makeOneAddress(2)}
+	if err := writeInternalTxnIndex(db, other, &tracers.InternalTransaction{Value: big.NewInt(1)}); err != nil {
+		t.Fatal(err)
+	}
+
+	itxs, err := getInternalTxnsByAccount(db, addr, 1, 3, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	exp := [][2]uint64{{2, 1}, {1, 2}, {1, 1}}
+	if len(itxs) != len(exp) {
+		t.Fatalf("unexpected size %v / %v", len(itxs), len(exp))
+	}
+	for i, itx := range itxs {
+		if itx.BlockNumber != exp[i][0] || itx.TraceIndex != exp[i][1] {
+			t.Errorf("Test %v: unexpected internal transaction %v-%v / %v", i, itx.BlockNumber, itx.TraceIndex, exp[i])
+		}
+		if itx.TxHash != makeTestTxHash(int(itx.BlockNumber)) {
+			t.Errorf("Test %v: unexpected parent hash %x", i, itx.TxHash)
+		}
+		if itx.Value.Uint64() != itx.TraceIndex || itx.Depth != 1 || len(itx.TraceAddress) != 1 {
+			t.Errorf("Test %v: unexpected decoded internal transaction %+v", i, itx)
+		}
+	}
+}
+
 func makeAddresses(size int) []oneAddress {
 	var addrs []oneAddress
 	for i := 0; i != size; i++ {
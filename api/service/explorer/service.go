@@ -20,6 +20,7 @@ import (
 	"github.com/harmony-one/harmony/core"
 	"github.com/harmony-one/harmony/core/types"
 	"github.com/harmony-one/harmony/hmy"
+	"github.com/harmony-one/harmony/hmy/tokens"
 	"github.com/harmony-one/harmony/hmy/tracers"
 	"github.com/harmony-one/harmony/internal/chain"
 	nodeconfig "github.com/harmony-one/harmony/internal/configs/node"
@@ -221,6 +222,24 @@ func (s *Service) GetStakingTxHashesByAccount(address string) ([]ethCommon.Hash,
 	return s.storage.GetStakingTxsByAddress(address)
 }
 
+// GetTokenTransfersByAddress get the token transfers sent or received by the
+// address, skipping offset transfers from the oldest or the newest if desc is set
+func (s *Service) GetTokenTransfersByAddress(address string, offset, limit int, desc bool) ([]*tokens.Transfer, error) {
+	return s.storage.GetTokenTransfersByAddress(address, offset, limit, desc)
+}
+
+// GetTokenTransfersByContract get the token transfers of the token contract,
+// skipping offset transfers from the oldest or the newest if desc is set
+func (s *Service) GetTokenTransfersByContract(address string, offset, limit int, desc bool) ([]*tokens.Transfer, error) {
+	return s.storage.GetTokenTransfersByContract(address, offset, limit, desc)
+}
+
+// GetInternalTxsByAddress get the internal transactions sent or received by the
+// address, skipping offset transactions from the oldest or the newest if desc is set
+func (s *Service) GetInternalTxsByAddress(address string, offset, limit int, desc bool) ([]*tracers.InternalTransaction, error) {
+	return s.storage.GetInternalTxsByAddress(address, offset, limit, desc)
+}
+
 func (s *Service) GetTraceResultByHash(hash ethCommon.Hash) (json.RawMessage, error) {
 	return s.storage.GetTraceResultByHash(hash)
 }
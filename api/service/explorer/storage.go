@@ -18,6 +18,7 @@ import (
 	"github.com/harmony-one/harmony/core"
 	core2 "github.com/harmony-one/harmony/core"
 	"github.com/harmony-one/harmony/core/types"
+	"github.com/harmony-one/harmony/hmy/tokens"
 	"github.com/harmony-one/harmony/hmy/tracers"
 	common2 "github.com/harmony-one/harmony/internal/common"
 	"github.com/harmony-one/harmony/internal/utils"
@@ -199,6 +200,29 @@ func (s *storage) GetStakingTxsByAddress(addr string) ([]common.Hash, []TxType,
 	return getStakingTxnHashesByAccount(s.db, oneAddress(addr))
 }
 
+func (s *storage) GetTokenTransfersByAddress(addr string, offset, limit int, desc bool) ([]*tokens.Transfer, error) {
+	if !s.available.IsSet() {
+		return nil, ErrExplorerNotReady
+	}
+	prefix := tokenTransferIndexPrefix(addrTokenTransferPrefix, oneAddress(addr))
+	return getTokenTransfers(s.db, prefix, offset, limit, desc)
+}
+
+func (s *storage) GetTokenTransfersByContract(addr string, offset, limit int, desc bool) ([]*tokens.Transfer, error) {
+	if !s.available.IsSet() {
+		return nil, ErrExplorerNotReady
+	}
+	prefix := tokenTransferIndexPrefix(tokenTransferPrefix, oneAddress(addr))
+	return getTokenTransfers(s.db, prefix, offset, limit, desc)
+}
+
+func (s *storage) GetInternalTxsByAddress(addr string, offset, limit int, desc bool) ([]*tracers.InternalTransaction, error) {
+	if !s.available.IsSet() {
+		return nil, ErrExplorerNotReady
+	}
+	return getInternalTxnsByAccount(s.db, oneAddress(addr), offset, limit, desc)
+}
+
 func (s *storage) GetTraceResultByHash(hash common.Hash) (json.RawMessage, error) {
 	if !s.available.IsSet() {
 		return nil, ErrExplorerNotReady
@@ -225,6 +249,18 @@ func (s *storage) run() {
 			os.Exit(1)
 		}
 	}
+	if is, err := isVersionV120(s.db); !is || err != nil {
+		s.available.UnSet()
+		err := s.migrateToV120()
+		if errors.Is(err, errInterrupted) {
+			return
+		}
+		if err != nil {
+			s.log.Error().Err(err).Msg("Failed to migrate explorer DB!")
+			fmt.Println("Failed to migrate explorer DB:", err)
+			os.Exit(1)
+		}
+	}
 	s.available.Set()
 	go s.loop()
 }
@@ -367,7 +403,7 @@ func (s *storage) makeWorkersAndStart() {
 type blockComputer struct {
 	tm      *taskManager
 	db      database
-	bc      blockChainTxIndexer
+	bc      blockChainTokenIndexer
 	resultC chan blockResult
 	resultT chan *traceResult
 	closeC  chan struct{}
@@ -410,6 +446,10 @@ LOOP:
 					_ = writeTraceResult(traceResult.btc, key, value)
 				}
 			})
+			if err := computeInternalTxs(traceResult.btc, traceResult.data); err != nil {
+				bc.log.Error().Err(err).Str("hash", traceResult.data.Hash.Hex()).
+					Msg("explorer failed to index internal transactions")
+			}
 			select {
 			case bc.resultT <- traceResult:
 			case <-bc.closeC:
@@ -433,6 +473,9 @@ func (bc *blockComputer) computeBlock(b *types.Block) (*blockResult, error) {
 	for _, stk := range b.StakingTransactions() {
 		bc.computeStakingTx(btc, b, stk)
 	}
+	if len(b.Transactions()) != 0 {
+		computeTokenTransfers(btc, b, bc.bc.GetReceiptsByHash(b.Hash()))
+	}
 	bc.tm.markBlockDone(btc, b.NumberU64())
 	return &blockResult{
 		btc: btc,
@@ -500,6 +543,82 @@ func (bc *blockComputer) computeStakingTx(btc batch, b *types.Block, tx *staking
 	}, txReceived)
 }
 
+// computeTokenTransfers indexes the token transfers emitted by the normal
+// transactions of the block under their sender, recipient and contract
+func computeTokenTransfers(btc batch, b *types.Block, receipts types.Receipts) {
+	var (
+		txs      = b.Transactions()
+		logIndex uint64
+	)
+	for i, receipt := range receipts {
+		if i >= len(txs) {
+			// staking transactions do not emit token events
+			break
+		}
+		for _, log := range receipt.Logs {
+			for _, transfer := range tokens.FromLog(log) {
+				transfer.TxHash = txs[i].HashByType()
+				transfer.BlockNumber = b.NumberU64()
+				transfer.TxIndex = uint64(i)
+				transfer.LogIndex = logIndex
+				transfer.Timestamp = b.Time().Uint64()
+				writeTokenTransfer(btc, transfer)
+			}
+			logIndex++
+		}
+	}
+}
+
+func writeTokenTransfer(btc batch, transfer *tokens.Transfer) {
+	index := tokenTransferIndex{
+		blockNumber: transfer.BlockNumber,
+		txnIndex:    transfer.TxIndex,
+		logIndex:    transfer.LogIndex,
+		batchIndex:  transfer.BatchIndex,
+	}
+	holders := []common.Address{transfer.From, transfer.To}
+	if transfer.From == transfer.To {
+		holders = holders[:1]
+	}
+	for _, holder := range holders {
+		if holder == (common.Address{}) {
+			// mints and burns
+			continue
+		}
+		index.prefix, index.addr = addrTokenTransferPrefix, ethToOneAddress(holder)
+		_ = writeAddressEntry(btc, index.addr)
+		_ = writeTokenTransferIndex(btc, index, transfer)
+	}
+	index.prefix, index.addr = tokenTransferPrefix, ethToOneAddress(transfer.Contract)
+	_ = writeTokenTransferIndex(btc, index, transfer)
+}
+
+// computeInternalTxs indexes the internal transactions found in the call
+// traces of the block under both of their participants
+func computeInternalTxs(btc batch, data *tracers.TraceBlockStorage) error {
+	itxs, err := data.InternalTransactions()
+	if err != nil {
+		return err
+	}
+	for _, itx := range itxs {
+		index := internalTxnIndex{
+			blockNumber: itx.BlockNumber,
+			txnIndex:    itx.TxIndex,
+			traceIndex:  itx.TraceIndex,
+		}
+		participants := []common.Address{itx.From, itx.To}
+		if itx.From == itx.To {
+			participants = participants[:1]
+		}
+		for _, participant := range participants {
+			index.addr = ethToOneAddress(participant)
+			_ = writeAddressEntry(btc, index.addr)
+			_ = writeInternalTxnIndex(btc, index, itx)
+		}
+	}
+	return nil
+}
+
 func ethToOneAddress(ethAddr common.Address) oneAddress {
 	raw, _ := common2.AddressToBech32(ethAddr)
 	return oneAddress(raw)
@@ -148,7 +148,7 @@ type Transaction struct {
 
 // GetTransaction ...
 func GetTransaction(tx *types.Transaction, addressBlock *types.Block) (*Transaction, error) {
-	msg, err := tx.AsMessage(types.NewEIP155Signer(tx.ChainID()))
+	msg, err := tx.AsMessage(types.NewLondonSigner(tx.ChainID()))
 	if err != nil {
 		utils.Logger().Error().Err(err).Msg("Error when parsing tx into message")
 	}
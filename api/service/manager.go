@@ -2,6 +2,8 @@ package service
 
 import (
 	"fmt"
+	"strings"
+	"sync"
 
 	"github.com/harmony-one/harmony/internal/utils"
 	"github.com/pkg/errors"
@@ -24,6 +26,7 @@ const (
 	Synchronize
 	CrosslinkSending
 	StagedStreamSync
+	CXReceiptsPulling
 )
 
 func (t Type) String() string {
@@ -48,11 +51,34 @@ func (t Type) String() string {
 		return "CrosslinkSending"
 	case StagedStreamSync:
 		return "StagedStreamSync"
+	case CXReceiptsPulling:
+		return "CXReceiptsPulling"
 	default:
 		return "Unknown"
 	}
 }
 
+// Restartable returns whether the service of the type can be stopped and started
+// again at runtime. The other services release their resources on stop for good.
+func (t Type) Restartable() bool {
+	switch t {
+	case Pprof:
+		return true
+	default:
+		return false
+	}
+}
+
+// ParseType returns the service type of the given name, case insensitive.
+func ParseType(name string) (Type, error) {
+	for t := ClientSupport; t <= CXReceiptsPulling; t++ {
+		if strings.EqualFold(t.String(), name) {
+			return t, nil
+		}
+	}
+	return UnknownService, errors.Errorf("unknown service [%v]", name)
+}
+
 // Service is the collection of functions any service needs to implement.
 type Service interface {
 	Start() error
@@ -63,6 +89,8 @@ type Service interface {
 type Manager struct {
 	services   []Service
 	serviceMap map[Type]Service
+	running    map[Service]bool
+	lock       sync.Mutex
 
 	logger zerolog.Logger
 }
@@ -72,6 +100,7 @@ func NewManager() *Manager {
 	return &Manager{
 		services:   nil,
 		serviceMap: make(map[Type]Service),
+		running:    make(map[Service]bool),
 		logger:     *utils.Logger(),
 	}
 }
@@ -100,6 +129,9 @@ func (m *Manager) GetService(t Type) Service {
 // StartServices run all registered services. If one of the starting service returns
 // an error, closing all started services.
 func (m *Manager) StartServices() (err error) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
 	started := make([]Service, 0, len(m.services))
 
 	defer func() {
@@ -119,22 +151,85 @@ func (m *Manager) StartServices() (err error) {
 			return err
 		}
 		started = append(started, service)
+		m.setRunning(service, true)
 	}
 	return err
 }
 
 // StopServices stops all services in the reverse order.
 func (m *Manager) StopServices() error {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
 	return m.stopServices(m.services)
 }
 
-// stopServices stops given services in the reverse order.
+// StartService starts the registered service of the given type if it is not running.
+// Only the restartable services can be started at runtime.
+func (m *Manager) StartService(t Type) error {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	if !t.Restartable() {
+		return errors.Errorf("service [%v] cannot be started at runtime", t.String())
+	}
+	service, ok := m.serviceMap[t]
+	if !ok {
+		return errors.Errorf("service [%v] is not registered", t.String())
+	}
+	if m.running[service] {
+		return errors.Errorf("service [%v] is already running", t.String())
+	}
+	m.logger.Info().Str("type", t.String()).Msg("Starting service")
+	if err := service.Start(); err != nil {
+		return errors.Wrapf(err, "cannot start service [%v]", t.String())
+	}
+	m.setRunning(service, true)
+	return nil
+}
+
+// StopService stops the registered service of the given type if it is running.
+// Only the restartable services can be stopped at runtime.
+func (m *Manager) StopService(t Type) error {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	if !t.Restartable() {
+		return errors.Errorf("service [%v] cannot be stopped at runtime", t.String())
+	}
+	service, ok := m.serviceMap[t]
+	if !ok {
+		return errors.Errorf("service [%v] is not registered", t.String())
+	}
+	if !m.running[service] {
+		return errors.Errorf("service [%v] is not running", t.String())
+	}
+	return m.stopServices([]Service{service})
+}
+
+// ServicesStatus returns whether each of the registered services is running.
+func (m *Manager) ServicesStatus() map[Type]bool {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	status := make(map[Type]bool, len(m.serviceMap))
+	for t, service := range m.serviceMap {
+		status[t] = m.running[service]
+	}
+	return status
+}
+
+// stopServices stops given services in the reverse order. The services not
+// running are skipped.
 func (m *Manager) stopServices(services []Service) error {
 	size := len(services)
 	var rErr error
 
 	for i := size - 1; i >= 0; i-- {
 		service := services[i]
+		if !m.running[service] {
+			continue
+		}
 		t := m.typeByService(service)
 
 		m.logger.Info().Str("type", t.String()).Msg("Stopping service")
@@ -146,10 +241,18 @@ func (m *Manager) stopServices(services []Service) error {
 				rErr = err
 			}
 		}
+		m.setRunning(service, false)
 	}
 	return rErr
 }
 
+func (m *Manager) setRunning(service Service, running bool) {
+	if m.running == nil {
+		m.running = make(map[Service]bool)
+	}
+	m.running[service] = running
+}
+
 func (m *Manager) typeByService(target Service) Type {
 	for t, s := range m.serviceMap {
 		if s == target {
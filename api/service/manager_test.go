@@ -94,6 +94,9 @@ func TestManager_StopServices(t *testing.T) {
 		m := &Manager{
 			services: test.services,
 		}
+		if err := m.StartServices(); err != nil {
+			t.Fatalf("Test %v: unexpected start error: %v", i, err)
+		}
 		err := m.StopServices()
 		if assErr := assertError(err, test.expErr); assErr != nil {
 			t.Errorf("Test %v: %v", i, assErr)
@@ -108,6 +111,84 @@ func TestManager_StopServices(t *testing.T) {
 
 }
 
+func TestManager_StartStopService(t *testing.T) {
+	m := NewManager()
+	s := makeTestService(0, nil, nil)
+	m.Register(Pprof, s)
+
+	if err := m.StopService(Pprof); err == nil {
+		t.Error("expected error stopping a service not running")
+	}
+	if err := m.StartService(Prometheus); err == nil {
+		t.Error("expected error starting a service not registered")
+	}
+	if err := m.StartService(Pprof); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !s.started || !m.ServicesStatus()[Pprof] {
+		t.Error("service not started")
+	}
+	if err := m.StartService(Pprof); err == nil {
+		t.Error("expected error starting a running service")
+	}
+	if err := m.StopService(Pprof); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if s.started || m.ServicesStatus()[Pprof] {
+		t.Error("service not stopped")
+	}
+}
+
+func TestManager_StopServices_SkipStopped(t *testing.T) {
+	m := NewManager()
+	s := makeTestService(0, nil, nil)
+	stops := 0
+	s.stopErrHook = func() error {
+		stops++
+		return nil
+	}
+	m.Register(Pprof, s)
+
+	if err := m.StartServices(); err != nil {
+		t.Fatal(err)
+	}
+	if err := m.StopService(Pprof); err != nil {
+		t.Fatal(err)
+	}
+	if err := m.StopServices(); err != nil {
+		t.Fatal(err)
+	}
+	if stops != 1 {
+		t.Errorf("stopped service stopped again: %v stops", stops)
+	}
+}
+
+func TestManager_StartStopService_NotRestartable(t *testing.T) {
+	m := NewManager()
+	m.Register(CrosslinkSending, makeTestService(0, nil, nil))
+
+	if err := m.StartServices(); err != nil {
+		t.Fatal(err)
+	}
+	if err := m.StopService(CrosslinkSending); err == nil {
+		t.Error("expected error stopping a service not restartable")
+	}
+	if !m.ServicesStatus()[CrosslinkSending] {
+		t.Error("service not restartable stopped")
+	}
+}
+
+func TestParseType(t *testing.T) {
+	for _, name := range []string{"pprof", "Pprof", "PPROF"} {
+		if typ, err := ParseType(name); err != nil || typ != Pprof {
+			t.Errorf("%v: unexpected type %v, error %v", name, typ, err)
+		}
+	}
+	if _, err := ParseType("unknown"); err == nil {
+		t.Error("expected error parsing unknown service")
+	}
+}
+
 type testService struct {
 	index        int
 	started      bool
@@ -43,6 +43,8 @@ const (
 type Service struct {
 	config   Config
 	profiles map[string]Profile
+	server   *http.Server
+	quit     chan struct{}
 }
 
 var (
@@ -89,10 +91,16 @@ func (s *Service) Start() error {
 		return err
 	}
 
-	go func() {
+	// the server and the scheduled profiles are recreated on every start, so
+	// the service can be stopped and started again at runtime
+	s.server = &http.Server{Addr: s.config.ListenAddr}
+	s.quit = make(chan struct{})
+	go func(server *http.Server) {
 		utils.Logger().Info().Str("address", s.config.ListenAddr).Msg("starting pprof HTTP service")
-		http.ListenAndServe(s.config.ListenAddr, nil)
-	}()
+		if err := server.ListenAndServe(); err != nil && err != http.ErrServerClosed {
+			utils.Logger().Error().Err(err).Msg("pprof HTTP service failed")
+		}
+	}(s.server)
 
 	if _, ok := s.profiles[CPU]; ok {
 		// The nature of the pprof CPU profile is fundamentally different to the other profiles, because it streams output to a file during profiling.
@@ -101,7 +109,7 @@ func (s *Service) Start() error {
 	}
 
 	for _, profile := range s.profiles {
-		scheduleProfile(profile, dir)
+		scheduleProfile(profile, dir, s.quit)
 	}
 
 	return nil
@@ -109,6 +117,16 @@ func (s *Service) Start() error {
 
 // Stop stop the service
 func (s *Service) Stop() error {
+	if s.quit != nil {
+		close(s.quit)
+		s.quit = nil
+	}
+	if s.server != nil {
+		if err := s.server.Close(); err != nil {
+			utils.Logger().Error().Err(err).Msg("could not close pprof HTTP service")
+		}
+		s.server = nil
+	}
 	dir, err := filepath.Abs(s.config.Folder)
 	if err != nil {
 		return err
@@ -127,7 +145,8 @@ func (s *Service) Stop() error {
 }
 
 // scheduleProfile schedules the provided profile based on the specified interval (e.g. saves the profile every x seconds)
-func scheduleProfile(profile Profile, dir string) {
+// until quit is closed
+func scheduleProfile(profile Profile, dir string, quit <-chan struct{}) {
 	go func() {
 		if profile.Interval > 0 {
 			ticker := time.NewTicker(time.Second * time.Duration(profile.Interval))
@@ -146,6 +165,8 @@ func scheduleProfile(profile Profile, dir string) {
 							utils.Logger().Error().Err(err).Msg(fmt.Sprintf("could not save pprof profile: %s", profile.Name))
 						}
 					}
+				case <-quit:
+					return
 				}
 			}
 		}
@@ -20,6 +20,8 @@ type blockDownloadManager struct {
 	chain blockChain
 
 	targetBN   uint64
+	batchSize  int                              // number of blocks of each request
+	bytesLimit uint64                           // bytes limit of each request, 0 for no limit
 	requesting map[uint64]struct{}              // block numbers that have been assigned to workers but not received
 	processing map[uint64]struct{}              // block numbers received requests but not inserted
 	retries    *prioritizedNumbers              // requests where error happens
@@ -30,10 +32,12 @@ type blockDownloadManager struct {
 	lock   sync.Mutex
 }
 
-func newBlockDownloadManager(chain blockChain, targetBN uint64, logger zerolog.Logger) *blockDownloadManager {
+func newBlockDownloadManager(chain blockChain, targetBN uint64, batchSize int, bytesLimit uint64, logger zerolog.Logger) *blockDownloadManager {
 	return &blockDownloadManager{
 		chain:      chain,
 		targetBN:   targetBN,
+		batchSize:  batchSize,
+		bytesLimit: bytesLimit,
 		requesting: make(map[uint64]struct{}),
 		processing: make(map[uint64]struct{}),
 		retries:    newPrioritizedNumbers(),
@@ -48,7 +52,7 @@ func (gbm *blockDownloadManager) GetNextBatch(curHeight uint64) []uint64 {
 	gbm.lock.Lock()
 	defer gbm.lock.Unlock()
 
-	cap := BlocksPerRequest
+	cap := gbm.batchSize
 
 	bns := gbm.getBatchFromRetries(cap, curHeight)
 	if len(bns) > 0 {
@@ -97,6 +101,16 @@ func (gbm *blockDownloadManager) HandleRequestResult(bns []uint64, blockBytes []
 	return nil
 }
 
+// blocksRequestSize returns the number of blocks and the bytes limit of each get
+// blocks request. The requests with a bytes limit are only sent when there are
+// enough streams serving them to keep all the workers busy.
+func blocksRequestSize(protocol syncProtocol, concurrency int) (int, uint64) {
+	if protocol.NumStreamsWithBytesLimit() >= concurrency {
+		return BlocksPerBytesRequest, BlockBytesPerRequest
+	}
+	return BlocksPerRequest, 0
+}
+
 func indexExists[T any](slice []T, index int) bool {
 	return index >= 0 && index < len(slice)
 }
@@ -0,0 +1,272 @@
+package stagedstreamsync
+
+import (
+	"context"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/harmony-one/harmony/block"
+	"github.com/harmony-one/harmony/core/types"
+	shardingconfig "github.com/harmony-one/harmony/internal/configs/sharding"
+	"github.com/harmony-one/harmony/internal/params"
+	syncproto "github.com/harmony-one/harmony/p2p/stream/protocols/sync"
+	"github.com/harmony-one/harmony/p2p/stream/protocols/sync/light"
+	sttypes "github.com/harmony-one/harmony/p2p/stream/types"
+	"github.com/harmony-one/harmony/shard"
+	"github.com/pkg/errors"
+)
+
+const (
+	// maximum number of streams asked for the checkpoint and epoch blocks in one sync
+	checkpointMaxAttempts int = 5
+)
+
+// checkpointVerifier keeps the beacon chain sync on the history of the trusted
+// checkpoint. The checkpoint block is fetched from the streams (or read from the
+// local chain), and the later epoch blocks are verified by a light client with
+// the committees elected since the checkpoint.
+type checkpointVerifier struct {
+	cp       shardingconfig.Checkpoint
+	number   uint64                 // block number of the checkpoint
+	client   *light.Client          // nil until the checkpoint block is trusted
+	verified map[uint64]common.Hash // verified epoch block hashes by block number
+	lock     sync.RWMutex
+}
+
+func newCheckpointVerifier(cp shardingconfig.Checkpoint) *checkpointVerifier {
+	return &checkpointVerifier{
+		cp:       cp,
+		number:   shard.Schedule.EpochLastBlock(cp.Epoch),
+		verified: make(map[uint64]common.Hash),
+	}
+}
+
+// trusted returns whether the checkpoint block is trusted
+func (cv *checkpointVerifier) trusted() bool {
+	cv.lock.RLock()
+	defer cv.lock.RUnlock()
+	return cv.client != nil
+}
+
+// trust starts the light client from the checkpoint block
+func (cv *checkpointVerifier) trust(config *params.ChainConfig, header *block.Header) error {
+	if header.Hash() != cv.cp.Hash {
+		return errors.Wrapf(ErrCheckpointMismatch, "block %v has hash %v", header.Number(), header.Hash().Hex())
+	}
+	client, err := light.NewClientFromCheckpoint(config, header)
+	if err != nil {
+		return err
+	}
+	cv.lock.Lock()
+	defer cv.lock.Unlock()
+	cv.client = client
+	cv.verified[cv.number] = cv.cp.Hash
+	return nil
+}
+
+// nextEpochs returns the epochs of the epoch blocks to verify next
+func (cv *checkpointVerifier) nextEpochs() []uint64 {
+	cv.lock.RLock()
+	defer cv.lock.RUnlock()
+	epochs := make([]uint64, 0, syncproto.GetEpochBlocksCap)
+	for i := uint64(0); i < syncproto.GetEpochBlocksCap; i++ {
+		epochs = append(epochs, cv.client.Epoch().Uint64()+i)
+	}
+	return epochs
+}
+
+// verifyEpochBlocks verifies the epoch blocks in order, and returns the number
+// of verified blocks. A nil header means the epoch is not finalized yet.
+func (cv *checkpointVerifier) verifyEpochBlocks(headers []*block.Header, sigs [][]byte) (int, error) {
+	cv.lock.Lock()
+	defer cv.lock.Unlock()
+	for i, header := range headers {
+		if header == nil {
+			return i, nil
+		}
+		if i >= len(sigs) {
+			return i, errors.New("missing epoch block signature")
+		}
+		if err := cv.client.VerifyEpochBlock(header, sigs[i]); err != nil {
+			return i, err
+		}
+		cv.verified[header.Number().Uint64()] = header.Hash()
+	}
+	return len(headers), nil
+}
+
+// verifyHeader checks the header does not conflict with the checkpoint and
+// the verified epoch blocks.
+func (cv *checkpointVerifier) verifyHeader(header *block.Header) error {
+	cv.lock.RLock()
+	defer cv.lock.RUnlock()
+	number := header.Number().Uint64()
+	if number == cv.number && header.Hash() != cv.cp.Hash {
+		return errors.Wrapf(ErrCheckpointMismatch, "block %v has hash %v", number, header.Hash().Hex())
+	}
+	if hash, ok := cv.verified[number]; ok && header.Hash() != hash {
+		return errors.Wrapf(ErrConflictingEpochBlock, "block %v has hash %v, want %v", number, header.Hash().Hex(), hash.Hex())
+	}
+	return nil
+}
+
+// verifyCommitSig verifies the commit signature of a header in the epoch of
+// the light client. Headers of other epochs are left to the chain verification.
+func (cv *checkpointVerifier) verifyCommitSig(header *block.Header, sig []byte) error {
+	cv.lock.RLock()
+	defer cv.lock.RUnlock()
+	if cv.client == nil || len(sig) == 0 || header.Epoch().Cmp(cv.client.Epoch()) != 0 {
+		return nil
+	}
+	return cv.client.VerifyHeader(header, sig)
+}
+
+// syncCheckpoint trusts the checkpoint block if not yet, then follows the epoch
+// blocks descending from it. It is a no-op if there is no checkpoint.
+func (s *StagedStreamSync) syncCheckpoint(ctx context.Context) error {
+	if s.checkpoint == nil {
+		return nil
+	}
+	if !s.checkpoint.trusted() {
+		if err := s.trustCheckpoint(ctx); err != nil {
+			return err
+		}
+		s.logger.Info().
+			Uint64("epoch", s.checkpoint.cp.Epoch).
+			Uint64("number", s.checkpoint.number).
+			Str("hash", s.checkpoint.cp.Hash.Hex()).
+			Msg(WrapStagedSyncMsg("sync checkpoint is trusted"))
+	}
+	return s.followCheckpoint(ctx)
+}
+
+// trustCheckpoint reads the checkpoint block from the local chain, or fetches
+// it from the streams if the chain has not reached the checkpoint yet.
+func (s *StagedStreamSync) trustCheckpoint(ctx context.Context) error {
+	cv := s.checkpoint
+	if s.bc.CurrentBlock().NumberU64() >= cv.number {
+		if header := s.bc.GetHeaderByNumber(cv.number); header != nil {
+			err := cv.trust(s.bc.Config(), header)
+			if errors.Is(err, ErrCheckpointMismatch) {
+				// the local chain is on another history, which can't be fixed by syncing
+				return errors.Wrapf(ErrLocalChainConflict, "checkpoint %v: %v", cv.cp, err)
+			}
+			return err
+		}
+	}
+
+	var skipped []sttypes.StreamID
+	for i := 0; i < checkpointMaxAttempts; i++ {
+		headers, _, stid, err := s.protocol.GetEpochBlocks(ctx, []uint64{cv.cp.Epoch},
+			syncproto.WithHighPriority(), syncproto.WithBlacklist(skipped))
+		if err != nil {
+			if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+				return err
+			}
+			s.logger.Warn().Err(err).Str("stream", string(stid)).
+				Msg(WrapStagedSyncMsg("get checkpoint block failed"))
+			if stid != "" {
+				s.protocol.StreamFailed(stid, "getEpochBlocks request failed")
+				skipped = append(skipped, stid)
+			}
+			continue
+		}
+		skipped = append(skipped, stid)
+		if len(headers) != 1 || headers[0] == nil {
+			// the stream has not reached the checkpoint yet
+			continue
+		}
+		if err := cv.trust(s.bc.Config(), headers[0]); err != nil {
+			s.rejectStream(stid, err)
+			continue
+		}
+		return nil
+	}
+	return ErrCheckpointNotFound
+}
+
+// followCheckpoint verifies the epoch blocks after the last verified one until
+// the streams have no more finalized epoch to serve.
+func (s *StagedStreamSync) followCheckpoint(ctx context.Context) error {
+	var rejected []sttypes.StreamID
+	for i := 0; i < checkpointMaxAttempts; {
+		epochs := s.checkpoint.nextEpochs()
+		headers, sigs, stid, err := s.protocol.GetEpochBlocks(ctx, epochs,
+			syncproto.WithHighPriority(), syncproto.WithBlacklist(rejected))
+		if err != nil {
+			if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+				return err
+			}
+			s.logger.Warn().Err(err).Str("stream", string(stid)).
+				Msg(WrapStagedSyncMsg("get epoch blocks failed"))
+			if stid != "" {
+				s.protocol.StreamFailed(stid, "getEpochBlocks request failed")
+			}
+			i++
+			continue
+		}
+		if len(headers) > len(epochs) {
+			s.rejectStream(stid, errors.Errorf("%v epoch blocks delivered for %v epochs", len(headers), len(epochs)))
+			rejected = append(rejected, stid)
+			i++
+			continue
+		}
+		n, err := s.checkpoint.verifyEpochBlocks(headers, sigs)
+		if err != nil {
+			s.rejectStream(stid, errors.Wrapf(err, "epoch %v", epochs[n]))
+			rejected = append(rejected, stid)
+			i++
+			continue
+		}
+		if n < len(epochs) {
+			return nil
+		}
+	}
+	// the epoch blocks not verified here are still verified by the chain with
+	// the committees of the verified ones
+	s.logger.Warn().Msg(WrapStagedSyncMsg("follow epoch blocks of checkpoint failed"))
+	return nil
+}
+
+// startFromCheckpoint returns whether the sync shall start from the checkpoint
+// instead of genesis, which is when the local chain has not reached the
+// checkpoint yet. The blocks up to the pivot are then stored without being
+// executed, and the state is synced at the pivot.
+func (s *StagedStreamSync) startFromCheckpoint(estimatedHeight uint64) bool {
+	if s.checkpoint == nil || s.isEpochChain || !s.config.StartFromCheckpoint {
+		return false
+	}
+	return s.CurrentBlockNumber() < s.checkpoint.number && s.checkpoint.number < estimatedHeight
+}
+
+// verifyCheckpointBlock checks the block header does not conflict with the
+// checkpoint history. It is a no-op if there is no checkpoint.
+func (s *StagedStreamSync) verifyCheckpointBlock(header *block.Header) error {
+	if s.checkpoint == nil {
+		return nil
+	}
+	return s.checkpoint.verifyHeader(header)
+}
+
+// verifyCheckpointPivot checks the pivot block does not conflict with the
+// checkpoint history, and verifies its commit signature if it is in the epoch
+// of the light client. It is a no-op if there is no checkpoint.
+func (s *StagedStreamSync) verifyCheckpointPivot(block *types.Block) error {
+	if s.checkpoint == nil {
+		return nil
+	}
+	if err := s.checkpoint.verifyHeader(block.Header()); err != nil {
+		return err
+	}
+	return s.checkpoint.verifyCommitSig(block.Header(), block.GetCurrentCommitSig())
+}
+
+// rejectStream removes the stream serving a history conflicting with the checkpoint
+func (s *StagedStreamSync) rejectStream(stid sttypes.StreamID, err error) {
+	s.logger.Warn().Err(err).
+		Str("stream", string(stid)).
+		Msg(WrapStagedSyncMsg("stream rejected for serving a conflicting history"))
+	numCheckpointRejectedStreamsCounterVec.With(s.promLabels()).Inc()
+	s.protocol.StreamInvalidResponse(stid, err.Error())
+	s.protocol.RemoveStream(stid)
+}
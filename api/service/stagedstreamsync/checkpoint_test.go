@@ -0,0 +1,47 @@
+package stagedstreamsync
+
+import (
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	shardingconfig "github.com/harmony-one/harmony/internal/configs/sharding"
+	"github.com/pkg/errors"
+)
+
+func TestCheckpointVerifier_VerifyHeader(t *testing.T) {
+	var (
+		cpBlock    = makeTestBlock(100)
+		epochBlock = makeTestBlock(200)
+	)
+	cv := &checkpointVerifier{
+		cp:     shardingconfig.Checkpoint{Epoch: 10, Hash: cpBlock.Hash()},
+		number: 100,
+		verified: map[uint64]common.Hash{
+			100: cpBlock.Hash(),
+			200: epochBlock.Hash(),
+		},
+	}
+
+	tests := []struct {
+		number   uint64
+		conflict bool
+		expErr   error
+	}{
+		{50, true, nil},
+		{100, false, nil},
+		{200, false, nil},
+		{250, true, nil},
+		{100, true, ErrCheckpointMismatch},
+		{200, true, ErrConflictingEpochBlock},
+	}
+	for i, test := range tests {
+		header := makeTestBlock(test.number).Header()
+		if test.conflict {
+			// a different block of the same number
+			header.SetExtra([]byte("conflict"))
+		}
+		if err := cv.verifyHeader(header); errors.Cause(err) != test.expErr {
+			t.Errorf("Test %v: unexpected error %v / %v", i, err, test.expErr)
+		}
+	}
+}
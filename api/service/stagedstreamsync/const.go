@@ -5,6 +5,7 @@ import (
 
 	"github.com/harmony-one/harmony/core/types"
 	nodeconfig "github.com/harmony-one/harmony/internal/configs/node"
+	shardingconfig "github.com/harmony-one/harmony/internal/configs/sharding"
 )
 
 const (
@@ -29,6 +30,16 @@ const (
 	// maximum number of blocks for get receipts request
 	ReceiptsPerRequest int = 10
 
+	// number of blocks and bytes limit for each get blocks request with a bytes limit,
+	// which is sent when enough streams serve it
+	BlocksPerBytesRequest int    = 128
+	BlockBytesPerRequest  uint64 = 8 * 1024 * 1024
+
+	// number of blocks and bytes limit for each get receipts request with a bytes limit,
+	// which is sent when enough streams serve it
+	ReceiptsPerBytesRequest int    = 128
+	ReceiptBytesPerRequest  uint64 = 4 * 1024 * 1024
+
 	// DefaultConcurrency is the default settings for concurrency
 	DefaultConcurrency int = 4
 
@@ -91,6 +102,13 @@ type (
 		// config for beacon config
 		BHConfig *BeaconHelperConfig
 
+		// trusted checkpoint of the beacon chain, nil to trust the peers
+		Checkpoint *shardingconfig.Checkpoint
+
+		// start a chain behind the checkpoint from it instead of genesis, only
+		// the blocks after the fast sync pivot are executed
+		StartFromCheckpoint bool
+
 		// use memory db
 		UseMemDB bool
 
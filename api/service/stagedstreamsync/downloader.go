@@ -7,6 +7,7 @@ import (
 	"time"
 
 	"github.com/ethereum/go-ethereum/event"
+	"github.com/pkg/errors"
 	"github.com/rs/zerolog"
 
 	"github.com/harmony-one/harmony/consensus"
@@ -16,6 +17,7 @@ import (
 	"github.com/harmony-one/harmony/p2p"
 	"github.com/harmony-one/harmony/p2p/stream/common/streammanager"
 	streamSyncProtocol "github.com/harmony-one/harmony/p2p/stream/protocols/sync"
+	sttypes "github.com/harmony-one/harmony/p2p/stream/types"
 	"github.com/harmony-one/harmony/shard"
 )
 
@@ -145,6 +147,11 @@ func (d *Downloader) NumPeers() int {
 	return d.syncProtocol.NumStreams()
 }
 
+// PeerScores returns the scores of the streams of a specific shard.
+func (d *Downloader) PeerScores() map[sttypes.StreamID]streammanager.StreamScore {
+	return d.syncProtocol.StreamScores()
+}
+
 // SyncStatus returns the current sync status
 func (d *Downloader) SyncStatus() (bool, uint64, uint64) {
 	syncing, target := d.stagedSyncInstance.status.Get()
@@ -242,6 +249,12 @@ func (d *Downloader) loop() {
 			if err == ErrNotEnoughStreams {
 				d.waitForEnoughStreams(d.config.MinStreams)
 			}
+			if errors.Is(err, ErrLocalChainConflict) {
+				// retrying can't help, the chain db has to be replaced
+				d.logger.Fatal().Err(err).
+					Uint32("shard", d.bc.ShardID()).
+					Msg(WrapStagedSyncMsg("local chain conflicts with the sync checkpoint, remove the chain db to resync or use another --sync.checkpoint"))
+			}
 			if err != nil {
 				//TODO: if there is a bad block which can't be resolved
 				if d.stagedSyncInstance.invalidBlock.Active {
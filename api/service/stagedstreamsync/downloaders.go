@@ -6,6 +6,8 @@ import (
 	"github.com/harmony-one/harmony/core"
 	nodeconfig "github.com/harmony-one/harmony/internal/configs/node"
 	"github.com/harmony-one/harmony/p2p"
+	"github.com/harmony-one/harmony/p2p/stream/common/streammanager"
+	sttypes "github.com/harmony-one/harmony/p2p/stream/types"
 )
 
 // Downloaders is the set of downloaders
@@ -83,6 +85,16 @@ func (ds *Downloaders) NumPeers() map[uint32]int {
 	return res
 }
 
+// PeerScores returns the scores of the connected peers for each shard
+func (ds *Downloaders) PeerScores() map[uint32]map[sttypes.StreamID]streammanager.StreamScore {
+	res := make(map[uint32]map[sttypes.StreamID]streammanager.StreamScore)
+
+	for sid, d := range ds.ds {
+		res[sid] = d.PeerScores()
+	}
+	return res
+}
+
 // SyncStatus returns whether the given shard is doing syncing task and the target block number
 func (ds *Downloaders) SyncStatus(shardID uint32) (bool, uint64, uint64) {
 	d, ok := ds.ds[shardID]
@@ -22,6 +22,10 @@ var (
 	ErrEmptyWhitelist                = WrapStagedSyncError("empty white list")
 	ErrWrongGetBlockNumberType       = WrapStagedSyncError("wrong type of getBlockNumber interface")
 	ErrSaveBlocksToDbFailed          = WrapStagedSyncError("saving downloaded blocks to db failed")
+	ErrCheckpointMismatch            = WrapStagedSyncError("block hash does not match the sync checkpoint")
+	ErrCheckpointNotFound            = WrapStagedSyncError("no stream provides the sync checkpoint block")
+	ErrConflictingEpochBlock         = WrapStagedSyncError("epoch block conflicts with the verified one")
+	ErrLocalChainConflict            = WrapStagedSyncError("local chain conflicts with the sync checkpoint")
 )
 
 // WrapStagedSyncError wraps errors for staged sync and returns error object
@@ -18,6 +18,7 @@ func init() {
 		numBlocksInsertedShortRangeHistogramVec,
 		numBlocksInsertedEpochSyncHistogramVec,
 		numBlocksInsertedBeaconHelperCounter,
+		numCheckpointRejectedStreamsCounterVec,
 	)
 }
 
@@ -114,6 +115,16 @@ var (
 			Help:      "number of blocks inserted from beacon helper",
 		},
 	)
+
+	numCheckpointRejectedStreamsCounterVec = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: "hmy",
+			Subsystem: "staged_stream_sync",
+			Name:      "num_checkpoint_rejected_streams",
+			Help:      "number of streams rejected for serving a history conflicting with the sync checkpoint",
+		},
+		[]string{"ShardID"},
+	)
 )
 
 func (d *Downloader) promLabels() prometheus.Labels {
@@ -25,6 +25,8 @@ type receiptDownloadManager struct {
 	tx    kv.RwTx
 
 	targetBN   uint64
+	batchSize  int                               // number of blocks of each request
+	bytesLimit uint64                            // bytes limit of each request, 0 for no limit
 	requesting map[uint64]struct{}               // receipt numbers that have been assigned to workers but not received
 	processing map[uint64]struct{}               // receipt numbers received requests but not inserted
 	retries    *prioritizedNumbers               // requests where error happens
@@ -36,11 +38,13 @@ type receiptDownloadManager struct {
 	lock   sync.RWMutex
 }
 
-func newReceiptDownloadManager(tx kv.RwTx, chain blockChain, targetBN uint64, logger zerolog.Logger) *receiptDownloadManager {
+func newReceiptDownloadManager(tx kv.RwTx, chain blockChain, targetBN uint64, batchSize int, bytesLimit uint64, logger zerolog.Logger) *receiptDownloadManager {
 	return &receiptDownloadManager{
 		chain:      chain,
 		tx:         tx,
 		targetBN:   targetBN,
+		batchSize:  batchSize,
+		bytesLimit: bytesLimit,
 		requesting: make(map[uint64]struct{}),
 		processing: make(map[uint64]struct{}),
 		retries:    newPrioritizedNumbers(),
@@ -56,7 +60,7 @@ func (rdm *receiptDownloadManager) GetNextBatch(curHeight uint64) []uint64 {
 	rdm.lock.Lock()
 	defer rdm.lock.Unlock()
 
-	cap := ReceiptsPerRequest
+	cap := rdm.batchSize
 
 	bns := rdm.getBatchFromRetries(cap, curHeight)
 	if len(bns) > 0 {
@@ -73,6 +77,16 @@ func (rdm *receiptDownloadManager) GetNextBatch(curHeight uint64) []uint64 {
 	return bns
 }
 
+// receiptsRequestSize returns the number of blocks and the bytes limit of each get
+// receipts request. The requests with a bytes limit are only sent when there are
+// enough streams serving them to keep all the workers busy.
+func receiptsRequestSize(protocol syncProtocol, concurrency int) (int, uint64) {
+	if protocol.NumStreamsWithBytesLimit() >= concurrency {
+		return ReceiptsPerBytesRequest, ReceiptBytesPerRequest
+	}
+	return ReceiptsPerRequest, 0
+}
+
 // HandleRequestError handles the error result
 func (rdm *receiptDownloadManager) HandleRequestError(bns []uint64, err error) {
 	rdm.lock.Lock()
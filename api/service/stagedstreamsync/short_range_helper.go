@@ -173,7 +173,7 @@ func (sh *srHelper) doGetBlockHashesRequest(ctx context.Context, bns []uint64, a
 		sh.logger.Warn().Err(ErrUnexpectedBlockHashes).
 			Str("stream", string(stid)).
 			Msg(WrapStagedSyncMsg("failed to doGetBlockHashesRequest"))
-		sh.syncProtocol.StreamFailed(stid, "unexpected get block hashes result delivered")
+		sh.syncProtocol.StreamInvalidResponse(stid, "unexpected get block hashes result delivered")
 		return nil, stid, ErrUnexpectedBlockHashes
 	}
 	return hashes, stid, nil
@@ -205,7 +205,7 @@ func (sh *srHelper) doGetBlocksByHashesRequest(ctx context.Context, hashes []com
 	if err := checkGetBlockByHashesResult(blocks, hashes); err != nil {
 		sh.logger.Warn().Err(err).Str("stream", string(stid)).Msg(WrapStagedSyncMsg("failed to getBlockByHashes"))
 		if !errors.Is(err, context.Canceled) && !errors.Is(err, context.DeadlineExceeded) {
-			sh.syncProtocol.StreamFailed(stid, "failed to getBlockByHashes")
+			sh.syncProtocol.StreamInvalidResponse(stid, "failed to getBlockByHashes")
 		}
 		return nil, stid, err
 	}
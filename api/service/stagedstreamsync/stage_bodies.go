@@ -112,7 +112,8 @@ func (b *StageBodies) Exec(ctx context.Context, firstCycle bool, invalidBlockRev
 	}
 
 	// Fetch blocks from neighbors
-	s.state.gbm = newBlockDownloadManager(b.configs.bc, targetHeight, s.state.logger)
+	batchSize, bytesLimit := blocksRequestSize(b.configs.protocol, s.state.config.Concurrency)
+	s.state.gbm = newBlockDownloadManager(b.configs.bc, targetHeight, batchSize, bytesLimit, s.state.logger)
 
 	// Setup workers to fetch blocks from remote node
 	var wg sync.WaitGroup
@@ -157,7 +158,7 @@ func (b *StageBodies) runBlockWorkerLoop(ctx context.Context, gbm *blockDownload
 			}
 		}
 
-		blockBytes, sigBytes, stid, err := b.downloadRawBlocks(ctx, batch)
+		blockBytes, sigBytes, stid, err := b.downloadRawBlocks(ctx, batch, gbm.bytesLimit)
 		if err != nil {
 			if !errors.Is(err, context.Canceled) && !errors.Is(err, context.DeadlineExceeded) {
 				b.configs.protocol.StreamFailed(stid, "downloadRawBlocks failed")
@@ -186,10 +187,16 @@ func (b *StageBodies) runBlockWorkerLoop(ctx context.Context, gbm *blockDownload
 			gbm.HandleRequestError(batch, err, stid)
 			b.configs.protocol.RemoveStream(stid)
 		} else {
-			if err = b.saveBlocks(ctx, nil, batch, blockBytes, sigBytes, loopID, stid); err != nil {
+			// the stream may stop before the last requested blocks, which are
+			// requested again
+			served := batch[:len(blockBytes)]
+			if err = b.saveBlocks(ctx, nil, served, blockBytes, sigBytes, loopID, stid); err != nil {
 				panic(ErrSaveBlocksToDbFailed)
 			}
-			gbm.HandleRequestResult(batch, blockBytes, sigBytes, loopID, stid)
+			gbm.HandleRequestResult(served, blockBytes, sigBytes, loopID, stid)
+			if len(served) < len(batch) {
+				gbm.HandleRequestError(batch[len(served):], errors.New("blocks not delivered"), stid)
+			}
 			if b.configs.logProgress {
 				//calculating block download speed
 				dt := time.Now().Sub(startTime).Seconds()
@@ -245,7 +252,7 @@ badBlockDownloadLoop:
 		if b.configs.protocol.NumStreams() == 0 {
 			return errors.Errorf("re-download bad block from all streams failed")
 		}
-		blockBytes, sigBytes, stid, err := b.downloadRawBlocks(ctx, batch)
+		blockBytes, sigBytes, stid, err := b.downloadRawBlocks(ctx, batch, 0)
 		if err != nil {
 			if !errors.Is(err, context.Canceled) && !errors.Is(err, context.DeadlineExceeded) {
 				b.configs.protocol.StreamFailed(stid, "tried to re-download bad block from this stream, but downloadRawBlocks failed")
@@ -290,10 +297,13 @@ func (b *StageBodies) downloadBlocks(ctx context.Context, bns []uint64) ([]*type
 	return blocks, stid, nil
 }
 
-func (b *StageBodies) downloadRawBlocks(ctx context.Context, bns []uint64) ([][]byte, [][]byte, sttypes.StreamID, error) {
+func (b *StageBodies) downloadRawBlocks(ctx context.Context, bns []uint64, bytesLimit uint64) ([][]byte, [][]byte, sttypes.StreamID, error) {
 	ctx, cancel := context.WithTimeout(ctx, 10*time.Second)
 	defer cancel()
 
+	if bytesLimit != 0 {
+		return b.configs.protocol.GetRawBlocksByNumberWithLimit(ctx, bns, bytesLimit)
+	}
 	return b.configs.protocol.GetRawBlocksByNumber(ctx, bns)
 }
 
@@ -132,6 +132,12 @@ func (sr *StageEpoch) doShortRangeSyncForEpochSync(ctx context.Context, s *Stage
 
 	n := 0
 	for _, block := range blocks {
+		if err := s.state.verifyCheckpointBlock(block.Header()); err != nil {
+			utils.Logger().Warn().Err(err).Int("blocks inserted", n).Msg("block conflicts with the sync checkpoint")
+			s.state.rejectStream(streamID, err)
+			numBlocksInsertedEpochSyncHistogramVec.With(s.state.promLabels()).Observe(float64(n))
+			return n, err
+		}
 		_, err := s.state.bc.InsertChain([]*types.Block{block}, true)
 		switch {
 		case errors.Is(err, core.ErrKnownBlock):
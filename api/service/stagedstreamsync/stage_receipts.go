@@ -122,13 +122,14 @@ func (r *StageReceipts) Exec(ctx context.Context, firstCycle bool, invalidBlockR
 		}
 
 		// calculate the block numbers range to download
-		toBn := curBn + uint64(ReceiptsPerRequest*s.state.config.Concurrency)
+		batchSize, bytesLimit := receiptsRequestSize(r.configs.protocol, s.state.config.Concurrency)
+		toBn := curBn + uint64(batchSize*s.state.config.Concurrency)
 		if toBn > targetHeight {
 			toBn = targetHeight
 		}
 
 		// Fetch receipts from connected peers
-		rdm := newReceiptDownloadManager(tx, r.configs.bc, toBn, s.state.logger)
+		rdm := newReceiptDownloadManager(tx, r.configs.bc, toBn, batchSize, bytesLimit, s.state.logger)
 
 		// Setup workers to fetch blocks from remote node
 		var wg sync.WaitGroup
@@ -192,6 +193,18 @@ func (r *StageReceipts) insertBlocksAndReceipts(ctx context.Context, rdm *receip
 			streamIDs = append(streamIDs, received.streamID)
 		}
 	}
+	// the blocks are not executed, so the checkpoint is the only protection
+	// against a forged history
+	for i, block := range blocks {
+		if err := s.state.verifyCheckpointBlock(block.Header()); err != nil {
+			utils.Logger().Warn().Err(err).
+				Uint64("block number", block.NumberU64()).
+				Msg(WrapStagedSyncMsg("block conflicts with the sync checkpoint"))
+			s.state.rejectStream(streamIDs[i], err)
+			rdm.HandleRequestError(bns, err)
+			return err
+		}
+	}
 	// insert sorted blocks and receipts to chain
 	if inserted, err := r.configs.bc.InsertReceiptChain(blocks, receipts); err != nil {
 		utils.Logger().Err(err).
@@ -283,7 +296,7 @@ func (r *StageReceipts) runReceiptWorkerLoop(ctx context.Context, rdm *receiptDo
 		}
 
 		// download receipts
-		receipts, stid, err := r.downloadReceipts(ctx, hashes)
+		receipts, stid, err := r.downloadReceipts(ctx, hashes, rdm.bytesLimit)
 		if err != nil {
 			if !errors.Is(err, context.Canceled) && !errors.Is(err, context.DeadlineExceeded) {
 				r.configs.protocol.StreamFailed(stid, "downloadRawBlocks failed")
@@ -315,11 +328,22 @@ func (r *StageReceipts) runReceiptWorkerLoop(ctx context.Context, rdm *receiptDo
 	}
 }
 
-func (r *StageReceipts) downloadReceipts(ctx context.Context, hs []common.Hash) ([]types.Receipts, sttypes.StreamID, error) {
+func (r *StageReceipts) downloadReceipts(ctx context.Context, hs []common.Hash, bytesLimit uint64) ([]types.Receipts, sttypes.StreamID, error) {
 	ctx, cancel := context.WithTimeout(ctx, 10*time.Second)
 	defer cancel()
 
-	receipts, stid, err := r.configs.protocol.GetReceipts(ctx, hs)
+	var (
+		receipts []types.Receipts
+		stid     sttypes.StreamID
+		err      error
+	)
+	if bytesLimit != 0 {
+		// the stream may stop before the last requested blocks, whose receipts
+		// are requested again by HandleRequestResult
+		receipts, stid, err = r.configs.protocol.GetReceiptsWithLimit(ctx, hs, bytesLimit)
+	} else {
+		receipts, stid, err = r.configs.protocol.GetReceipts(ctx, hs)
+	}
 	if err != nil {
 		return nil, stid, err
 	}
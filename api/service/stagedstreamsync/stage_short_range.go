@@ -5,7 +5,6 @@ import (
 
 	"github.com/harmony-one/harmony/core"
 	"github.com/harmony-one/harmony/internal/utils"
-	sttypes "github.com/harmony-one/harmony/p2p/stream/types"
 	"github.com/ledgerwatch/erigon-lib/kv"
 	"github.com/pkg/errors"
 )
@@ -137,6 +136,18 @@ func (sr *StageShortRange) doShortRangeSync(ctx context.Context, s *StageState)
 
 	utils.Logger().Info().Int("num blocks", len(blocks)).Msg("getBlockByHashes result")
 
+	for _, block := range blocks {
+		if err := s.state.verifyCheckpointBlock(block.Header()); err != nil {
+			utils.Logger().Warn().Err(err).Uint64("block number", block.NumberU64()).
+				Msg("block conflicts with the sync checkpoint")
+			// the hash chain of the conflicting block is served by all whitelisted streams
+			for _, stid := range whitelist {
+				s.state.rejectStream(stid, err)
+			}
+			return 0, err
+		}
+	}
+
 	n, err := verifyAndInsertBlocks(sr.configs.bc, blocks)
 	numBlocksInsertedShortRangeHistogramVec.With(s.state.promLabels()).Observe(float64(n))
 	if err != nil {
@@ -152,7 +163,7 @@ func (sr *StageShortRange) doShortRangeSync(ctx context.Context, s *StageState)
 		} else {
 			// It is the last block gives a wrong commit sig. Blame the provider of the last block.
 			st2Blame := stids[len(stids)-1]
-			sh.streamsFailed([]sttypes.StreamID{st2Blame}, "the last block provided by stream gives a wrong commit sig")
+			sh.syncProtocol.StreamInvalidResponse(st2Blame, "the last block provided by stream gives a wrong commit sig")
 		}
 		return 0, err
 	}
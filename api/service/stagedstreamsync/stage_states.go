@@ -136,7 +136,7 @@ func (stg *StageStates) Exec(ctx context.Context, firstCycle bool, invalidBlockR
 				Uint64("block number", i).
 				Msg("block size invalid")
 			invalidBlockHash := common.Hash{}
-			s.state.protocol.StreamFailed(streamID, "zero bytes block is received from stream")
+			s.state.protocol.StreamInvalidResponse(streamID, "zero bytes block is received from stream")
 			reverter.RevertTo(stg.configs.bc.CurrentBlock().NumberU64(), i, invalidBlockHash, streamID)
 			return ErrInvalidBlockBytes
 		}
@@ -146,7 +146,7 @@ func (stg *StageStates) Exec(ctx context.Context, firstCycle bool, invalidBlockR
 			utils.Logger().Error().
 				Uint64("block number", i).
 				Msg("block size invalid")
-			s.state.protocol.StreamFailed(streamID, "invalid block is received from stream")
+			s.state.protocol.StreamInvalidResponse(streamID, "invalid block is received from stream")
 			invalidBlockHash := common.Hash{}
 			reverter.RevertTo(stg.configs.bc.CurrentBlock().NumberU64(), i, invalidBlockHash, streamID)
 			return ErrInvalidBlockBytes
@@ -156,7 +156,7 @@ func (stg *StageStates) Exec(ctx context.Context, firstCycle bool, invalidBlockR
 		}
 
 		if block.NumberU64() != i {
-			s.state.protocol.StreamFailed(streamID, "invalid block with unmatched number is received from stream")
+			s.state.protocol.StreamInvalidResponse(streamID, "invalid block with unmatched number is received from stream")
 			if !invalidBlockRevert {
 				invalidBlockHash := block.Hash()
 				reverter.RevertTo(stg.configs.bc.CurrentBlock().NumberU64(), i, invalidBlockHash, streamID)
@@ -164,6 +164,16 @@ func (stg *StageStates) Exec(ctx context.Context, firstCycle bool, invalidBlockR
 			return ErrInvalidBlockNumber
 		}
 
+		if err := s.state.verifyCheckpointBlock(block.Header()); err != nil {
+			stg.configs.logger.Warn().Err(err).Uint64("cycle target block", targetHeight).
+				Uint64("block number", block.NumberU64()).
+				Msg(WrapStagedSyncMsg("block conflicts with the sync checkpoint"))
+			s.state.rejectStream(streamID, err)
+			invalidBlockHash := block.Hash()
+			reverter.RevertTo(stg.configs.bc.CurrentBlock().NumberU64(), block.NumberU64(), invalidBlockHash, streamID)
+			return err
+		}
+
 		if stg.configs.bc.HasBlock(block.Hash(), block.NumberU64()) {
 			continue
 		}
@@ -172,6 +182,7 @@ func (stg *StageStates) Exec(ctx context.Context, firstCycle bool, invalidBlockR
 			stg.configs.logger.Warn().Err(err).Uint64("cycle target block", targetHeight).
 				Uint64("block number", block.NumberU64()).
 				Msg(WrapStagedSyncMsg("insert blocks failed in long range"))
+			// the insertion may fail for a local reason, so it is not recorded as an invalid response
 			s.state.protocol.StreamFailed(streamID, "unverifiable invalid block is received from stream")
 			invalidBlockHash := block.Hash()
 			reverter.RevertTo(stg.configs.bc.CurrentBlock().NumberU64(), block.NumberU64(), invalidBlockHash, streamID)
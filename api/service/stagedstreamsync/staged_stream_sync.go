@@ -82,6 +82,7 @@ type StagedStreamSync struct {
 	revertPoint       *uint64 // used to run stages
 	prevRevertPoint   *uint64 // used to get value from outside of staged sync after cycle (for example to notify RPCDaemon)
 	invalidBlock      InvalidBlock
+	checkpoint        *checkpointVerifier // nil if there is no trusted checkpoint
 	currentStage      uint
 	LogProgress       bool
 	currentCycle      SyncCycle // current cycle
@@ -349,6 +350,12 @@ func New(
 
 	status := NewStatus()
 
+	// the checkpoint is of the beacon chain
+	var cv *checkpointVerifier
+	if config.Checkpoint != nil && isBeaconShard {
+		cv = newCheckpointVerifier(*config.Checkpoint)
+	}
+
 	return &StagedStreamSync{
 		bc:                bc,
 		consensus:         consensus,
@@ -363,6 +370,7 @@ func New(
 		lastMileBlocks:    []*types.Block{},
 		gbm:               nil,
 		status:            status,
+		checkpoint:        cv,
 		inserted:          0,
 		config:            config,
 		logger:            logger,
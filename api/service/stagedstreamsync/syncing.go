@@ -107,6 +107,17 @@ func CreateStagedSync(ctx context.Context,
 		return nil, errInitDB
 	}
 
+	// a chain behind the trusted checkpoint is fast synced from it instead of
+	// executing every block from genesis
+	if config.SyncMode == FullSync && config.StartFromCheckpoint && config.Checkpoint != nil &&
+		isBeaconShard && !isEpochChain &&
+		bc.CurrentBlock().NumberU64() < shard.Schedule.EpochLastBlock(config.Checkpoint.Epoch) {
+		logger.Info().
+			Str("checkpoint", config.Checkpoint.String()).
+			Msg(WrapStagedSyncMsg("chain is behind the sync checkpoint, switching to fast sync"))
+		config.SyncMode = FastSync
+	}
+
 	extractReceiptHashes := config.SyncMode == FastSync || config.SyncMode == SnapSync
 	stageHeadsCfg := NewStageHeadersCfg(bc, mainDB)
 	stageShortRangeCfg := NewStageShortRangeCfg(bc, mainDB)
@@ -271,6 +282,10 @@ func (s *StagedStreamSync) checkPivot(ctx context.Context, estimatedHeight uint6
 		return nil, FullSync, nil
 	}
 
+	// a chain behind the checkpoint gets a pivot after the checkpoint even if
+	// it is not empty
+	fromCheckpoint := s.startFromCheckpoint(estimatedHeight)
+
 	pivotBlockNumber := uint64(0)
 	var curPivot *uint64
 	if curPivot = rawdb.ReadLastPivotNumber(s.bc.ChainDb()); curPivot != nil {
@@ -283,7 +298,8 @@ func (s *StagedStreamSync) checkPivot(ctx context.Context, estimatedHeight uint6
 			}
 		}
 	} else {
-		if head := s.CurrentBlockNumber(); s.config.SyncMode == FastSync && head <= 1 {
+		head := s.CurrentBlockNumber()
+		if (s.config.SyncMode == FastSync && head <= 1) || (fromCheckpoint && estimatedHeight > MinPivotDistanceToHead) {
 			pivotBlockNumber = estimatedHeight - MinPivotDistanceToHead
 			if err := rawdb.WriteLastPivotNumber(s.bc.ChainDb(), pivotBlockNumber); err != nil {
 				s.logger.Warn().Err(err).
@@ -292,12 +308,23 @@ func (s *StagedStreamSync) checkPivot(ctx context.Context, estimatedHeight uint6
 			}
 		}
 	}
+	// the pivot is never placed before the checkpoint, the sync starts from the
+	// checkpoint block at the earliest
+	if s.checkpoint != nil && (pivotBlockNumber > 0 || fromCheckpoint) &&
+		pivotBlockNumber < s.checkpoint.number && s.checkpoint.number < estimatedHeight {
+		pivotBlockNumber = s.checkpoint.number
+	}
 	if pivotBlockNumber > 0 {
 		if block, err := s.queryAllPeersForBlockByNumber(ctx, pivotBlockNumber); err != nil {
 			s.logger.Error().Err(err).
 				Uint64("pivot", pivotBlockNumber).
 				Msg(WrapStagedSyncMsg("query peers for pivot block failed"))
 			return block, FastSync, err
+		} else if err := s.verifyCheckpointPivot(block); err != nil {
+			s.logger.Error().Err(err).
+				Uint64("pivot", pivotBlockNumber).
+				Msg(WrapStagedSyncMsg("pivot block conflicts with the sync checkpoint"))
+			return nil, FastSync, err
 		} else {
 			if curPivot == nil || pivotBlockNumber != *curPivot {
 				if err := rawdb.WriteLastPivotNumber(s.bc.ChainDb(), pivotBlockNumber); err != nil {
@@ -350,6 +377,13 @@ func (s *StagedStreamSync) doSync(downloaderContext context.Context, initSync bo
 		}
 	}
 
+	// follow the epoch blocks from the trusted checkpoint before choosing
+	// the pivot, so the pivot and the downloaded blocks could be verified
+	if err := s.syncCheckpoint(downloaderContext); err != nil {
+		s.logger.Error().Err(err).Msg(WrapStagedSyncMsg("sync checkpoint failed"))
+		return 0, 0, err
+	}
+
 	// We are probably in full sync, but we might have rewound to before the
 	// fast/snap sync pivot, check if we should reenable
 	if pivotBlock, cycleSyncMode, err := s.checkPivot(downloaderContext, estimatedHeight, initSync); err != nil {
@@ -236,4 +236,16 @@ type Header interface {
 	// SetSlashes sets the RLP-encoded form of slashes
 	// It stores a copy; the caller may freely modify the original.
 	SetSlashes(newSlashes []byte)
+
+	// BaseFee is the EIP-1559 base fee per gas of the block.
+	// It is nil for headers that predate the London fork.
+	//
+	// The returned value is a copy; the caller may do anything with it.
+	BaseFee() *big.Int
+
+	// SetBaseFee sets the EIP-1559 base fee per gas of the block.
+	// It fails for headers that predate the London fork.
+	//
+	// It stores a copy; the caller may freely modify the original.
+	SetBaseFee(newBaseFee *big.Int) error
 }
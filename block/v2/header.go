@@ -13,6 +13,7 @@ import (
 	"github.com/harmony-one/harmony/crypto/hash"
 	"github.com/harmony-one/harmony/internal/utils"
 	"github.com/harmony-one/harmony/shard"
+	"github.com/pkg/errors"
 	"github.com/rs/zerolog"
 )
 
@@ -378,6 +379,21 @@ func (h *Header) SetSlashes(newSlashes []byte) {
 		Msg("cannot store slashes in V2 header")
 }
 
+// BaseFee is the EIP-1559 base fee per gas of the block.
+// V2 headers predate the London fork and carry no base fee.
+func (h *Header) BaseFee() *big.Int {
+	return nil
+}
+
+// SetBaseFee sets the EIP-1559 base fee per gas of the block.
+// V2 headers can't carry a base fee, so setting one fails.
+func (h *Header) SetBaseFee(newBaseFee *big.Int) error {
+	if newBaseFee != nil {
+		return errors.New("cannot store base fee in V2 header")
+	}
+	return nil
+}
+
 // field type overrides for gencodec
 type headerMarshaling struct {
 	Difficulty *hexutil.Big
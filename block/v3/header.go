@@ -8,6 +8,7 @@ import (
 	"github.com/ethereum/go-ethereum/common"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/rlp"
+	"github.com/pkg/errors"
 	"github.com/rs/zerolog"
 
 	blockif "github.com/harmony-one/harmony/block/interface"
@@ -380,6 +381,21 @@ func (h *Header) SetSlashes(newSlashes []byte) {
 	h.fields.Slashes = append(newSlashes[:0:0], newSlashes...)
 }
 
+// BaseFee is the EIP-1559 base fee per gas of the block.
+// V3 headers predate the London fork and carry no base fee.
+func (h *Header) BaseFee() *big.Int {
+	return nil
+}
+
+// SetBaseFee sets the EIP-1559 base fee per gas of the block.
+// V3 headers can't carry a base fee, so setting one fails.
+func (h *Header) SetBaseFee(newBaseFee *big.Int) error {
+	if newBaseFee != nil {
+		return errors.New("cannot store base fee in V3 header")
+	}
+	return nil
+}
+
 // Hash returns the block hash of the header, which is simply the keccak256 hash of its
 // RLP encoding.
 func (h *Header) Hash() common.Hash {
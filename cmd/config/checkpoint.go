@@ -0,0 +1,97 @@
+package config
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+
+	"github.com/ethereum/go-ethereum/ethdb"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+
+	"github.com/harmony-one/harmony/core/rawdb"
+	"github.com/harmony-one/harmony/internal/cli"
+	shardingconfig "github.com/harmony-one/harmony/internal/configs/sharding"
+	"github.com/harmony-one/harmony/internal/shardchain"
+	"github.com/harmony-one/harmony/shard"
+)
+
+var checkpointCmd = &cobra.Command{
+	Use:   "checkpoint datadir [epoch]",
+	Short: "print the sync checkpoint of a beacon chain epoch.",
+	Long: "print the last beacon chain block of the epoch in the epoch:hash format of --sync.checkpoint, " +
+		"which is also the format of the built-in checkpoints of each release. The epoch defaults to the " +
+		"last finished epoch of the local beacon chain.",
+	Example: "harmony checkpoint --network mainnet /data",
+	Args:    cobra.RangeArgs(1, 2),
+	Run: func(cmd *cobra.Command, args []string) {
+		dataDir := args[0]
+		var epoch *uint64
+		if len(args) > 1 {
+			e, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				fmt.Println("invalid epoch:", args[1])
+				os.Exit(128)
+			}
+			epoch = &e
+		}
+		schedule := getShardSchedule(getNetworkType(cmd))
+		if schedule == nil {
+			fmt.Println("unsupported network type")
+			os.Exit(128)
+		}
+		factory := &shardchain.LDBFactory{RootDir: dataDir}
+		db, err := factory.NewChainDB(shard.BeaconChainShardID)
+		if err != nil {
+			fmt.Println("open chain db error:", err)
+			os.Exit(-1)
+		}
+		defer db.Close()
+
+		cp, err := readCheckpoint(db, schedule, epoch)
+		if err != nil {
+			fmt.Println("checkpoint error:", err)
+			os.Exit(-1)
+		}
+		fmt.Println(cp.String())
+	},
+}
+
+func registerCheckpointFlags() error {
+	return cli.RegisterFlags(checkpointCmd, []cli.Flag{networkTypeFlag})
+}
+
+// readCheckpoint reads the checkpoint of the epoch from the beacon chain db,
+// the epoch defaults to the one before the head block epoch.
+func readCheckpoint(db ethdb.Reader, schedule shardingconfig.Schedule, epoch *uint64) (*shardingconfig.Checkpoint, error) {
+	headHash := rawdb.ReadHeadBlockHash(db)
+	headNumber := rawdb.ReadHeaderNumber(db, headHash)
+	if headNumber == nil {
+		return nil, errors.New("no head block in the chain db")
+	}
+	head := rawdb.ReadHeader(db, headHash, *headNumber)
+	if head == nil {
+		return nil, errors.Errorf("missing head header %v", headHash.Hex())
+	}
+	if epoch == nil {
+		if head.Epoch().Uint64() == 0 {
+			return nil, errors.New("no finished epoch in the chain db")
+		}
+		e := head.Epoch().Uint64() - 1
+		epoch = &e
+	}
+
+	number := schedule.EpochLastBlock(*epoch)
+	if number > *headNumber {
+		return nil, errors.Errorf("epoch %v is not finished, the head block is %v", *epoch, *headNumber)
+	}
+	hash := rawdb.ReadCanonicalHash(db, number)
+	header := rawdb.ReadHeader(db, hash, number)
+	if header == nil {
+		return nil, errors.Errorf("missing canonical header %v", number)
+	}
+	if header.Epoch().Uint64() != *epoch || !header.IsLastBlockInEpoch() {
+		return nil, errors.Errorf("block %v is not the last block of epoch %v", number, *epoch)
+	}
+	return &shardingconfig.Checkpoint{Epoch: *epoch, Hash: hash}, nil
+}
@@ -0,0 +1,41 @@
+package config
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/harmony-one/harmony/block"
+	blockfactory "github.com/harmony-one/harmony/block/factory"
+	"github.com/harmony-one/harmony/core/rawdb"
+	shardingconfig "github.com/harmony-one/harmony/internal/configs/sharding"
+)
+
+func TestReadCheckpoint(t *testing.T) {
+	db := rawdb.NewMemoryDatabase()
+	schedule := shardingconfig.LocalnetSchedule
+
+	_, err := readCheckpoint(db, schedule, nil)
+	require.Error(t, err)
+
+	number := schedule.EpochLastBlock(1)
+	last := blockfactory.NewTestHeader().With().
+		Number(new(big.Int).SetUint64(number)).Epoch(big.NewInt(1)).ShardState([]byte{1}).Header()
+	head := blockfactory.NewTestHeader().With().
+		Number(new(big.Int).SetUint64(number + 1)).Epoch(big.NewInt(2)).Header()
+	for _, header := range []*block.Header{last, head} {
+		require.NoError(t, rawdb.WriteHeader(db, header))
+		require.NoError(t, rawdb.WriteCanonicalHash(db, header.Hash(), header.Number().Uint64()))
+	}
+	require.NoError(t, rawdb.WriteHeadBlockHash(db, head.Hash()))
+
+	cp, err := readCheckpoint(db, schedule, nil)
+	require.NoError(t, err)
+	require.Equal(t, shardingconfig.Checkpoint{Epoch: 1, Hash: last.Hash()}, *cp)
+
+	// the epoch 2 is not finished yet
+	epoch := uint64(2)
+	_, err = readCheckpoint(db, schedule, &epoch)
+	require.Error(t, err)
+}
@@ -11,6 +11,7 @@ import (
 	"github.com/harmony-one/harmony/internal/cli"
 	harmonyconfig "github.com/harmony-one/harmony/internal/configs/harmony"
 	nodeconfig "github.com/harmony-one/harmony/internal/configs/node"
+	shardingconfig "github.com/harmony-one/harmony/internal/configs/sharding"
 	"github.com/pelletier/go-toml"
 	"github.com/spf13/cobra"
 )
@@ -56,6 +57,12 @@ func validateHarmonyConfig(config harmonyconfig.HarmonyConfig) error {
 		return errors.New("either --sync.downloader or --sync.legacy.client shall be enabled")
 	}
 
+	if config.Sync.Checkpoint != "" {
+		if _, err := shardingconfig.ParseCheckpoint(config.Sync.Checkpoint); err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
@@ -407,6 +414,13 @@ func Init(rootCmd *cobra.Command) {
 	rootCmd.AddCommand(dumpConfigLegacyCmd)
 	rootCmd.AddCommand(dumpDBCmd)
 	rootCmd.AddCommand(inspectDBCmd)
+	rootCmd.AddCommand(exportChainCmd)
+	rootCmd.AddCommand(importChainCmd)
+	rootCmd.AddCommand(pruneStateCmd)
+	rootCmd.AddCommand(checkpointCmd)
+	slashingProtectionCmd.AddCommand(slashingProtectionExportCmd)
+	slashingProtectionCmd.AddCommand(slashingProtectionImportCmd)
+	rootCmd.AddCommand(slashingProtectionCmd)
 
 	if err := registerRootCmdFlags(rootCmd); err != nil {
 		os.Exit(2)
@@ -420,4 +434,16 @@ func Init(rootCmd *cobra.Command) {
 	if err := registerInspectionFlags(); err != nil {
 		os.Exit(2)
 	}
+	if err := registerExportChainFlags(); err != nil {
+		os.Exit(2)
+	}
+	if err := registerImportChainFlags(); err != nil {
+		os.Exit(2)
+	}
+	if err := registerPruneStateFlags(); err != nil {
+		os.Exit(2)
+	}
+	if err := registerCheckpointFlags(); err != nil {
+		os.Exit(2)
+	}
 }
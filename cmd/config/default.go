@@ -26,6 +26,7 @@ var defaultConfig = harmonyconfig.HarmonyConfig{
 		IsOffline:        false,
 		DataDir:          "./",
 		TraceEnable:      false,
+		EnablePruneState: false,
 	},
 	Network:  GetDefaultNetworkConfig(defNetworkType),
 	Localnet: GetDefaultLocalnetConfig(),
@@ -0,0 +1,182 @@
+package config
+
+import (
+	"bufio"
+	"compress/gzip"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/ethdb"
+	"github.com/ethereum/go-ethereum/rlp"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+
+	"github.com/harmony-one/harmony/core/rawdb"
+	"github.com/harmony-one/harmony/core/types"
+	"github.com/harmony-one/harmony/internal/cli"
+	"github.com/harmony-one/harmony/internal/shardchain"
+)
+
+var chainShardFlag = cli.IntFlag{
+	Name:     "shard",
+	Usage:    "shard ID of the chain",
+	DefValue: 0,
+}
+
+var exportChainCmd = &cobra.Command{
+	Use:   "export-chain datadir file [from] [to]",
+	Short: "export blocks to a file.",
+	Long: "export the canonical blocks of a range, along with their receipts and commit signatures, " +
+		"to an RLP file. The file is gzipped if its name ends with .gz. The range defaults to the whole chain.",
+	Example: "harmony export-chain --shard 0 /data/db /backup/shard0.rlp.gz 0 100000",
+	Args:    cobra.RangeArgs(2, 4),
+	Run: func(cmd *cobra.Command, args []string) {
+		dataDir, fileName := args[0], args[1]
+		shardID := uint32(cli.GetIntFlagValue(cmd, chainShardFlag))
+		from, to, err := parseBlockRange(args[2:])
+		if err != nil {
+			fmt.Println(err)
+			os.Exit(128)
+		}
+		if err := exportChainMain(dataDir, shardID, fileName, from, to); err != nil {
+			fmt.Println("export chain error:", err)
+			os.Exit(-1)
+		}
+		os.Exit(0)
+	},
+}
+
+func registerExportChainFlags() error {
+	return cli.RegisterFlags(exportChainCmd, []cli.Flag{chainShardFlag})
+}
+
+// exportedBlock is a single entry of an exported chain file. The receipts are
+// kept in their storage encoding.
+type exportedBlock struct {
+	Block     *types.Block
+	Receipts  rlp.RawValue
+	CommitSig []byte
+}
+
+// parseBlockRange parses the optional [from] [to] arguments, to is nil when
+// the range runs up to the head block
+func parseBlockRange(args []string) (uint64, *uint64, error) {
+	var (
+		from uint64
+		to   *uint64
+	)
+	if len(args) > 0 {
+		n, err := strconv.ParseUint(args[0], 10, 64)
+		if err != nil {
+			return 0, nil, errors.Wrap(err, "invalid first block number")
+		}
+		from = n
+	}
+	if len(args) > 1 {
+		n, err := strconv.ParseUint(args[1], 10, 64)
+		if err != nil {
+			return 0, nil, errors.Wrap(err, "invalid last block number")
+		}
+		if n < from {
+			return 0, nil, fmt.Errorf("last block %d is before first block %d", n, from)
+		}
+		to = &n
+	}
+	return from, to, nil
+}
+
+func exportChainMain(dataDir string, shardID uint32, fileName string, from uint64, to *uint64) error {
+	fmt.Println("===exportChain===")
+	db, err := (&shardchain.LDBFactory{RootDir: dataDir}).NewChainDB(shardID)
+	if err != nil {
+		return errors.Wrap(err, "open chain db")
+	}
+	defer db.Close()
+
+	headHash := rawdb.ReadHeadBlockHash(db)
+	headNumber := rawdb.ReadHeaderNumber(db, headHash)
+	if headNumber == nil {
+		return errors.New("empty chain db")
+	}
+	last := *headNumber
+	if to != nil {
+		if *to > last {
+			return fmt.Errorf("last block %d is beyond the head block %d", *to, last)
+		}
+		last = *to
+	}
+
+	file, err := os.OpenFile(fileName, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, 0644)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+	buffered := bufio.NewWriter(file)
+	var w io.Writer = buffered
+	if strings.HasSuffix(fileName, ".gz") {
+		gz := gzip.NewWriter(buffered)
+		defer gz.Close()
+		w = gz
+	}
+
+	fmt.Printf("exporting blocks %d - %d of shard %d\n", from, last, shardID)
+	if err := exportChain(db, w, from, last); err != nil {
+		return err
+	}
+	if gz, ok := w.(*gzip.Writer); ok {
+		if err := gz.Close(); err != nil {
+			return err
+		}
+	}
+	if err := buffered.Flush(); err != nil {
+		return err
+	}
+	fmt.Println("chain export completed!")
+	return nil
+}
+
+// exportChain writes the canonical blocks from first to last into w
+func exportChain(db ethdb.Reader, w io.Writer, first, last uint64) error {
+	for n := first; n <= last; n++ {
+		hash := rawdb.ReadCanonicalHash(db, n)
+		if hash == (common.Hash{}) {
+			return fmt.Errorf("canonical hash of block %d not found", n)
+		}
+		block := rawdb.ReadBlock(db, hash, n)
+		if block == nil {
+			return fmt.Errorf("block %d not found", n)
+		}
+		// the genesis block is not signed
+		var commitSig []byte
+		if n > 0 {
+			sig, err := rawdb.ReadBlockCommitSig(db, n)
+			if err != nil {
+				return err
+			}
+			commitSig = sig
+		}
+		entry := exportedBlock{
+			Block:     block,
+			Receipts:  rawdb.ReadReceiptsRLP(db, hash, n),
+			CommitSig: commitSig,
+		}
+		if len(entry.Receipts) == 0 {
+			entry.Receipts = rlp.EmptyList
+		}
+		if err := rlp.Encode(w, &entry); err != nil {
+			return errors.Wrapf(err, "encode block %d", n)
+		}
+		if n%10000 == 0 && n != first {
+			fmt.Println("exported block", n)
+		}
+		if n == last {
+			// avoid overflowing when exporting up to the max uint64
+			break
+		}
+	}
+	return nil
+}
@@ -0,0 +1,69 @@
+package config
+
+import (
+	"bytes"
+	"io"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/rlp"
+	"github.com/stretchr/testify/require"
+
+	blockfactory "github.com/harmony-one/harmony/block/factory"
+	"github.com/harmony-one/harmony/core/rawdb"
+	"github.com/harmony-one/harmony/core/types"
+)
+
+func TestParseBlockRange(t *testing.T) {
+	from, to, err := parseBlockRange(nil)
+	require.NoError(t, err)
+	require.Equal(t, uint64(0), from)
+	require.Nil(t, to)
+
+	from, to, err = parseBlockRange([]string{"10", "20"})
+	require.NoError(t, err)
+	require.Equal(t, uint64(10), from)
+	require.Equal(t, uint64(20), *to)
+
+	_, _, err = parseBlockRange([]string{"20", "10"})
+	require.Error(t, err)
+	_, _, err = parseBlockRange([]string{"abc"})
+	require.Error(t, err)
+}
+
+func TestExportChain(t *testing.T) {
+	db := rawdb.NewMemoryDatabase()
+	var blocks []*types.Block
+	for n := int64(0); n < 4; n++ {
+		header := blockfactory.NewTestHeader().With().Number(big.NewInt(n)).Header()
+		block := types.NewBlockWithHeader(header)
+		require.NoError(t, rawdb.WriteBlock(db, block))
+		require.NoError(t, rawdb.WriteCanonicalHash(db, block.Hash(), block.NumberU64()))
+		receipts := types.Receipts{{Status: types.ReceiptStatusSuccessful, CumulativeGasUsed: uint64(n)}}
+		require.NoError(t, rawdb.WriteReceipts(db, block.Hash(), block.NumberU64(), receipts))
+		if n > 0 {
+			require.NoError(t, rawdb.WriteBlockCommitSig(db, block.NumberU64(), bytes.Repeat([]byte{byte(n)}, 100)))
+		}
+		blocks = append(blocks, block)
+	}
+
+	var buf bytes.Buffer
+	require.NoError(t, exportChain(db, &buf, 1, 3))
+
+	stream := rlp.NewStream(&buf, 0)
+	for _, want := range blocks[1:] {
+		var entry exportedBlock
+		require.NoError(t, stream.Decode(&entry))
+		require.Equal(t, want.Hash(), entry.Block.Hash())
+		require.Equal(t, bytes.Repeat([]byte{byte(want.NumberU64())}, 100), entry.CommitSig)
+
+		var receipts []*types.ReceiptForStorage
+		require.NoError(t, rlp.DecodeBytes(entry.Receipts, &receipts))
+		require.Len(t, receipts, 1)
+		require.Equal(t, want.NumberU64(), receipts[0].CumulativeGasUsed)
+	}
+	var entry exportedBlock
+	require.Equal(t, io.EOF, stream.Decode(&entry))
+
+	require.Error(t, exportChain(db, &buf, 3, 4), "missing block must fail the export")
+}
@@ -24,6 +24,7 @@ var (
 		isBeaconArchiveFlag,
 		isOfflineFlag,
 		dataDirFlag,
+		pruneStateFlag,
 
 		legacyNodeTypeFlag,
 		legacyIsStakingFlag,
@@ -86,6 +87,7 @@ var (
 		httpReadTimeoutFlag,
 		httpWriteTimeoutFlag,
 		httpIdleTimeoutFlag,
+		httpAuthTokenFileFlag,
 	}
 
 	wsFlags = []cli.Flag{
@@ -105,6 +107,7 @@ var (
 		rpcRateLimiterEnabledFlag,
 		rpcRateLimitFlag,
 		rpcEvmCallTimeoutFlag,
+		rpcIPCPathFlag,
 	}
 
 	blsFlags = append(newBLSFlags, legacyBLSFlags...)
@@ -137,6 +140,8 @@ var (
 	consensusValidFlags = []cli.Flag{
 		consensusMinPeersFlag,
 		consensusAggregateSigFlag,
+		consensusEnableWALFlag,
+		consensusSlashingProtectionFlag,
 		legacyConsensusMinPeersFlag,
 	}
 
@@ -261,6 +266,7 @@ var (
 		syncDiscHardLowFlag,
 		syncDiscHighFlag,
 		syncDiscBatchFlag,
+		syncCheckpointFlag,
 	}
 
 	shardDataFlags = []cli.Flag{
@@ -339,6 +345,11 @@ var (
 		Usage:    "directory of chain database",
 		DefValue: defaultConfig.General.DataDir,
 	}
+	pruneStateFlag = cli.BoolFlag{
+		Name:     "run.prune-state",
+		Usage:    "prune the stale state once at startup, before the chain is loaded; the state is not pruned while the node runs, and only the default leveldb chain database is supported (requires cache.snapshot_limit > 0)",
+		DefValue: defaultConfig.General.EnablePruneState,
+	}
 	legacyNodeTypeFlag = cli.StringFlag{
 		Name:       "node_type",
 		Usage:      "run node type (validator, explorer)",
@@ -455,6 +466,10 @@ func applyGeneralFlags(cmd *cobra.Command, config *harmonyconfig.HarmonyConfig)
 	if cli.IsFlagChanged(cmd, isBackupFlag) {
 		config.General.IsBackup = cli.GetBoolFlagValue(cmd, isBackupFlag)
 	}
+
+	if cli.IsFlagChanged(cmd, pruneStateFlag) {
+		config.General.EnablePruneState = cli.GetBoolFlagValue(cmd, pruneStateFlag)
+	}
 }
 
 // network flags
@@ -829,6 +844,11 @@ var (
 		Usage:    "maximum amount of time to wait for the next request when keep-alives are enabled",
 		DefValue: defaultConfig.HTTP.IdleTimeout,
 	}
+	httpAuthTokenFileFlag = cli.StringFlag{
+		Name:     "http.auth-token-file",
+		Usage:    "file of the bearer token required on the auth port, enables the admin apis on the auth port",
+		DefValue: defaultConfig.HTTP.AuthTokenFile,
+	}
 )
 
 func applyHTTPFlags(cmd *cobra.Command, config *harmonyconfig.HarmonyConfig) {
@@ -875,6 +895,9 @@ func applyHTTPFlags(cmd *cobra.Command, config *harmonyconfig.HarmonyConfig) {
 	if cli.IsFlagChanged(cmd, httpIdleTimeoutFlag) {
 		config.HTTP.IdleTimeout = cli.GetStringFlagValue(cmd, httpIdleTimeoutFlag)
 	}
+	if cli.IsFlagChanged(cmd, httpAuthTokenFileFlag) {
+		config.HTTP.AuthTokenFile = cli.GetStringFlagValue(cmd, httpAuthTokenFileFlag)
+	}
 
 }
 
@@ -977,6 +1000,12 @@ var (
 		Usage:    "timeout for evm execution (eth_call); 0 means infinite timeout",
 		DefValue: defaultConfig.RPCOpt.EvmCallTimeout,
 	}
+
+	rpcIPCPathFlag = cli.StringFlag{
+		Name:     "rpc.ipc",
+		Usage:    "path of the IPC socket serving the auth and admin apis, relative to the data dir (empty to disable)",
+		DefValue: defaultConfig.RPCOpt.IPCPath,
+	}
 )
 
 func applyRPCOptFlags(cmd *cobra.Command, config *harmonyconfig.HarmonyConfig) {
@@ -1007,6 +1036,9 @@ func applyRPCOptFlags(cmd *cobra.Command, config *harmonyconfig.HarmonyConfig) {
 	if cli.IsFlagChanged(cmd, rpcEvmCallTimeoutFlag) {
 		config.RPCOpt.EvmCallTimeout = cli.GetStringFlagValue(cmd, rpcEvmCallTimeoutFlag)
 	}
+	if cli.IsFlagChanged(cmd, rpcIPCPathFlag) {
+		config.RPCOpt.IPCPath = cli.GetStringFlagValue(cmd, rpcIPCPathFlag)
+	}
 }
 
 // bls flags
@@ -1236,6 +1268,16 @@ var (
 		Usage:    "(multi-key) aggregate bls signatures before sending",
 		DefValue: defaultConsensusConfig.AggregateSig,
 	}
+	consensusEnableWALFlag = cli.BoolFlag{
+		Name:     "consensus.wal",
+		Usage:    "persist FBFT messages to a write-ahead log and replay it at startup",
+		DefValue: defaultConsensusConfig.EnableWAL,
+	}
+	consensusSlashingProtectionFlag = cli.BoolFlag{
+		Name:     "consensus.slashing-protection",
+		Usage:    "keep the signing history of the bls keys and refuse to sign conflicting messages",
+		DefValue: defaultConsensusConfig.EnableSlashingProtection,
+	}
 	legacyDelayCommitFlag = cli.StringFlag{
 		Name:       "delay_commit",
 		Usage:      "how long to delay sending commit messages in consensus, ex: 500ms, 1s",
@@ -1284,6 +1326,14 @@ func applyConsensusFlags(cmd *cobra.Command, config *harmonyconfig.HarmonyConfig
 	if cli.IsFlagChanged(cmd, consensusAggregateSigFlag) {
 		config.Consensus.AggregateSig = cli.GetBoolFlagValue(cmd, consensusAggregateSigFlag)
 	}
+
+	if cli.IsFlagChanged(cmd, consensusEnableWALFlag) {
+		config.Consensus.EnableWAL = cli.GetBoolFlagValue(cmd, consensusEnableWALFlag)
+	}
+
+	if cli.IsFlagChanged(cmd, consensusSlashingProtectionFlag) {
+		config.Consensus.EnableSlashingProtection = cli.GetBoolFlagValue(cmd, consensusSlashingProtectionFlag)
+	}
 }
 
 // transaction pool flags
@@ -2039,6 +2089,10 @@ var (
 		Usage:  "batch size of the sync discovery",
 		Hidden: true,
 	}
+	syncCheckpointFlag = cli.StringFlag{
+		Name:  "sync.checkpoint",
+		Usage: "trusted beacon chain checkpoint to start the sync from, in epoch:blockhash format (default: the latest built-in checkpoint of the network)",
+	}
 )
 
 // applySyncFlags apply the sync flags.
@@ -2090,6 +2144,10 @@ func applySyncFlags(cmd *cobra.Command, config *harmonyconfig.HarmonyConfig) {
 	if cli.IsFlagChanged(cmd, syncDiscBatchFlag) {
 		config.Sync.DiscBatch = cli.GetIntFlagValue(cmd, syncDiscBatchFlag)
 	}
+
+	if cli.IsFlagChanged(cmd, syncCheckpointFlag) {
+		config.Sync.Checkpoint = cli.GetStringFlagValue(cmd, syncCheckpointFlag)
+	}
 }
 
 // shard data flags
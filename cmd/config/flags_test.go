@@ -341,6 +341,17 @@ func TestGeneralFlags(t *testing.T) {
 				DataDir:    "./",
 			},
 		},
+		{
+			args: []string{"--run.prune-state"},
+			expConfig: harmonyconfig.GeneralConfig{
+				NodeType:         "validator",
+				NoStaking:        false,
+				ShardID:          -1,
+				IsArchival:       false,
+				DataDir:          "./",
+				EnablePruneState: true,
+			},
+		},
 	}
 	for i, test := range tests {
 		ts := newFlagTestSuite(t, generalFlags, applyGeneralFlags)
@@ -870,6 +881,21 @@ func TestRPCFlags(t *testing.T) {
 				IdleTimeout:    "30s",
 			},
 		},
+		{
+			args: []string{"--http.auth-token-file", "./.hmy/auth_token"},
+			expConfig: harmonyconfig.HttpConfig{
+				Enabled:        true,
+				RosettaEnabled: false,
+				IP:             defaultConfig.HTTP.IP,
+				Port:           defaultConfig.HTTP.Port,
+				AuthPort:       defaultConfig.HTTP.AuthPort,
+				RosettaPort:    defaultConfig.HTTP.RosettaPort,
+				ReadTimeout:    defaultConfig.HTTP.ReadTimeout,
+				WriteTimeout:   defaultConfig.HTTP.WriteTimeout,
+				IdleTimeout:    defaultConfig.HTTP.IdleTimeout,
+				AuthTokenFile:  "./.hmy/auth_token",
+			},
+		},
 	}
 	for i, test := range tests {
 		ts := newFlagTestSuite(t, append(httpFlags, legacyMiscFlags...),
@@ -1120,6 +1146,22 @@ func TestRPCOptFlags(t *testing.T) {
 				PreimagesEnabled:   true,
 			},
 		},
+
+		{
+			args: []string{"--rpc.ipc", "harmony.ipc"},
+			expConfig: harmonyconfig.RpcOptConfig{
+				DebugEnabled:       false,
+				EthRPCsEnabled:     true,
+				StakingRPCsEnabled: true,
+				LegacyRPCsEnabled:  true,
+				RpcFilterFile:      "./.hmy/rpc_filter.txt",
+				RateLimterEnabled:  true,
+				RequestsPerSecond:  1000,
+				EvmCallTimeout:     defaultConfig.RPCOpt.EvmCallTimeout,
+				PreimagesEnabled:   defaultConfig.RPCOpt.PreimagesEnabled,
+				IPCPath:            "harmony.ipc",
+			},
+		},
 	}
 	for i, test := range tests {
 		ts := newFlagTestSuite(t, rpcOptFlags, applyRPCOptFlags)
@@ -1240,6 +1282,22 @@ func TestConsensusFlags(t *testing.T) {
 				AggregateSig: true,
 			},
 		},
+		{
+			args: []string{"--consensus.wal"},
+			expConfig: &harmonyconfig.ConsensusConfig{
+				MinPeers:     6,
+				AggregateSig: true,
+				EnableWAL:    true,
+			},
+		},
+		{
+			args: []string{"--consensus.slashing-protection"},
+			expConfig: &harmonyconfig.ConsensusConfig{
+				MinPeers:                 6,
+				AggregateSig:             true,
+				EnableSlashingProtection: true,
+			},
+		},
 	}
 	for i, test := range tests {
 		ts := newFlagTestSuite(t, consensusFlags, applyConsensusFlags)
@@ -1962,6 +2020,15 @@ func TestSyncFlags(t *testing.T) {
 				return cfgSync
 			}(),
 		},
+		{
+			args:    []string{"--sync.checkpoint", "1200:0x5e1b0d5c4e1dbc6a6a3a0cc1cb6a4ca8d2bfa2e11d2c9f1c5e4b3a2d1c0b9a87"},
+			network: "mainnet",
+			expConfig: func() harmonyconfig.SyncConfig {
+				cfgSync := defaultMainnetSyncConfig
+				cfgSync.Checkpoint = "1200:0x5e1b0d5c4e1dbc6a6a3a0cc1cb6a4ca8d2bfa2e11d2c9f1c5e4b3a2d1c0b9a87"
+				return cfgSync
+			}(),
+		},
 	}
 	for i, test := range tests {
 		ts := newFlagTestSuite(t, syncFlags, func(command *cobra.Command, config *harmonyconfig.HarmonyConfig) {
@@ -0,0 +1,222 @@
+package config
+
+import (
+	"bufio"
+	"compress/gzip"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/rlp"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+
+	"github.com/harmony-one/harmony/core"
+	"github.com/harmony-one/harmony/core/types"
+	"github.com/harmony-one/harmony/internal/chain"
+	"github.com/harmony-one/harmony/internal/cli"
+	nodeconfig "github.com/harmony-one/harmony/internal/configs/node"
+	"github.com/harmony-one/harmony/internal/shardchain"
+	"github.com/harmony-one/harmony/shard"
+)
+
+var chainArchiveFlag = cli.BoolFlag{
+	Name:     "archive",
+	Usage:    "import the chain in archive mode",
+	DefValue: true,
+}
+
+var chainEpochChainFlag = cli.BoolFlag{
+	Name:     "epoch-chain",
+	Usage:    "import a beacon chain file into the beacon epoch chain of a non-beacon shard node",
+	DefValue: false,
+}
+
+var importChainCmd = &cobra.Command{
+	Use:   "import-chain datadir file",
+	Short: "import blocks from a file.",
+	Long: "import the blocks of a file written by export-chain. The commit signature of every block " +
+		"is verified and the blocks are executed on top of the local chain, which is created from " +
+		"the genesis of the network if missing. The receipts of the file are not used, they are " +
+		"derived again by the execution. Non-beacon shards need the beacon epoch chain in the same " +
+		"datadir to verify the committees, which is imported from a beacon chain file with --epoch-chain.",
+	Example: "harmony import-chain --network mainnet --epoch-chain /data/db /backup/shard0.rlp.gz\n" +
+		"harmony import-chain --network mainnet --shard 1 /data/db /backup/shard1.rlp.gz",
+	Args: cobra.ExactArgs(2),
+	Run: func(cmd *cobra.Command, args []string) {
+		dataDir, fileName := args[0], args[1]
+		shardID := uint32(cli.GetIntFlagValue(cmd, chainShardFlag))
+		archive := cli.GetBoolFlagValue(cmd, chainArchiveFlag)
+		epochChain := cli.GetBoolFlagValue(cmd, chainEpochChainFlag)
+		networkType := getNetworkType(cmd)
+		if err := importChainMain(dataDir, shardID, archive, epochChain, networkType, fileName); err != nil {
+			fmt.Println("import chain error:", err)
+			os.Exit(-1)
+		}
+		os.Exit(0)
+	},
+}
+
+func registerImportChainFlags() error {
+	return cli.RegisterFlags(importChainCmd, []cli.Flag{chainShardFlag, chainArchiveFlag, chainEpochChainFlag, networkTypeFlag})
+}
+
+func importChainMain(dataDir string, shardID uint32, archive, epochChain bool, networkType nodeconfig.NetworkType, fileName string) error {
+	fmt.Println("===importChain===")
+	if epochChain && shardID != shard.BeaconChainShardID {
+		return errors.New("--epoch-chain imports a beacon chain file, it can't be used with another shard")
+	}
+	schedule := getShardSchedule(networkType)
+	if schedule == nil {
+		return errors.New("unsupported network type")
+	}
+	shard.Schedule = schedule
+	nodeconfig.SetShardingSchedule(schedule)
+	nodeconfig.SetNetworkType(networkType)
+
+	chainConfig := networkType.ChainConfig()
+	collection := shardchain.NewCollection(
+		nil, &shardchain.LDBFactory{RootDir: dataDir},
+		&core.GenesisInitializer{NetworkType: networkType}, chain.NewEngine(), &chainConfig,
+	)
+	defer collection.Close()
+	if archive && !epochChain {
+		collection.DisableCache(shardID)
+	}
+	var (
+		beacon core.BlockChain
+		err    error
+	)
+	if shardID != shard.BeaconChainShardID || epochChain {
+		beacon, err = collection.ShardChain(shard.BeaconChainShardID, core.Options{EpochChain: true})
+		if err != nil {
+			return errors.Wrap(err, "open beacon epoch chain")
+		}
+	}
+	bc := beacon
+	if !epochChain {
+		if bc, err = collection.ShardChain(shardID); err != nil {
+			return errors.Wrap(err, "open chain")
+		}
+	}
+
+	file, err := os.Open(fileName)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+	var r io.Reader = bufio.NewReader(file)
+	if strings.HasSuffix(fileName, ".gz") {
+		gz, err := gzip.NewReader(r)
+		if err != nil {
+			return err
+		}
+		defer gz.Close()
+		r = gz
+	}
+
+	fmt.Println("head-block:", bc.CurrentBlock().NumberU64(), bc.CurrentBlock().Hash().Hex())
+	var imported int
+	if epochChain {
+		imported, err = importEpochChain(bc, rlp.NewStream(r, 0))
+	} else {
+		imported, err = importChain(bc, beacon, rlp.NewStream(r, 0))
+	}
+	fmt.Println("imported blocks:", imported)
+	if err != nil {
+		return err
+	}
+	fmt.Println("head-block:", bc.CurrentBlock().NumberU64(), bc.CurrentBlock().Hash().Hex())
+	fmt.Println("chain import completed!")
+	return nil
+}
+
+// importChain inserts the blocks of the stream into the chain, skipping the
+// blocks that are already known. The committees of a non-beacon chain are read
+// from the beacon epoch chain. It returns the number of inserted blocks.
+func importChain(bc, beacon core.BlockChain, stream *rlp.Stream) (int, error) {
+	var imported int
+	for {
+		var entry exportedBlock
+		if err := stream.Decode(&entry); err == io.EOF {
+			return imported, nil
+		} else if err != nil {
+			return imported, errors.Wrap(err, "decode block")
+		}
+		block := entry.Block
+		if bc.HasBlock(block.Hash(), block.NumberU64()) {
+			continue
+		}
+		if block.NumberU64() == 0 {
+			return imported, fmt.Errorf("genesis mismatch: file %s, chain %s",
+				block.Hash().Hex(), bc.GetHeaderByNumber(0).Hash().Hex())
+		}
+		if head := bc.CurrentBlock().NumberU64(); block.NumberU64() != head+1 {
+			return imported, fmt.Errorf("block %d does not extend the head block %d", block.NumberU64(), head)
+		}
+		if beacon != nil {
+			if _, err := beacon.ReadShardState(block.Epoch()); err != nil {
+				return imported, errors.Wrapf(err, "no committee of epoch %v in the beacon epoch chain, "+
+					"import the beacon chain file with --epoch-chain first", block.Epoch())
+			}
+		}
+		if err := verifyExportedBlock(bc, &entry); err != nil {
+			return imported, errors.Wrapf(err, "block %d", block.NumberU64())
+		}
+		block.SetCurrentCommitSig(entry.CommitSig)
+		if _, err := bc.InsertChain(types.Blocks{block}, true); err != nil {
+			return imported, errors.Wrapf(err, "insert block %d", block.NumberU64())
+		}
+		imported++
+		if block.NumberU64()%10000 == 0 {
+			fmt.Println("imported block", block.NumberU64())
+		}
+	}
+}
+
+// importEpochChain inserts the last blocks of the epochs of a beacon chain
+// stream into the epoch chain, which verifies their commit signatures. The
+// other blocks are skipped. It returns the number of inserted blocks.
+func importEpochChain(bc core.BlockChain, stream *rlp.Stream) (int, error) {
+	var imported int
+	for {
+		var entry exportedBlock
+		if err := stream.Decode(&entry); err == io.EOF {
+			return imported, nil
+		} else if err != nil {
+			return imported, errors.Wrap(err, "decode block")
+		}
+		block := entry.Block
+		if block.NumberU64() <= bc.CurrentBlock().NumberU64() {
+			if hash := bc.GetCanonicalHash(block.NumberU64()); hash != (common.Hash{}) && hash != block.Hash() {
+				return imported, fmt.Errorf("block %d mismatch: file %s, chain %s",
+					block.NumberU64(), block.Hash().Hex(), hash.Hex())
+			}
+			continue
+		}
+		if !block.IsLastBlockInEpoch() {
+			continue
+		}
+		block.SetCurrentCommitSig(entry.CommitSig)
+		if _, err := bc.InsertChain(types.Blocks{block}, true); err != nil {
+			return imported, errors.Wrapf(err, "insert epoch block %d", block.NumberU64())
+		}
+		imported++
+		fmt.Println("imported epoch block", block.NumberU64(), "of epoch", block.Epoch())
+	}
+}
+
+// verifyExportedBlock checks the commit signature of the block against its
+// committee
+func verifyExportedBlock(bc core.BlockChain, entry *exportedBlock) error {
+	sig, bitmap, err := chain.ParseCommitSigAndBitmap(entry.CommitSig)
+	if err != nil {
+		return errors.Wrap(err, "parse commit sig")
+	}
+	if err := bc.Engine().VerifyHeaderSignature(bc, entry.Block.Header(), sig, bitmap); err != nil {
+		return errors.Wrap(err, "verify commit sig")
+	}
+	return nil
+}
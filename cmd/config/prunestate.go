@@ -0,0 +1,79 @@
+package config
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+
+	"github.com/harmony-one/harmony/core/state/pruner"
+	"github.com/harmony-one/harmony/internal/cli"
+	"github.com/harmony-one/harmony/internal/shardchain"
+)
+
+var pruneBloomSizeFlag = cli.Uint64Flag{
+	Name:     "bloomsize",
+	Usage:    "megabytes of memory allocated to the bloom filter, at least 256",
+	DefValue: pruner.DefaultBloomSize,
+}
+
+var pruneStateCmd = &cobra.Command{
+	Use:   "prune-state datadir [root]",
+	Short: "prune the stale state of a non-archival node.",
+	Long: "delete all the state trie nodes and contract codes that do not belong to the given state root " +
+		"or to the genesis state. The root defaults to the state 127 blocks below the head block. " +
+		"The live state is read from the snapshot, so the node must have been running with a " +
+		"snapshot (cache.SnapshotLimit > 0) and must be stopped. An interrupted pruning is resumed " +
+		"by running the command again or by starting the node.",
+	Example: "harmony prune-state --shard 1 /data",
+	Args:    cobra.RangeArgs(1, 2),
+	Run: func(cmd *cobra.Command, args []string) {
+		dataDir := args[0]
+		var root common.Hash
+		if len(args) > 1 {
+			b, err := hexutil.Decode(args[1])
+			if err != nil || len(b) != common.HashLength {
+				fmt.Println("invalid state root:", args[1])
+				os.Exit(128)
+			}
+			root = common.BytesToHash(b)
+		}
+		shardID := uint32(cli.GetIntFlagValue(cmd, chainShardFlag))
+		bloomSize := cli.GetUint64FlagValue(cmd, pruneBloomSizeFlag)
+		if err := pruneStateMain(dataDir, shardID, bloomSize, root); err != nil {
+			fmt.Println("prune state error:", err)
+			os.Exit(-1)
+		}
+		os.Exit(0)
+	},
+}
+
+func registerPruneStateFlags() error {
+	return cli.RegisterFlags(pruneStateCmd, []cli.Flag{chainShardFlag, pruneBloomSizeFlag})
+}
+
+func pruneStateMain(dataDir string, shardID uint32, bloomSize uint64, root common.Hash) error {
+	fmt.Println("===pruneState===")
+	factory := &shardchain.LDBFactory{RootDir: dataDir}
+	db, err := factory.NewChainDB(shardID)
+	if err != nil {
+		return errors.Wrap(err, "open chain db")
+	}
+	defer db.Close()
+
+	p, err := pruner.NewPruner(db, pruner.Config{
+		Datadir:   factory.StateBloomDir(shardID),
+		BloomSize: bloomSize,
+	})
+	if err != nil {
+		return errors.Wrap(err, "open snapshot")
+	}
+	if err := p.Prune(root); err != nil {
+		return err
+	}
+	fmt.Println("state pruning completed!")
+	return nil
+}
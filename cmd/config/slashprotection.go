@@ -0,0 +1,96 @@
+package config
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+
+	"github.com/harmony-one/harmony/consensus/slashprotection"
+)
+
+// SlashingProtectionDir is the directory of the slashing protection database
+// under the data directory
+const SlashingProtectionDir = "harmony_slashing_protection"
+
+var slashingProtectionCmd = &cobra.Command{
+	Use:   "slashing-protection",
+	Short: "manage the signing history of the local bls keys.",
+	Long: "export or import the signing history kept by the slashing protection database " +
+		"(--consensus.slashing-protection) in the interchange JSON format, to move validator " +
+		"keys between machines without signing conflicting consensus messages.",
+}
+
+var slashingProtectionExportCmd = &cobra.Command{
+	Use:     "export datadir file",
+	Short:   "export the signing history to a JSON file.",
+	Long:    "export the signing history of all the keys to a JSON file. The node must be stopped.",
+	Example: "harmony slashing-protection export /data history.json",
+	Args:    cobra.ExactArgs(2),
+	Run: func(cmd *cobra.Command, args []string) {
+		if err := exportSlashingProtection(args[0], args[1]); err != nil {
+			fmt.Println("export slashing protection error:", err)
+			os.Exit(-1)
+		}
+		os.Exit(0)
+	},
+}
+
+var slashingProtectionImportCmd = &cobra.Command{
+	Use:   "import datadir file",
+	Short: "import the signing history from a JSON file.",
+	Long: "merge the signing history of a JSON file into the slashing protection database. " +
+		"Messages signed differently on both machines are never signed again. The node must be stopped.",
+	Example: "harmony slashing-protection import /data history.json",
+	Args:    cobra.ExactArgs(2),
+	Run: func(cmd *cobra.Command, args []string) {
+		if err := importSlashingProtection(args[0], args[1]); err != nil {
+			fmt.Println("import slashing protection error:", err)
+			os.Exit(-1)
+		}
+		os.Exit(0)
+	},
+}
+
+func exportSlashingProtection(dataDir, fileName string) error {
+	db, err := slashprotection.Open(filepath.Join(dataDir, SlashingProtectionDir))
+	if err != nil {
+		return errors.Wrap(err, "open slashing protection db")
+	}
+	defer db.Close()
+
+	file, err := os.OpenFile(fileName, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0600)
+	if err != nil {
+		return err
+	}
+	if err := db.ExportJSON(file); err != nil {
+		file.Close()
+		return err
+	}
+	if err := file.Close(); err != nil {
+		return err
+	}
+	fmt.Println("signing history exported to", fileName)
+	return nil
+}
+
+func importSlashingProtection(dataDir, fileName string) error {
+	db, err := slashprotection.Open(filepath.Join(dataDir, SlashingProtectionDir))
+	if err != nil {
+		return errors.Wrap(err, "open slashing protection db")
+	}
+	defer db.Close()
+
+	file, err := os.Open(fileName)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+	if err := db.ImportJSON(file); err != nil {
+		return err
+	}
+	fmt.Println("signing history imported from", fileName)
+	return nil
+}
@@ -16,11 +16,11 @@ import (
 	reward "github.com/harmony-one/harmony/staking/reward"
 
 	ethCommon "github.com/ethereum/go-ethereum/common"
-	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/ethereum/go-ethereum/log"
 	"github.com/harmony-one/bls/ffi/go/bls"
 	"github.com/harmony-one/harmony/api/service"
 	"github.com/harmony-one/harmony/api/service/crosslink_sending"
+	"github.com/harmony-one/harmony/api/service/cx_receipts_pulling"
 	"github.com/harmony-one/harmony/api/service/pprof"
 	"github.com/harmony-one/harmony/api/service/prometheus"
 	"github.com/harmony-one/harmony/api/service/stagedstreamsync"
@@ -30,6 +30,7 @@ import (
 	"github.com/harmony-one/harmony/common/ntp"
 	"github.com/harmony-one/harmony/consensus"
 	"github.com/harmony-one/harmony/consensus/quorum"
+	"github.com/harmony-one/harmony/consensus/slashprotection"
 	"github.com/harmony-one/harmony/core"
 	"github.com/harmony-one/harmony/hmy/downloader"
 	"github.com/harmony-one/harmony/internal/chain"
@@ -379,6 +380,9 @@ func setupNodeAndRun(hc harmonyconfig.HarmonyConfig) {
 		currentNode.RegisterExplorerServices()
 	}
 	currentNode.RegisterService(service.CrosslinkSending, crosslink_sending.New(currentNode, currentNode.Blockchain()))
+	if hc.Sync.Enabled && hc.Sync.StagedSync && currentNode.NodeConfig.Role() == nodeconfig.Validator {
+		setupCXReceiptsPullingService(currentNode, myHost, hc)
+	}
 	if hc.Pprof.Enabled {
 		setupPprofService(currentNode, hc)
 	}
@@ -639,6 +643,9 @@ func createGlobalConfig(hc harmonyconfig.HarmonyConfig) (*nodeconfig.ConfigType,
 			os.Exit(1)
 		}
 		nodeConfig.WebHooks.Hooks = config
+		dispatcher := webhooks.NewDispatcher(config)
+		dispatcher.Start()
+		webhooks.SetDefaultDispatcher(dispatcher)
 	}
 
 	nodeConfig.NtpServer = hc.Sys.NtpServer
@@ -710,13 +717,19 @@ func setupConsensusAndNode(hc harmonyconfig.HarmonyConfig, nodeConfig *nodeconfi
 	// Parse minPeers from harmonyconfig.HarmonyConfig
 	var minPeers int
 	var aggregateSig bool
+	var enableWAL bool
+	var enableSlashingProtection bool
 	if hc.Consensus != nil {
 		minPeers = hc.Consensus.MinPeers
 		aggregateSig = hc.Consensus.AggregateSig
+		enableWAL = hc.Consensus.EnableWAL
+		enableSlashingProtection = hc.Consensus.EnableSlashingProtection
 	} else {
 		defaultConsensusConfig := harmonyConfigs.GetDefaultConsensusConfigCopy()
 		minPeers = defaultConsensusConfig.MinPeers
 		aggregateSig = defaultConsensusConfig.AggregateSig
+		enableWAL = defaultConsensusConfig.EnableWAL
+		enableSlashingProtection = defaultConsensusConfig.EnableSlashingProtection
 	}
 
 	blacklist, err := setupBlacklist(hc)
@@ -803,6 +816,27 @@ func setupConsensusAndNode(hc harmonyconfig.HarmonyConfig, nodeConfig *nodeconfi
 	// update consensus information based on the blockchain
 	currentConsensus.SetMode(currentConsensus.UpdateConsensusInformation("setupConsensusAndNode"))
 	currentConsensus.NextBlockDue = time.Now()
+
+	// Replay the FBFT messages of the round interrupted by the last shutdown
+	if enableWAL {
+		walDir := filepath.Join(hc.General.DataDir, fmt.Sprintf("harmony_consensus_wal_%d", nodeConfig.ShardID))
+		wal, err := consensus.OpenFBFTWAL(walDir)
+		if err != nil {
+			_, _ = fmt.Fprintf(os.Stderr, "Error :%v \n", err)
+			os.Exit(1)
+		}
+		if err := currentConsensus.SetWAL(wal); err != nil {
+			utils.Logger().Warn().Err(err).Msg("Replay consensus WAL failed")
+		}
+	}
+	if enableSlashingProtection {
+		protection, err := slashprotection.Open(filepath.Join(hc.General.DataDir, harmonyConfigs.SlashingProtectionDir))
+		if err != nil {
+			_, _ = fmt.Fprintf(os.Stderr, "Error :%v \n", err)
+			os.Exit(1)
+		}
+		currentConsensus.SetSlashProtection(protection)
+	}
 	return currentNode
 }
 
@@ -943,6 +977,21 @@ func setupStagedSyncService(node *node.Node, host p2p.Host, hc harmonyconfig.Har
 		DebugMode:            true, // hc.Sync.StagedSyncCfg.DebugMode,
 	}
 
+	// the checkpoint is only used by the beacon chain sync, the flag overrides
+	// the built-in checkpoint of the network
+	if hc.Sync.Checkpoint != "" {
+		cp, err := shardingconfig.ParseCheckpoint(hc.Sync.Checkpoint)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "ERROR invalid sync checkpoint: %s\n", err)
+			os.Exit(1)
+		}
+		sConfig.Checkpoint = cp
+	} else {
+		sConfig.Checkpoint = shardingconfig.LatestCheckpoint(shard.Schedule.GetNetworkID())
+	}
+	// an archival beacon node still executes every block from genesis
+	sConfig.StartFromCheckpoint = !node.NodeConfig.ArchiveModes()[shard.BeaconChainShardID]
+
 	// If we are running side chain, we will need to do some extra works for beacon
 	// sync.
 	if !node.IsRunningBeaconChain() {
@@ -962,80 +1011,24 @@ func setupStagedSyncService(node *node.Node, host p2p.Host, hc harmonyconfig.Har
 	}
 }
 
-func setupBlacklist(hc harmonyconfig.HarmonyConfig) (map[ethCommon.Address]struct{}, error) {
-	rosetta_common.InitRosettaFile(hc.TxPool.RosettaFixFile)
-
-	utils.Logger().Debug().Msgf("Using blacklist file at `%s`", hc.TxPool.BlacklistFile)
-	dat, err := os.ReadFile(hc.TxPool.BlacklistFile)
-	if err != nil {
-		return nil, err
-	}
-	addrMap := make(map[ethCommon.Address]struct{})
-	for _, line := range strings.Split(string(dat), "\n") {
-		if len(line) != 0 { // blacklist file may have trailing empty string line
-			b32 := strings.TrimSpace(strings.Split(string(line), "#")[0])
-			addr, err := common.ParseAddr(b32)
-			if err != nil {
-				return nil, err
-			}
-			addrMap[addr] = struct{}{}
-		}
+func setupCXReceiptsPullingService(node *node.Node, host p2p.Host, hc harmonyconfig.HarmonyConfig) {
+	// the crosslinks of the source shards are read from the beacon chain
+	beacon := node.Beaconchain()
+	if beacon == nil {
+		return
 	}
-	return addrMap, nil
+	s := cx_receipts_pulling.New(host, node, node.Blockchain(), beacon, nodeconfig.NetworkType(hc.Network.NetworkType))
+	node.RegisterService(service.CXReceiptsPulling, s)
 }
 
-func parseAllowedTxs(data []byte) (map[ethCommon.Address][]core.AllowedTxData, error) {
-	allowedTxs := make(map[ethCommon.Address][]core.AllowedTxData)
-	for _, line := range strings.Split(string(data), "\n") {
-		line = strings.TrimSpace(line)
-		if len(line) != 0 { // AllowedTxs file may have trailing empty string line
-			substrings := strings.Split(string(line), "->")
-			fromStr := strings.TrimSpace(substrings[0])
-			txSubstrings := strings.Split(substrings[1], ":")
-			toStr := strings.TrimSpace(txSubstrings[0])
-			dataStr := strings.TrimSpace(txSubstrings[1])
-			from, err := common.ParseAddr(fromStr)
-			if err != nil {
-				return nil, err
-			}
-			to, err := common.ParseAddr(toStr)
-			if err != nil {
-				return nil, err
-			}
-			data, err := hexutil.Decode(dataStr)
-			if err != nil {
-				return nil, err
-			}
-			allowedTxs[from] = append(allowedTxs[from], core.AllowedTxData{
-				To:   to,
-				Data: data,
-			})
-		}
-	}
-	return allowedTxs, nil
+func setupBlacklist(hc harmonyconfig.HarmonyConfig) (map[ethCommon.Address]struct{}, error) {
+	rosetta_common.InitRosettaFile(hc.TxPool.RosettaFixFile)
+
+	return core.ReadBlacklistFile(hc.TxPool.BlacklistFile)
 }
 
 func setupAllowedTxs(hc harmonyconfig.HarmonyConfig) (map[ethCommon.Address][]core.AllowedTxData, error) {
-	// check if the file exists
-	if _, err := os.Stat(hc.TxPool.AllowedTxsFile); err == nil {
-		// read the file and parse allowed transactions
-		utils.Logger().Debug().Msgf("Using AllowedTxs file at `%s`", hc.TxPool.AllowedTxsFile)
-		data, err := os.ReadFile(hc.TxPool.AllowedTxsFile)
-		if err != nil {
-			return nil, err
-		}
-		return parseAllowedTxs(data)
-	} else if errors.Is(err, os.ErrNotExist) {
-		// file path does not exist
-		utils.Logger().Debug().
-			Str("AllowedTxsFile", hc.TxPool.AllowedTxsFile).
-			Msg("AllowedTxs file doesn't exist")
-		return make(map[ethCommon.Address][]core.AllowedTxData), nil
-	} else {
-		// some other errors happened
-		utils.Logger().Error().Err(err).Msg("setup allowedTxs failed")
-		return nil, err
-	}
+	return core.ReadAllowedTxsFile(hc.TxPool.AllowedTxsFile)
 }
 
 func setupLocalAccounts(hc harmonyconfig.HarmonyConfig, blacklist map[ethCommon.Address]struct{}) ([]ethCommon.Address, error) {
@@ -38,7 +38,7 @@ func TestAllowedTxsParse(t *testing.T) {
 			},
 		},
 	}
-	got, err := parseAllowedTxs(testData)
+	got, err := core.ParseAllowedTxs(testData)
 	if err != nil {
 		t.Fatal(err)
 	}
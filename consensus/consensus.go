@@ -11,6 +11,7 @@ import (
 	bls_core "github.com/harmony-one/bls/ffi/go/bls"
 	"github.com/harmony-one/harmony/consensus/engine"
 	"github.com/harmony-one/harmony/consensus/quorum"
+	"github.com/harmony-one/harmony/consensus/slashprotection"
 	"github.com/harmony-one/harmony/core"
 	"github.com/harmony-one/harmony/core/types"
 	"github.com/harmony-one/harmony/crypto/bls"
@@ -131,6 +132,8 @@ type Consensus struct {
 	NextBlockDue time.Time
 	// Temporary flag to control whether aggregate signature signing is enabled
 	AggregateSig bool
+	// slashProtection keeps the signing history of the local keys, nil if disabled
+	slashProtection *slashprotection.DB
 
 	// TODO (leo): an new metrics system to keep track of the consensus/viewchange
 	// finality of previous consensus in the unit of milliseconds
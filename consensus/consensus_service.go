@@ -12,6 +12,7 @@ import (
 	consensus_engine "github.com/harmony-one/harmony/consensus/engine"
 	"github.com/harmony-one/harmony/consensus/quorum"
 	"github.com/harmony-one/harmony/consensus/signature"
+	"github.com/harmony-one/harmony/consensus/slashprotection"
 	"github.com/harmony-one/harmony/core"
 	"github.com/harmony-one/harmony/core/types"
 	"github.com/harmony-one/harmony/crypto/bls"
@@ -122,6 +123,14 @@ func (consensus *Consensus) signMessage(message []byte, priKey *bls_core.SecretK
 // Sign on the consensus message signature field.
 func (consensus *Consensus) signConsensusMessage(message *msg_pb.Message,
 	priKey *bls_core.SecretKey) error {
+	if request := message.GetConsensus(); request != nil && message.Type == msg_pb.MessageType_ANNOUNCE {
+		pub := bls_cosi.FromLibBLSPublicKeyUnsafe(priKey.GetPublicKey())
+		if err := consensus.checkSigningHistory(
+			*pub, slashprotection.Announce, request.BlockNum, request.ViewId, request.BlockHash,
+		); err != nil {
+			return err
+		}
+	}
 	message.Signature = nil
 	marshaledMessage, err := protobuf.Marshal(message)
 	if err != nil {
@@ -638,6 +647,16 @@ func (consensus *Consensus) selfCommit(payload []byte) error {
 	commitPayload := signature.ConstructCommitPayload(consensus.ChainReader().Config(),
 		block.Epoch(), block.Hash(), block.NumberU64(), block.Header().ViewID().Uint64())
 	for i, key := range consensus.priKey {
+		if err := consensus.checkSigningHistory(
+			key.Pub.Bytes, slashprotection.Commit, block.NumberU64(), block.Header().ViewID().Uint64(), commitPayload,
+		); err != nil {
+			consensus.getLogger().Warn().
+				Err(err).
+				Int("Index", i).
+				Str("Key", key.Pub.Bytes.Hex()).
+				Msg("[selfCommit] Commit refused by slashing protection")
+			continue
+		}
 		if err := consensus.commitBitmap.SetKey(key.Pub.Bytes, true); err != nil {
 			consensus.getLogger().Error().
 				Err(err).
@@ -646,7 +665,6 @@ func (consensus *Consensus) selfCommit(payload []byte) error {
 				Msg("[selfCommit] New Leader commit bitmap set failed")
 			continue
 		}
-
 		if _, err := consensus.decider.AddNewVote(
 			quorum.Commit,
 			[]*bls_cosi.PublicKeyWrapper{key.Pub},
@@ -715,17 +733,10 @@ func (consensus *Consensus) BlockVerifier(newBlock *types.Block) error {
 		default:
 		}
 
-		if hooks := consensus.registry.GetWebHooks(); hooks != nil {
-			if p := hooks.ProtocolIssues; p != nil {
-				url := p.OnCannotCommit
-				go func() {
-					webhooks.DoPost(url, map[string]interface{}{
-						"bad-header": newBlock.Header(),
-						"reason":     err.Error(),
-					})
-				}()
-			}
-		}
+		webhooks.Publish(webhooks.EventCannotCommit, map[string]interface{}{
+			"bad-header": newBlock.Header(),
+			"reason":     err.Error(),
+		})
 		utils.Logger().Error().
 			Str("blockHash", newBlock.Hash().Hex()).
 			Int("numTx", len(newBlock.Transactions())).
@@ -25,6 +25,7 @@ import (
 	"github.com/harmony-one/harmony/numeric"
 	"github.com/harmony-one/harmony/p2p"
 	"github.com/harmony-one/harmony/shard"
+	"github.com/harmony-one/harmony/webhooks"
 	"github.com/harmony-one/vdf/src/vdf_go"
 	libp2p_peer "github.com/libp2p/go-libp2p/core/peer"
 	"github.com/pkg/errors"
@@ -403,6 +404,12 @@ func (consensus *Consensus) syncNotReadyChan(reason string) {
 	consensus.getLogger().Info().Msgf("[ConsensusMainLoop] syncNotReadyChan, prev %s, reason %s", mode.String(), reason)
 	consensus.getLogger().Info().Msgf("[ConsensusMainLoop] Node is OUT OF SYNC, reason: %s", reason)
 	consensusSyncCounterVec.With(prometheus.Labels{"consensus": "out_of_sync"}).Inc()
+	webhooks.Publish(webhooks.EventOutOfSync, map[string]interface{}{
+		"shard-id":  consensus.ShardID,
+		"block-num": consensus.getBlockNum(),
+		"mode":      mode.String(),
+		"reason":    reason,
+	})
 }
 
 func (consensus *Consensus) Tick() {
@@ -878,6 +885,15 @@ func (consensus *Consensus) setupForNewConsensus(blk *types.Block, committedMsg
 				Bool("NodeWasPreviousLeader", wasLeader).
 				Bool("LeaderChanged", newLeader).
 				Msg("Leader change evaluation")
+			if newLeader {
+				webhooks.Publish(webhooks.EventLeaderRotated, map[string]interface{}{
+					"shard-id":        consensus.ShardID,
+					"block-num":       blk.NumberU64() + 1,
+					"epoch":           epoch.Uint64(),
+					"previous-leader": prev.Bytes.Hex(),
+					"leader":          next.Bytes.Hex(),
+				})
+			}
 
 			if consensus.isLeader() && newLeader && !wasLeader {
 				// leader changed
@@ -895,6 +911,7 @@ func (consensus *Consensus) setupForNewConsensus(blk *types.Block, committedMsg
 		consensus.setMode(consensus.updateConsensusInformation("setupForNewConsensus"))
 	}
 	consensus.fBFTLog.PruneCacheBeforeBlock(blk.NumberU64())
+	consensus.pruneSigningHistory(blk.NumberU64())
 	consensus.resetState()
 	consensus.sendLastSignPower()
 }
@@ -8,6 +8,7 @@ import (
 	"github.com/harmony-one/harmony/api/proto"
 	msg_pb "github.com/harmony-one/harmony/api/proto/message"
 	"github.com/harmony-one/harmony/consensus/quorum"
+	"github.com/harmony-one/harmony/consensus/slashprotection"
 	"github.com/harmony-one/harmony/crypto/bls"
 	"github.com/harmony-one/harmony/internal/utils"
 	protobuf "google.golang.org/protobuf/proto"
@@ -99,6 +100,11 @@ func (consensus *Consensus) construct(
 		needMsgSig = false
 		sig := bls_core.Sign{}
 		for _, priKey := range priKeys {
+			if err := consensus.checkSigningHistory(
+				priKey.Pub.Bytes, slashprotection.Prepare, consensusMsg.BlockNum, consensusMsg.ViewId, consensusMsg.BlockHash,
+			); err != nil {
+				return nil, err
+			}
 			if s := priKey.Pri.SignHash(consensusMsg.BlockHash); s != nil {
 				sig.Add(s)
 			}
@@ -108,6 +114,11 @@ func (consensus *Consensus) construct(
 		needMsgSig = false
 		sig := bls_core.Sign{}
 		for _, priKey := range priKeys {
+			if err := consensus.checkSigningHistory(
+				priKey.Pub.Bytes, slashprotection.Commit, consensusMsg.BlockNum, consensusMsg.ViewId, payloadForSign,
+			); err != nil {
+				return nil, err
+			}
 			if s := priKey.Pri.SignHash(payloadForSign); s != nil {
 				sig.Add(s)
 			}
@@ -16,6 +16,7 @@ import (
 	"github.com/harmony-one/harmony/core/types"
 	"github.com/harmony-one/harmony/crypto/bls"
 	bls_cosi "github.com/harmony-one/harmony/crypto/bls"
+	"github.com/harmony-one/harmony/internal/utils"
 )
 
 // FBFTMessage is the record of pbft messages received by a node during FBFT process
@@ -129,6 +130,7 @@ type FBFTLog struct {
 	blocks         map[common.Hash]*types.Block // store blocks received in FBFT
 	verifiedBlocks map[common.Hash]struct{}     // store block hashes for blocks that has already been verified
 	messages       map[fbftMsgID]*FBFTMessage   // store messages received in FBFT
+	wal            *FBFTWAL                     // persists the verified messages and blocks, if set
 }
 
 // NewFBFTLog returns new instance of FBFTLog
@@ -143,6 +145,13 @@ func NewFBFTLog() *FBFTLog {
 
 // AddBlock add a new block into the log
 func (log *FBFTLog) AddBlock(block *types.Block) {
+	if log.wal != nil {
+		if err := log.wal.WriteBlock(block); err != nil {
+			utils.Logger().Error().Err(err).
+				Uint64("blockNum", block.NumberU64()).
+				Msg("[FBFTLog] Failed writing block to WAL")
+		}
+	}
 	log.blocks[block.Hash()] = block
 }
 
@@ -205,6 +214,13 @@ func (log *FBFTLog) deleteMessagesLessThan(number uint64) {
 // AddVerifiedMessage adds a signature verified pbft message into the log
 func (log *FBFTLog) AddVerifiedMessage(msg *FBFTMessage) {
 	msg.Verified = true
+	if log.wal != nil {
+		if err := log.wal.WriteMessage(msg); err != nil {
+			utils.Logger().Error().Err(err).
+				Str("msg", msg.String()).
+				Msg("[FBFTLog] Failed writing message to WAL")
+		}
+	}
 
 	log.messages[msg.id()] = msg
 }
@@ -386,6 +402,32 @@ func (log *FBFTLog) GetCommittedBlockAndMsgsFromNumber(bn uint64, logger *zerolo
 func (log *FBFTLog) PruneCacheBeforeBlock(bn uint64) {
 	log.deleteBlocksLessThan(bn - 1)
 	log.deleteMessagesLessThan(bn - 1)
+	if log.wal != nil {
+		if err := log.wal.Prune(bn - 1); err != nil {
+			utils.Logger().Error().Err(err).
+				Uint64("blockNum", bn).
+				Msg("[FBFTLog] Failed pruning WAL")
+		}
+	}
+}
+
+// attachWAL replays the messages and blocks of the WAL from the given block
+// number into the log, then persists every new message and block to the WAL.
+// It returns the replayed messages.
+func (log *FBFTLog) attachWAL(wal *FBFTWAL, fromBlockNum uint64) ([]*FBFTMessage, error) {
+	msgs, blocks, err := wal.Replay(fromBlockNum)
+	if err != nil {
+		return nil, err
+	}
+	for _, block := range blocks {
+		log.blocks[block.Hash()] = block
+	}
+	for _, msg := range msgs {
+		msg.Verified = true
+		log.messages[msg.id()] = msg
+	}
+	log.wal = wal
+	return msgs, nil
 }
 
 type threadsafeFBFTLog struct {
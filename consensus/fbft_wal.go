@@ -0,0 +1,416 @@
+package consensus
+
+import (
+	"bufio"
+	"encoding/binary"
+	"hash/crc32"
+	"io"
+	"os"
+	"path/filepath"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/rlp"
+	"github.com/pkg/errors"
+
+	msg_pb "github.com/harmony-one/harmony/api/proto/message"
+	"github.com/harmony-one/harmony/core/types"
+	"github.com/harmony-one/harmony/crypto/bls"
+	bls_cosi "github.com/harmony-one/harmony/crypto/bls"
+)
+
+const (
+	walFileName = "fbft.wal"
+	// walHeaderBytes is the size of the record header, the payload length
+	// followed by its crc32 checksum
+	walHeaderBytes = 8
+	// walMaxRecordBytes bounds the size of a record read back from the WAL
+	walMaxRecordBytes = 64 * 1024 * 1024
+)
+
+const (
+	walMessageRecord byte = iota + 1
+	walBlockRecord
+)
+
+// FBFTWAL is the write-ahead log of the FBFT process. It persists the
+// verified FBFT messages and the proposed blocks, so that a node restarting
+// in the middle of a round can recover the messages it has already seen.
+// The votes of the leader's decider are not persisted.
+//
+// Records are appended to a single file as [length][crc32][payload] and
+// synced to disk before the write returns. A torn record at the end of the
+// file, left by a crash during the write, is dropped when the WAL is opened.
+type FBFTWAL struct {
+	mu   sync.Mutex
+	path string
+	file *os.File
+}
+
+// walRecord is the payload of a WAL record
+type walRecord struct {
+	Kind     byte
+	BlockNum uint64
+	Data     []byte
+}
+
+// walMessage is the persisted form of a FBFTMessage. The view change fields
+// are not persisted as view change messages are not kept in the FBFT log.
+type walMessage struct {
+	MessageType        uint32
+	ViewID             uint64
+	BlockNum           uint64
+	BlockHash          common.Hash
+	Block              []byte
+	SenderPubkeys      [][]byte
+	SenderPubkeyBitmap []byte
+	LeaderPubkey       []byte
+	Payload            []byte
+}
+
+// OpenFBFTWAL opens the WAL in the given directory, creating it if needed
+func OpenFBFTWAL(dir string) (*FBFTWAL, error) {
+	if err := os.MkdirAll(dir, 0700); err != nil {
+		return nil, err
+	}
+	wal := &FBFTWAL{path: filepath.Join(dir, walFileName)}
+	if err := wal.open(); err != nil {
+		return nil, err
+	}
+	return wal, nil
+}
+
+// open opens the WAL file, truncating the torn record at its end if any
+func (wal *FBFTWAL) open() error {
+	file, err := os.OpenFile(wal.path, os.O_RDWR|os.O_CREATE, 0600)
+	if err != nil {
+		return err
+	}
+	valid, err := readWALRecords(file, func(walRecord) {})
+	if err != nil {
+		file.Close()
+		return err
+	}
+	if err := file.Truncate(valid); err != nil {
+		file.Close()
+		return err
+	}
+	if _, err := file.Seek(valid, io.SeekStart); err != nil {
+		file.Close()
+		return err
+	}
+	wal.file = file
+	return nil
+}
+
+// WriteMessage appends the FBFT message to the WAL
+func (wal *FBFTWAL) WriteMessage(msg *FBFTMessage) error {
+	data, err := rlp.EncodeToBytes(encodeWALMessage(msg))
+	if err != nil {
+		return err
+	}
+	return wal.append(walRecord{Kind: walMessageRecord, BlockNum: msg.BlockNum, Data: data})
+}
+
+// WriteBlock appends the block to the WAL
+func (wal *FBFTWAL) WriteBlock(block *types.Block) error {
+	data, err := rlp.EncodeToBytes(block)
+	if err != nil {
+		return err
+	}
+	return wal.append(walRecord{Kind: walBlockRecord, BlockNum: block.NumberU64(), Data: data})
+}
+
+func (wal *FBFTWAL) append(record walRecord) error {
+	b, err := encodeWALRecord(record)
+	if err != nil {
+		return err
+	}
+	wal.mu.Lock()
+	defer wal.mu.Unlock()
+
+	if _, err := wal.file.Write(b); err != nil {
+		return err
+	}
+	return wal.file.Sync()
+}
+
+// Replay reads back the messages and blocks of the WAL from the given block
+// number, in the order they were written.
+func (wal *FBFTWAL) Replay(fromBlockNum uint64) ([]*FBFTMessage, []*types.Block, error) {
+	wal.mu.Lock()
+	defer wal.mu.Unlock()
+
+	file, err := os.Open(wal.path)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer file.Close()
+
+	var (
+		msgs      []*FBFTMessage
+		blocks    []*types.Block
+		decodeErr error
+	)
+	_, err = readWALRecords(file, func(record walRecord) {
+		if decodeErr != nil || record.BlockNum < fromBlockNum {
+			return
+		}
+		switch record.Kind {
+		case walMessageRecord:
+			var m walMessage
+			if decodeErr = rlp.DecodeBytes(record.Data, &m); decodeErr != nil {
+				return
+			}
+			var msg *FBFTMessage
+			if msg, decodeErr = m.decode(); decodeErr != nil {
+				return
+			}
+			msgs = append(msgs, msg)
+		case walBlockRecord:
+			block := new(types.Block)
+			if decodeErr = rlp.DecodeBytes(record.Data, block); decodeErr != nil {
+				return
+			}
+			blocks = append(blocks, block)
+		}
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	if decodeErr != nil {
+		return nil, nil, errors.Wrap(decodeErr, "decode WAL record")
+	}
+	return msgs, blocks, nil
+}
+
+// Prune drops the records of blocks before the given block number. The kept
+// records are written to a new file which then replaces the WAL.
+func (wal *FBFTWAL) Prune(beforeBlockNum uint64) error {
+	wal.mu.Lock()
+	defer wal.mu.Unlock()
+
+	if _, err := wal.file.Seek(0, io.SeekStart); err != nil {
+		return err
+	}
+	var (
+		kept    []byte
+		encErr  error
+		dropped bool
+	)
+	if _, err := readWALRecords(wal.file, func(record walRecord) {
+		if record.BlockNum < beforeBlockNum {
+			dropped = true
+			return
+		}
+		b, err := encodeWALRecord(record)
+		if err != nil {
+			encErr = err
+			return
+		}
+		kept = append(kept, b...)
+	}); err != nil {
+		return err
+	}
+	if encErr != nil {
+		return encErr
+	}
+	if !dropped {
+		_, err := wal.file.Seek(0, io.SeekEnd)
+		return err
+	}
+
+	tmpPath := wal.path + ".tmp"
+	if err := writeFileSync(tmpPath, kept); err != nil {
+		return err
+	}
+	wal.file.Close()
+	if err := os.Rename(tmpPath, wal.path); err != nil {
+		if openErr := wal.open(); openErr != nil {
+			return openErr
+		}
+		return err
+	}
+	return wal.open()
+}
+
+// Close closes the WAL
+func (wal *FBFTWAL) Close() error {
+	wal.mu.Lock()
+	defer wal.mu.Unlock()
+	return wal.file.Close()
+}
+
+func encodeWALRecord(record walRecord) ([]byte, error) {
+	payload, err := rlp.EncodeToBytes(record)
+	if err != nil {
+		return nil, err
+	}
+	b := make([]byte, walHeaderBytes+len(payload))
+	binary.BigEndian.PutUint32(b, uint32(len(payload)))
+	binary.BigEndian.PutUint32(b[4:], crc32.ChecksumIEEE(payload))
+	copy(b[walHeaderBytes:], payload)
+	return b, nil
+}
+
+// readWALRecords reads the records from the reader until its end or the first
+// torn or corrupted record, and returns the size of the valid records.
+func readWALRecords(r io.Reader, fn func(walRecord)) (int64, error) {
+	var (
+		reader = bufio.NewReader(r)
+		header [walHeaderBytes]byte
+		valid  int64
+	)
+	for {
+		if _, err := io.ReadFull(reader, header[:]); err != nil {
+			if err == io.EOF || err == io.ErrUnexpectedEOF {
+				return valid, nil
+			}
+			return valid, err
+		}
+		size := binary.BigEndian.Uint32(header[:])
+		if size > walMaxRecordBytes {
+			return valid, nil
+		}
+		payload := make([]byte, size)
+		if _, err := io.ReadFull(reader, payload); err != nil {
+			if err == io.EOF || err == io.ErrUnexpectedEOF {
+				return valid, nil
+			}
+			return valid, err
+		}
+		if crc32.ChecksumIEEE(payload) != binary.BigEndian.Uint32(header[4:]) {
+			return valid, nil
+		}
+		var record walRecord
+		if err := rlp.DecodeBytes(payload, &record); err != nil {
+			return valid, nil
+		}
+		fn(record)
+		valid += walHeaderBytes + int64(size)
+	}
+}
+
+func writeFileSync(path string, data []byte) error {
+	file, err := os.OpenFile(path, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0600)
+	if err != nil {
+		return err
+	}
+	if _, err := file.Write(data); err != nil {
+		file.Close()
+		return err
+	}
+	if err := file.Sync(); err != nil {
+		file.Close()
+		return err
+	}
+	return file.Close()
+}
+
+func encodeWALMessage(msg *FBFTMessage) *walMessage {
+	m := &walMessage{
+		MessageType:        uint32(msg.MessageType),
+		ViewID:             msg.ViewID,
+		BlockNum:           msg.BlockNum,
+		BlockHash:          msg.BlockHash,
+		Block:              msg.Block,
+		SenderPubkeyBitmap: msg.SenderPubkeyBitmap,
+		Payload:            msg.Payload,
+	}
+	for _, key := range msg.SenderPubkeys {
+		m.SenderPubkeys = append(m.SenderPubkeys, key.Bytes[:])
+	}
+	if msg.LeaderPubkey != nil {
+		m.LeaderPubkey = msg.LeaderPubkey.Bytes[:]
+	}
+	return m
+}
+
+func (m *walMessage) decode() (*FBFTMessage, error) {
+	msg := &FBFTMessage{
+		MessageType:        msg_pb.MessageType(m.MessageType),
+		ViewID:             m.ViewID,
+		BlockNum:           m.BlockNum,
+		BlockHash:          m.BlockHash,
+		Block:              m.Block,
+		SenderPubkeyBitmap: m.SenderPubkeyBitmap,
+		Payload:            m.Payload,
+	}
+	for _, key := range m.SenderPubkeys {
+		wrapper, err := decodeWALPubKey(key)
+		if err != nil {
+			return nil, err
+		}
+		msg.SenderPubkeys = append(msg.SenderPubkeys, wrapper)
+	}
+	if len(m.LeaderPubkey) != 0 {
+		wrapper, err := decodeWALPubKey(m.LeaderPubkey)
+		if err != nil {
+			return nil, err
+		}
+		msg.LeaderPubkey = wrapper
+	}
+	return msg, nil
+}
+
+func decodeWALPubKey(b []byte) (*bls.PublicKeyWrapper, error) {
+	pubKey, err := bls_cosi.BytesToBLSPublicKey(b)
+	if err != nil {
+		return nil, err
+	}
+	wrapper := &bls.PublicKeyWrapper{Object: pubKey}
+	copy(wrapper.Bytes[:], b)
+	return wrapper, nil
+}
+
+// SetWAL attaches the write-ahead log to the FBFT log of the consensus. The
+// messages and blocks of the next block are replayed first, to recover the
+// view ID, the block locked by a prepared message and the aggregated
+// signatures collected before the node stopped.
+//
+// The recovery is replica-only: the individual prepare and commit votes a
+// leader collects in its decider are not written to the WAL, so a leader
+// restarting in the middle of a round does not get them back and the round
+// is finished through a view change.
+func (consensus *Consensus) SetWAL(wal *FBFTWAL) error {
+	consensus.mutex.Lock()
+	defer consensus.mutex.Unlock()
+
+	blockNum := consensus.Blockchain().CurrentHeader().Number().Uint64() + 1
+	msgs, err := consensus.fBFTLog.attachWAL(wal, blockNum)
+	if err != nil {
+		return err
+	}
+	var (
+		viewID   = consensus.getCurBlockViewID()
+		prepared *FBFTMessage
+	)
+	for _, msg := range msgs {
+		if msg.BlockNum != blockNum {
+			continue
+		}
+		if msg.ViewID > viewID {
+			viewID = msg.ViewID
+		}
+		if msg.MessageType == msg_pb.MessageType_PREPARED && (prepared == nil || msg.ViewID >= prepared.ViewID) {
+			prepared = msg
+		}
+	}
+	consensus.setViewIDs(viewID)
+	if prepared != nil {
+		if block := consensus.fBFTLog.GetBlockByHash(prepared.BlockHash); block != nil {
+			encodedBlock, err := rlp.EncodeToBytes(block)
+			if err != nil {
+				return err
+			}
+			consensus.blockHash = prepared.BlockHash
+			consensus.block = encodedBlock
+		}
+	}
+	consensus.getLogger().Info().
+		Int("messages", len(msgs)).
+		Uint64("viewID", viewID).
+		Bool("locked", prepared != nil).
+		Msg("[SetWAL] Replayed FBFT write-ahead log")
+	return nil
+}
@@ -0,0 +1,128 @@
+package consensus
+
+import (
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	msg_pb "github.com/harmony-one/harmony/api/proto/message"
+	blockfactory "github.com/harmony-one/harmony/block/factory"
+	"github.com/harmony-one/harmony/core/types"
+	"github.com/harmony-one/harmony/crypto/bls"
+)
+
+func makeWALTestMessage(typ msg_pb.MessageType, blockNum, viewID uint64) *FBFTMessage {
+	pub := bls.RandPrivateKey().GetPublicKey()
+	wrapper := &bls.PublicKeyWrapper{Object: pub}
+	copy(wrapper.Bytes[:], pub.Serialize())
+	return &FBFTMessage{
+		MessageType:   typ,
+		ViewID:        viewID,
+		BlockNum:      blockNum,
+		BlockHash:     common.BigToHash(new(big.Int).SetUint64(blockNum)),
+		SenderPubkeys: []*bls.PublicKeyWrapper{wrapper},
+		Payload:       []byte{1, 2, 3},
+	}
+}
+
+func TestFBFTWAL(t *testing.T) {
+	dir := t.TempDir()
+	wal, err := OpenFBFTWAL(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for num := uint64(1); num <= 3; num++ {
+		block := types.NewBlockWithHeader(blockfactory.NewTestHeader().With().Number(new(big.Int).SetUint64(num)).Header())
+		if err := wal.WriteBlock(block); err != nil {
+			t.Fatal(err)
+		}
+		if err := wal.WriteMessage(makeWALTestMessage(msg_pb.MessageType_ANNOUNCE, num, num+10)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	prepared := makeWALTestMessage(msg_pb.MessageType_PREPARED, 3, 13)
+	if err := wal.WriteMessage(prepared); err != nil {
+		t.Fatal(err)
+	}
+
+	msgs, blocks, err := wal.Replay(2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(msgs) != 3 || len(blocks) != 2 {
+		t.Fatalf("have %d messages and %d blocks, want 3 and 2", len(msgs), len(blocks))
+	}
+	last := msgs[2]
+	if last.MessageType != prepared.MessageType || last.ViewID != prepared.ViewID ||
+		last.BlockHash != prepared.BlockHash || last.id() != prepared.id() {
+		t.Fatalf("unexpected replayed message %v, want %v", last, prepared)
+	}
+
+	if err := wal.Prune(3); err != nil {
+		t.Fatal(err)
+	}
+	// a torn record left by a crash is dropped when the WAL is reopened
+	if err := wal.Close(); err != nil {
+		t.Fatal(err)
+	}
+	file, err := os.OpenFile(filepath.Join(dir, walFileName), os.O_APPEND|os.O_WRONLY, 0600)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := file.Write([]byte{0, 0, 1, 0, 0xff}); err != nil {
+		t.Fatal(err)
+	}
+	file.Close()
+
+	wal, err = OpenFBFTWAL(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := wal.WriteMessage(makeWALTestMessage(msg_pb.MessageType_COMMITTED, 3, 13)); err != nil {
+		t.Fatal(err)
+	}
+	msgs, blocks, err = wal.Replay(0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(msgs) != 3 || len(blocks) != 1 {
+		t.Fatalf("have %d messages and %d blocks after pruning, want 3 and 1", len(msgs), len(blocks))
+	}
+	for _, msg := range msgs {
+		if msg.BlockNum != 3 {
+			t.Fatalf("message of block %d not pruned", msg.BlockNum)
+		}
+	}
+}
+
+func TestFBFTLog_AttachWAL(t *testing.T) {
+	wal, err := OpenFBFTWAL(t.TempDir())
+	if err != nil {
+		t.Fatal(err)
+	}
+	log := NewFBFTLog()
+	if _, err := log.attachWAL(wal, 0); err != nil {
+		t.Fatal(err)
+	}
+	block := types.NewBlockWithHeader(blockfactory.NewTestHeader().With().Number(big.NewInt(5)).Header())
+	msg := makeWALTestMessage(msg_pb.MessageType_PREPARED, 5, 7)
+	msg.BlockHash = block.Hash()
+	log.AddBlock(block)
+	log.AddVerifiedMessage(msg)
+
+	// a restarted node recovers the log from the WAL
+	restarted := NewFBFTLog()
+	msgs, err := restarted.attachWAL(wal, 5)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(msgs) != 1 || !restarted.HasMatchingViewPrepared(5, 7, block.Hash()) {
+		t.Fatal("prepared message not recovered")
+	}
+	if restarted.GetBlockByHash(block.Hash()) == nil {
+		t.Fatal("block not recovered")
+	}
+}
@@ -12,6 +12,7 @@ import (
 	bls_core "github.com/harmony-one/bls/ffi/go/bls"
 	msg_pb "github.com/harmony-one/harmony/api/proto/message"
 	"github.com/harmony-one/harmony/consensus/quorum"
+	"github.com/harmony-one/harmony/consensus/slashprotection"
 	"github.com/harmony-one/harmony/core/types"
 	"github.com/harmony-one/harmony/p2p"
 )
@@ -55,6 +56,14 @@ func (consensus *Consensus) announce(block *types.Block) {
 
 	// Leader sign the block hash itself
 	for i, key := range consensus.priKey {
+		if err := consensus.checkSigningHistory(
+			key.Pub.Bytes, slashprotection.Prepare, block.NumberU64(), block.Header().ViewID().Uint64(), consensus.blockHash[:],
+		); err != nil {
+			consensus.getLogger().Warn().Err(err).Msgf(
+				"[Announce] Leader prepare refused by slashing protection for key at index %d", i,
+			)
+			continue
+		}
 		if err := consensus.prepareBitmap.SetKey(key.Pub.Bytes, true); err != nil {
 			consensus.getLogger().Warn().Err(err).Msgf(
 				"[Announce] Leader prepareBitmap SetKey failed for key at index %d", i,
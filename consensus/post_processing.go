@@ -62,41 +62,36 @@ func (consensus *Consensus) PostConsensusProcessing(newBlock *types.Block) error
 	// Broadcast client requested missing cross shard receipts if there is any
 	BroadcastMissingCXReceipts(consensus)
 
-	if h := consensus.registry.GetNodeConfig().WebHooks.Hooks; h != nil {
-		if h.Availability != nil {
-			shardState, err := consensus.Blockchain().ReadShardState(newBlock.Epoch())
+	if webhooks.DefaultDispatcher().Subscribed(webhooks.EventAvailabilityDropped) {
+		shardState, err := consensus.Blockchain().ReadShardState(newBlock.Epoch())
+		if err != nil {
+			utils.Logger().Error().Err(err).
+				Int64("epoch", newBlock.Epoch().Int64()).
+				Uint32("shard-id", consensus.ShardID).
+				Msg("failed to read shard state")
+			return err
+		}
+
+		for _, addr := range consensus.Registry().GetAddressToBLSKey().GetAddresses(consensus.getPublicKeys(), shardState, newBlock.Epoch()) {
+			wrapper, err := consensus.Beaconchain().ReadValidatorInformation(addr)
+			if err != nil {
+				utils.Logger().Err(err).Str("addr", addr.Hex()).Msg("failed reaching validator info")
+				return nil
+			}
+			snapshot, err := consensus.Beaconchain().ReadValidatorSnapshot(addr)
 			if err != nil {
-				utils.Logger().Error().Err(err).
-					Int64("epoch", newBlock.Epoch().Int64()).
-					Uint32("shard-id", consensus.ShardID).
-					Msg("failed to read shard state")
-				return err
+				utils.Logger().Err(err).Str("addr", addr.Hex()).Msg("failed reaching validator snapshot")
+				return nil
 			}
+			computed := availability.ComputeCurrentSigning(
+				snapshot.Validator, wrapper, consensus.Blockchain().Config().IsHIP32(newBlock.Epoch()),
+			)
+			lastBlockOfEpoch := shard.Schedule.EpochLastBlock(consensus.Beaconchain().CurrentBlock().Header().Epoch().Uint64())
 
-			for _, addr := range consensus.Registry().GetAddressToBLSKey().GetAddresses(consensus.getPublicKeys(), shardState, newBlock.Epoch()) {
-				wrapper, err := consensus.Beaconchain().ReadValidatorInformation(addr)
-				if err != nil {
-					utils.Logger().Err(err).Str("addr", addr.Hex()).Msg("failed reaching validator info")
-					return nil
-				}
-				snapshot, err := consensus.Beaconchain().ReadValidatorSnapshot(addr)
-				if err != nil {
-					utils.Logger().Err(err).Str("addr", addr.Hex()).Msg("failed reaching validator snapshot")
-					return nil
-				}
-				computed := availability.ComputeCurrentSigning(
-					snapshot.Validator, wrapper, consensus.Blockchain().Config().IsHIP32(newBlock.Epoch()),
-				)
-				lastBlockOfEpoch := shard.Schedule.EpochLastBlock(consensus.Beaconchain().CurrentBlock().Header().Epoch().Uint64())
-
-				computed.BlocksLeftInEpoch = lastBlockOfEpoch - consensus.Beaconchain().CurrentBlock().Header().Number().Uint64()
-
-				if err != nil && computed.IsBelowThreshold {
-					url := h.Availability.OnDroppedBelowThreshold
-					go func() {
-						webhooks.DoPost(url, computed)
-					}()
-				}
+			computed.BlocksLeftInEpoch = lastBlockOfEpoch - consensus.Beaconchain().CurrentBlock().Header().Number().Uint64()
+
+			if computed.IsBelowThreshold {
+				webhooks.Publish(webhooks.EventAvailabilityDropped, computed)
 			}
 		}
 	}
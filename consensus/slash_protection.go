@@ -0,0 +1,48 @@
+package consensus
+
+import (
+	"github.com/ethereum/go-ethereum/crypto"
+
+	"github.com/harmony-one/harmony/consensus/slashprotection"
+	"github.com/harmony-one/harmony/crypto/bls"
+)
+
+// SetSlashProtection sets the database keeping the signing history of the
+// local keys. Once set, the consensus refuses to sign a message conflicting
+// with one the key signed before for the same block number and view ID.
+func (consensus *Consensus) SetSlashProtection(db *slashprotection.DB) {
+	consensus.mutex.Lock()
+	defer consensus.mutex.Unlock()
+	consensus.slashProtection = db
+	consensus.vc.protection = db
+}
+
+// checkSigningHistory checks and records the payload about to be signed by the
+// key against the slashing protection database
+func (consensus *Consensus) checkSigningHistory(
+	pub bls.SerializedPublicKey, kind slashprotection.Kind, blockNum, viewID uint64, payload []byte,
+) error {
+	return checkSigningHistory(consensus.slashProtection, pub, kind, blockNum, viewID, payload)
+}
+
+func checkSigningHistory(
+	db *slashprotection.DB, pub bls.SerializedPublicKey, kind slashprotection.Kind, blockNum, viewID uint64, payload []byte,
+) error {
+	if db == nil {
+		return nil
+	}
+	return db.CheckAndRecord(pub, kind, blockNum, viewID, crypto.Keccak256Hash(payload))
+}
+
+// pruneSigningHistory drops the signing history before the committed block,
+// no message below it can be signed anymore
+func (consensus *Consensus) pruneSigningHistory(committed uint64) {
+	if consensus.slashProtection == nil {
+		return
+	}
+	if err := consensus.slashProtection.Prune(committed); err != nil {
+		consensus.getLogger().Warn().Err(err).
+			Uint64("blockNum", committed).
+			Msg("[pruneSigningHistory] failed to prune the slashing protection history")
+	}
+}
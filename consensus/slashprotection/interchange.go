@@ -0,0 +1,174 @@
+package slashprotection
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+
+	"github.com/harmony-one/harmony/crypto/bls"
+)
+
+// InterchangeVersion is the version of the interchange format
+const InterchangeVersion = "1"
+
+// Interchange is the JSON document used to move the signing history of keys
+// between machines.
+type Interchange struct {
+	Metadata InterchangeMetadata `json:"metadata"`
+	Data     []InterchangeKey    `json:"data"`
+}
+
+// InterchangeMetadata is the metadata of an interchange document
+type InterchangeMetadata struct {
+	Version string `json:"interchange_format_version"`
+}
+
+// InterchangeKey is the signing history of a key
+type InterchangeKey struct {
+	PubKey hexutil.Bytes `json:"pubkey"`
+	// Watermark is the lowest block number the key may sign
+	Watermark      uint64               `json:"watermark,string"`
+	SignedMessages []InterchangeMessage `json:"signed_messages"`
+}
+
+// InterchangeMessage is a signed message of a key
+type InterchangeMessage struct {
+	Kind        Kind        `json:"kind"`
+	BlockNum    uint64      `json:"block_num,string"`
+	ViewID      uint64      `json:"view_id,string"`
+	SigningRoot common.Hash `json:"signing_root"`
+}
+
+// Export returns the signing history of all keys
+func (p *DB) Export() (*Interchange, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	history := make(map[bls.SerializedPublicKey]*InterchangeKey)
+	entry := func(pub bls.SerializedPublicKey) *InterchangeKey {
+		if key, ok := history[pub]; ok {
+			return key
+		}
+		key := &InterchangeKey{PubKey: common.CopyBytes(pub[:]), SignedMessages: []InterchangeMessage{}}
+		history[pub] = key
+		return key
+	}
+	it := p.db.NewIterator(recordPrefix, nil)
+	for it.Next() {
+		pub, kind, blockNum, viewID, ok := parseRecordKey(it.Key())
+		if !ok {
+			continue
+		}
+		key := entry(pub)
+		key.SignedMessages = append(key.SignedMessages, InterchangeMessage{
+			Kind:        kind,
+			BlockNum:    blockNum,
+			ViewID:      viewID,
+			SigningRoot: common.BytesToHash(it.Value()),
+		})
+	}
+	it.Release()
+	if err := it.Error(); err != nil {
+		return nil, err
+	}
+	it = p.db.NewIterator(watermarkPrefix, nil)
+	for it.Next() {
+		var pub bls.SerializedPublicKey
+		copy(pub[:], it.Key()[len(watermarkPrefix):])
+		watermark, err := p.watermark(pub)
+		if err != nil {
+			it.Release()
+			return nil, err
+		}
+		entry(pub).Watermark = watermark
+	}
+	it.Release()
+	if err := it.Error(); err != nil {
+		return nil, err
+	}
+
+	interchange := &Interchange{
+		Metadata: InterchangeMetadata{Version: InterchangeVersion},
+		Data:     make([]InterchangeKey, 0, len(history)),
+	}
+	for _, key := range history {
+		interchange.Data = append(interchange.Data, *key)
+	}
+	sort.Slice(interchange.Data, func(i, j int) bool {
+		return string(interchange.Data[i].PubKey) < string(interchange.Data[j].PubKey)
+	})
+	return interchange, nil
+}
+
+// Import merges the signing history of the interchange document into the
+// database. When both have signed different roots for the same message, the
+// message is recorded with the empty root so that the key signs neither of
+// them again.
+func (p *DB) Import(interchange *Interchange) error {
+	if interchange.Metadata.Version != InterchangeVersion {
+		return fmt.Errorf("unsupported interchange format version %q", interchange.Metadata.Version)
+	}
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	batch := p.db.NewBatch()
+	for _, key := range interchange.Data {
+		if len(key.PubKey) != bls.PublicKeySizeInBytes {
+			return fmt.Errorf("invalid public key %s", key.PubKey)
+		}
+		var pub bls.SerializedPublicKey
+		copy(pub[:], key.PubKey)
+		for _, msg := range key.SignedMessages {
+			if _, ok := kindNames[msg.Kind]; !ok {
+				return fmt.Errorf("unknown signing kind %d", byte(msg.Kind))
+			}
+			dbKey := recordKey(pub, msg.Kind, msg.BlockNum, msg.ViewID)
+			root := msg.SigningRoot
+			has, err := p.db.Has(dbKey)
+			if err != nil {
+				return err
+			}
+			if has {
+				signed, err := p.db.Get(dbKey)
+				if err != nil {
+					return err
+				}
+				if common.BytesToHash(signed) == root {
+					continue
+				}
+				root = common.Hash{}
+			}
+			if err := batch.Put(dbKey, root[:]); err != nil {
+				return err
+			}
+		}
+		if err := p.raiseWatermark(batch, pub, key.Watermark); err != nil {
+			return err
+		}
+	}
+	return batch.Write()
+}
+
+// ExportJSON writes the signing history of all keys as JSON
+func (p *DB) ExportJSON(w io.Writer) error {
+	interchange, err := p.Export()
+	if err != nil {
+		return err
+	}
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(interchange)
+}
+
+// ImportJSON merges the signing history read as JSON into the database
+func (p *DB) ImportJSON(r io.Reader) error {
+	var interchange Interchange
+	if err := json.NewDecoder(r).Decode(&interchange); err != nil {
+		return err
+	}
+	return p.Import(&interchange)
+}
@@ -0,0 +1,221 @@
+// Package slashprotection keeps the signing history of the local validator
+// BLS keys, so that a node never signs two conflicting consensus messages for
+// the same block number and view ID. This may otherwise happen after a restart
+// from an old snapshot, or when a failover node runs with the same keys.
+package slashprotection
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/ethdb"
+	"github.com/pkg/errors"
+
+	"github.com/harmony-one/harmony/core/rawdb"
+	"github.com/harmony-one/harmony/crypto/bls"
+)
+
+var (
+	// ErrConflictingSignature is returned when the key already signed another
+	// payload of the same kind for the block number and view ID
+	ErrConflictingSignature = errors.New("conflicting signature refused by slashing protection")
+	// ErrBelowWatermark is returned when the block number is below the pruned
+	// signing history of the key
+	ErrBelowWatermark = errors.New("block number below the slashing protection watermark")
+)
+
+var (
+	recordPrefix    = []byte("sp-r-") // recordPrefix + pubkey + kind + blockNum + viewID -> signing root
+	watermarkPrefix = []byte("sp-w-") // watermarkPrefix + pubkey -> lowest block number allowed
+)
+
+const recordKeyLength = 5 + bls.PublicKeySizeInBytes + 1 + 8 + 8
+
+// Kind is the kind of a signed consensus message
+type Kind byte
+
+// The kinds of the signed consensus messages
+const (
+	Announce Kind = iota + 1
+	Prepare
+	Commit
+	ViewChange
+)
+
+var kindNames = map[Kind]string{
+	Announce:   "announce",
+	Prepare:    "prepare",
+	Commit:     "commit",
+	ViewChange: "viewchange",
+}
+
+// String returns the name of the kind
+func (k Kind) String() string {
+	if name, ok := kindNames[k]; ok {
+		return name
+	}
+	return fmt.Sprintf("unknown(%d)", byte(k))
+}
+
+// MarshalText implements encoding.TextMarshaler
+func (k Kind) MarshalText() ([]byte, error) {
+	if _, ok := kindNames[k]; !ok {
+		return nil, fmt.Errorf("unknown signing kind %d", byte(k))
+	}
+	return []byte(k.String()), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler
+func (k *Kind) UnmarshalText(text []byte) error {
+	for kind, name := range kindNames {
+		if name == string(text) {
+			*k = kind
+			return nil
+		}
+	}
+	return fmt.Errorf("unknown signing kind %q", text)
+}
+
+// DB is the slashing protection database. It is safe for concurrent use.
+type DB struct {
+	db ethdb.KeyValueStore
+	mu sync.Mutex
+}
+
+// New returns the slashing protection database on top of the key value store
+func New(db ethdb.KeyValueStore) *DB {
+	return &DB{db: db}
+}
+
+// Open opens the slashing protection database in the given directory
+func Open(dir string) (*DB, error) {
+	db, err := rawdb.NewLevelDBDatabase(dir, 16, 16, "slashprotection", false)
+	if err != nil {
+		return nil, err
+	}
+	return New(db), nil
+}
+
+// Close closes the database
+func (p *DB) Close() error {
+	return p.db.Close()
+}
+
+// CheckAndRecord checks the signing history of the key and records the
+// signing root of the message about to be signed. Signing the same root
+// again is allowed, while a different root for the same kind, block number
+// and view ID is refused with ErrConflictingSignature.
+func (p *DB) CheckAndRecord(pub bls.SerializedPublicKey, kind Kind, blockNum, viewID uint64, root common.Hash) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	watermark, err := p.watermark(pub)
+	if err != nil {
+		return err
+	}
+	if blockNum < watermark {
+		return errors.Wrapf(ErrBelowWatermark, "key %s block %d < %d", pub.Hex(), blockNum, watermark)
+	}
+	key := recordKey(pub, kind, blockNum, viewID)
+	has, err := p.db.Has(key)
+	if err != nil {
+		return err
+	}
+	if has {
+		signed, err := p.db.Get(key)
+		if err != nil {
+			return err
+		}
+		if !bytes.Equal(signed, root[:]) {
+			return errors.Wrapf(ErrConflictingSignature, "key %s %v at block %d view %d", pub.Hex(), kind, blockNum, viewID)
+		}
+		return nil
+	}
+	return p.db.Put(key, root[:])
+}
+
+// Prune deletes the signing history of all keys before the given block
+// number, and raises their watermark so they can no longer sign below it.
+func (p *DB) Prune(beforeBlockNum uint64) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	var (
+		batch = p.db.NewBatch()
+		keys  = make(map[bls.SerializedPublicKey]struct{})
+		it    = p.db.NewIterator(recordPrefix, nil)
+	)
+	for it.Next() {
+		pub, _, blockNum, _, ok := parseRecordKey(it.Key())
+		if !ok {
+			continue
+		}
+		keys[pub] = struct{}{}
+		if blockNum < beforeBlockNum {
+			if err := batch.Delete(common.CopyBytes(it.Key())); err != nil {
+				it.Release()
+				return err
+			}
+		}
+	}
+	it.Release()
+	if err := it.Error(); err != nil {
+		return err
+	}
+	for pub := range keys {
+		if err := p.raiseWatermark(batch, pub, beforeBlockNum); err != nil {
+			return err
+		}
+	}
+	return batch.Write()
+}
+
+func (p *DB) watermark(pub bls.SerializedPublicKey) (uint64, error) {
+	key := append(common.CopyBytes(watermarkPrefix), pub[:]...)
+	if has, err := p.db.Has(key); err != nil || !has {
+		return 0, err
+	}
+	b, err := p.db.Get(key)
+	if err != nil {
+		return 0, err
+	}
+	return binary.BigEndian.Uint64(b), nil
+}
+
+func (p *DB) raiseWatermark(w ethdb.KeyValueWriter, pub bls.SerializedPublicKey, blockNum uint64) error {
+	watermark, err := p.watermark(pub)
+	if err != nil {
+		return err
+	}
+	if blockNum <= watermark {
+		return nil
+	}
+	var b [8]byte
+	binary.BigEndian.PutUint64(b[:], blockNum)
+	return w.Put(append(common.CopyBytes(watermarkPrefix), pub[:]...), b[:])
+}
+
+func recordKey(pub bls.SerializedPublicKey, kind Kind, blockNum, viewID uint64) []byte {
+	key := make([]byte, 0, recordKeyLength)
+	key = append(key, recordPrefix...)
+	key = append(key, pub[:]...)
+	key = append(key, byte(kind))
+	key = binary.BigEndian.AppendUint64(key, blockNum)
+	return binary.BigEndian.AppendUint64(key, viewID)
+}
+
+func parseRecordKey(key []byte) (pub bls.SerializedPublicKey, kind Kind, blockNum, viewID uint64, ok bool) {
+	if len(key) != recordKeyLength || !bytes.HasPrefix(key, recordPrefix) {
+		return pub, 0, 0, 0, false
+	}
+	key = key[len(recordPrefix):]
+	copy(pub[:], key)
+	key = key[bls.PublicKeySizeInBytes:]
+	kind = Kind(key[0])
+	blockNum = binary.BigEndian.Uint64(key[1:])
+	viewID = binary.BigEndian.Uint64(key[9:])
+	return pub, kind, blockNum, viewID, true
+}
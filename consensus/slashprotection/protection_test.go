@@ -0,0 +1,103 @@
+package slashprotection
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/pkg/errors"
+
+	"github.com/harmony-one/harmony/core/rawdb"
+	"github.com/harmony-one/harmony/crypto/bls"
+)
+
+func randPubKey() bls.SerializedPublicKey {
+	var pub bls.SerializedPublicKey
+	copy(pub[:], bls.RandPrivateKey().GetPublicKey().Serialize())
+	return pub
+}
+
+func TestCheckAndRecord(t *testing.T) {
+	var (
+		p     = New(rawdb.NewMemoryDatabase())
+		pub   = randPubKey()
+		root1 = common.HexToHash("0x01")
+		root2 = common.HexToHash("0x02")
+	)
+	if err := p.CheckAndRecord(pub, Prepare, 10, 3, root1); err != nil {
+		t.Fatal(err)
+	}
+	// signing the same payload again is allowed
+	if err := p.CheckAndRecord(pub, Prepare, 10, 3, root1); err != nil {
+		t.Fatal(err)
+	}
+	if err := p.CheckAndRecord(pub, Prepare, 10, 3, root2); errors.Cause(err) != ErrConflictingSignature {
+		t.Fatalf("have %v, want %v", err, ErrConflictingSignature)
+	}
+	// another kind, view or key is not a conflict
+	if err := p.CheckAndRecord(pub, Commit, 10, 3, root2); err != nil {
+		t.Fatal(err)
+	}
+	if err := p.CheckAndRecord(pub, Prepare, 10, 4, root2); err != nil {
+		t.Fatal(err)
+	}
+	if err := p.CheckAndRecord(randPubKey(), Prepare, 10, 3, root2); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := p.Prune(11); err != nil {
+		t.Fatal(err)
+	}
+	if err := p.CheckAndRecord(pub, Prepare, 10, 3, root1); errors.Cause(err) != ErrBelowWatermark {
+		t.Fatalf("have %v, want %v", err, ErrBelowWatermark)
+	}
+	if err := p.CheckAndRecord(pub, Prepare, 11, 3, root1); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestInterchange(t *testing.T) {
+	var (
+		src   = New(rawdb.NewMemoryDatabase())
+		pub   = randPubKey()
+		root1 = common.HexToHash("0x01")
+		root2 = common.HexToHash("0x02")
+	)
+	if err := src.CheckAndRecord(pub, Prepare, 5, 1, root1); err != nil {
+		t.Fatal(err)
+	}
+	if err := src.CheckAndRecord(pub, ViewChange, 6, 2, root1); err != nil {
+		t.Fatal(err)
+	}
+	if err := src.Prune(5); err != nil {
+		t.Fatal(err)
+	}
+	var buf bytes.Buffer
+	if err := src.ExportJSON(&buf); err != nil {
+		t.Fatal(err)
+	}
+
+	dst := New(rawdb.NewMemoryDatabase())
+	if err := dst.CheckAndRecord(pub, ViewChange, 6, 2, root2); err != nil {
+		t.Fatal(err)
+	}
+	if err := dst.ImportJSON(&buf); err != nil {
+		t.Fatal(err)
+	}
+	if err := dst.CheckAndRecord(pub, Prepare, 5, 1, root2); errors.Cause(err) != ErrConflictingSignature {
+		t.Fatalf("have %v, want %v", err, ErrConflictingSignature)
+	}
+	if err := dst.CheckAndRecord(pub, Prepare, 4, 1, root1); errors.Cause(err) != ErrBelowWatermark {
+		t.Fatalf("have %v, want %v", err, ErrBelowWatermark)
+	}
+	// both machines signed a different view change, neither is signed again
+	for _, root := range []common.Hash{root1, root2} {
+		if err := dst.CheckAndRecord(pub, ViewChange, 6, 2, root); errors.Cause(err) != ErrConflictingSignature {
+			t.Fatalf("have %v, want %v", err, ErrConflictingSignature)
+		}
+	}
+
+	if err := dst.Import(&Interchange{Metadata: InterchangeMetadata{Version: "0"}}); err == nil {
+		t.Fatal("unsupported version imported")
+	}
+}
@@ -5,6 +5,7 @@ import (
 	msg_pb "github.com/harmony-one/harmony/api/proto/message"
 	"github.com/harmony-one/harmony/consensus/quorum"
 	"github.com/harmony-one/harmony/consensus/signature"
+	"github.com/harmony-one/harmony/consensus/slashprotection"
 	"github.com/harmony-one/harmony/core/types"
 	"github.com/harmony-one/harmony/crypto/bls"
 	nodeconfig "github.com/harmony-one/harmony/internal/configs/node"
@@ -52,6 +53,12 @@ func (consensus *Consensus) didReachPrepareQuorum() error {
 	// so by this point, everyone has committed to the blockhash of this block
 	// in prepare and so this is the actual block.
 	for i, key := range consensus.priKey {
+		if err := consensus.checkSigningHistory(
+			key.Pub.Bytes, slashprotection.Commit, blockObj.NumberU64(), blockObj.Header().ViewID().Uint64(), commitPayload,
+		); err != nil {
+			consensus.getLogger().Warn().Err(err).Msgf("[OnPrepare] Leader commit refused by slashing protection for key at index %d", i)
+			continue
+		}
 		if err := consensus.commitBitmap.SetKey(key.Pub.Bytes, true); err != nil {
 			consensus.getLogger().Warn().Msgf("[OnPrepare] Leader commit bitmap set failed for key at index %d", i)
 			continue
@@ -14,6 +14,7 @@ import (
 	"github.com/harmony-one/harmony/internal/utils"
 	"github.com/harmony-one/harmony/p2p"
 	"github.com/harmony-one/harmony/shard"
+	"github.com/harmony-one/harmony/webhooks"
 	"github.com/pkg/errors"
 	"github.com/prometheus/client_golang/prometheus"
 )
@@ -140,6 +141,24 @@ func (consensus *Consensus) getNextViewID() (uint64, time.Duration) {
 	return nextViewID, viewChangeDuration
 }
 
+// GetNextLeaderKey returns the key of the leader taking over from the current one
+// on the next view change.
+func (consensus *Consensus) GetNextLeaderKey() (*bls.PublicKeyWrapper, error) {
+	consensus.mutex.RLock()
+	defer consensus.mutex.RUnlock()
+
+	epoch := consensus.Blockchain().CurrentHeader().Epoch()
+	ss, err := consensus.Blockchain().ReadShardState(epoch)
+	if err != nil {
+		return nil, err
+	}
+	committee, err := ss.FindCommitteeByID(consensus.ShardID)
+	if err != nil {
+		return nil, err
+	}
+	return consensus.getNextLeaderKey(consensus.getCurBlockViewID()+1, committee), nil
+}
+
 // getNextLeaderKey uniquely determine who is the leader for given viewID
 // It reads the current leader's pubkey based on the blockchain data and returns
 // the next leader based on the gap of the viewID of the view change and the last
@@ -276,6 +295,12 @@ func (consensus *Consensus) startViewChange() {
 		Str("NextLeader", consensus.getLeaderPubKey().Bytes.Hex()).
 		Msg("[startViewChange]")
 	consensusVCCounterVec.With(prometheus.Labels{"viewchange": "started"}).Inc()
+	webhooks.Publish(webhooks.EventViewChangeStarted, map[string]interface{}{
+		"shard-id":    consensus.ShardID,
+		"block-num":   consensus.getBlockNum(),
+		"view-id":     nextViewID,
+		"next-leader": consensus.getLeaderPubKey().Bytes.Hex(),
+	})
 
 	consensus.consensusTimeout[timeoutViewChange].SetDuration(duration)
 	defer consensus.consensusTimeout[timeoutViewChange].Start()
@@ -303,6 +328,9 @@ func (consensus *Consensus) startViewChange() {
 			continue
 		}
 		msgToSend := consensus.constructViewChangeMessage(&key)
+		if msgToSend == nil {
+			continue
+		}
 		if err := consensus.msgSender.SendWithRetry(
 			consensus.getBlockNum(),
 			msg_pb.MessageType_VIEWCHANGE,
@@ -569,6 +597,12 @@ func (consensus *Consensus) onNewView(recvMsg *FBFTMessage) {
 		Msg("new leader changed")
 	consensus.consensusTimeout[timeoutConsensus].Start()
 	consensusVCCounterVec.With(prometheus.Labels{"viewchange": "finished"}).Inc()
+	webhooks.Publish(webhooks.EventViewChangeFinished, map[string]interface{}{
+		"shard-id":  consensus.ShardID,
+		"block-num": consensus.getBlockNum(),
+		"view-id":   consensus.getCurBlockViewID(),
+		"leader":    consensus.getLeaderPubKey().Bytes.Hex(),
+	})
 }
 
 // ResetViewChangeState resets the view change structure
@@ -8,6 +8,7 @@ import (
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/rlp"
 	"github.com/harmony-one/harmony/consensus/quorum"
+	"github.com/harmony-one/harmony/consensus/slashprotection"
 	"github.com/harmony-one/harmony/core/types"
 
 	bls_core "github.com/harmony-one/bls/ffi/go/bls"
@@ -47,6 +48,9 @@ type viewChange struct {
 	m1Payload []byte // message payload for type m1 := |vcBlockHash|prepared_agg_sigs|prepared_bitmap|, new leader only need one
 
 	viewChangeDuration time.Duration
+
+	// protection keeps the signing history of the local keys, nil if disabled
+	protection *slashprotection.DB
 }
 
 // newViewChange returns a new viewChange object
@@ -410,6 +414,12 @@ func (vc *viewChange) InitPayload(
 					vc.getLogger().Info().Uint64("viewID", viewID).Uint64("blockNum", blockNum).Int("size", binary.Size(preparedBlock)).Msg("[InitPayload] add my M1 (prepared) type messaage")
 					msgToSign := append(preparedMsg.BlockHash[:], preparedMsg.Payload...)
 					for _, key := range privKeys {
+						if err := checkSigningHistory(
+							vc.protection, key.Pub.Bytes, slashprotection.ViewChange, blockNum, viewID, msgToSign,
+						); err != nil {
+							vc.getLogger().Warn().Err(err).Str("key", key.Pub.Bytes.Hex()).Msg("[InitPayload] M1 refused by slashing protection")
+							continue
+						}
 						// update the dictionary key if the viewID is first time received
 						if _, ok := vc.bhpBitmap[viewID]; !ok {
 							bhpBitmap := bls_cosi.NewMask(members)
@@ -435,6 +445,12 @@ func (vc *viewChange) InitPayload(
 		if !hasBlock {
 			vc.getLogger().Info().Uint64("viewID", viewID).Uint64("blockNum", blockNum).Msg("[InitPayload] add my M2 (NIL) type messaage")
 			for _, key := range privKeys {
+				if err := checkSigningHistory(
+					vc.protection, key.Pub.Bytes, slashprotection.ViewChange, blockNum, viewID, NIL,
+				); err != nil {
+					vc.getLogger().Warn().Err(err).Str("key", key.Pub.Bytes.Hex()).Msg("[InitPayload] M2 refused by slashing protection")
+					continue
+				}
 				if _, ok := vc.nilBitmap[viewID]; !ok {
 					nilBitmap := bls_cosi.NewMask(members)
 					vc.nilBitmap[viewID] = nilBitmap
@@ -10,13 +10,14 @@ import (
 	bls_core "github.com/harmony-one/bls/ffi/go/bls"
 	"github.com/harmony-one/harmony/api/proto"
 	msg_pb "github.com/harmony-one/harmony/api/proto/message"
+	"github.com/harmony-one/harmony/consensus/slashprotection"
 	bls_cosi "github.com/harmony-one/harmony/crypto/bls"
 
 	"github.com/harmony-one/harmony/multibls"
 	"github.com/pkg/errors"
 )
 
-// construct the view change message
+// construct the view change message, nil if refused by the slashing protection
 func (consensus *Consensus) constructViewChangeMessage(priKey *bls.PrivateKeyWrapper) []byte {
 	message := &msg_pb.Message{
 		ServiceType: msg_pb.ServiceType_CONSENSUS,
@@ -75,6 +76,14 @@ func (consensus *Consensus) constructViewChangeMessage(priKey *bls.PrivateKeyWra
 		Str("SenderPubKey", priKey.Pub.Bytes.Hex()).
 		Msg("[constructViewChangeMessage]")
 
+	if err := consensus.checkSigningHistory(
+		priKey.Pub.Bytes, slashprotection.ViewChange, consensus.getBlockNum(), consensus.getViewChangingID(), msgToSign,
+	); err != nil {
+		consensus.getLogger().Err(err).
+			Str("SenderPubKey", priKey.Pub.Bytes.Hex()).
+			Msg("[constructViewChangeMessage] view change refused by slashing protection")
+		return nil
+	}
 	sign := priKey.Pri.SignHash(msgToSign)
 	if sign != nil {
 		vcMsg.ViewchangeSig = sign.Serialize()
@@ -21,6 +21,7 @@ import (
 	"encoding/binary"
 	"encoding/hex"
 	"fmt"
+	"math/big"
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/crypto"
@@ -75,7 +76,7 @@ func (v *BlockValidator) ValidateBody(block *types.Block) error {
 	); hash != header.TxHash() {
 		return fmt.Errorf("transaction root hash mismatch: have %x, want %x", hash, header.TxHash())
 	}
-	return nil
+	return v.validateBaseFee(header)
 }
 
 // ValidateState validates the various changes that happen after a state
@@ -127,11 +128,85 @@ func (v *BlockValidator) ValidateHeader(block *types.Block, seal bool) error {
 		return errors.New("block is nil")
 	}
 	if h := block.Header(); h != nil {
-		return v.bc.Engine().VerifyHeader(v.bc, h, true)
+		if err := v.bc.Engine().VerifyHeader(v.bc, h, true); err != nil {
+			return err
+		}
+		return v.validateBaseFee(h)
 	}
 	return errors.New("header field was nil")
 }
 
+// validateBaseFee checks the EIP-1559 base fee of a London header against
+// the one derived from its parent.
+func (v *BlockValidator) validateBaseFee(header *block.Header) error {
+	if !v.bc.Config().IsLondon(header.Epoch()) {
+		return nil
+	}
+	parent := v.bc.GetHeader(header.ParentHash(), header.Number().Uint64()-1)
+	if parent == nil {
+		return consensus_engine.ErrUnknownAncestor
+	}
+	return VerifyEIP1559Header(v.bc.Config(), parent, header)
+}
+
+// VerifyEIP1559Header verifies that the base fee of a London header is the
+// one expected from its parent.
+func VerifyEIP1559Header(config *params.ChainConfig, parent, header *block.Header) error {
+	baseFee := header.BaseFee()
+	if baseFee == nil {
+		return errors.New("header is missing baseFee")
+	}
+	if expected := CalcBaseFee(config, parent); baseFee.Cmp(expected) != 0 {
+		return fmt.Errorf("invalid baseFee: have %s, want %s, parentBaseFee %s, parentGasUsed %d",
+			baseFee, expected, parent.BaseFee(), parent.GasUsed())
+	}
+	return nil
+}
+
+// CalcBaseFee calculates the base fee of the header following parent.
+// The first London block uses the initial base fee; afterwards the base fee
+// moves towards keeping blocks at half of their gas limit, and never drops
+// below the minimum gas price accepted by the network.
+func CalcBaseFee(config *params.ChainConfig, parent *block.Header) *big.Int {
+	// If the parent predates the fork, use the initial base fee.
+	if !config.IsLondon(parent.Epoch()) {
+		return new(big.Int).SetUint64(params.InitialBaseFee)
+	}
+	var (
+		parentBaseFee            = parent.BaseFee()
+		parentGasTarget          = parent.GasLimit() / params.ElasticityMultiplier
+		baseFeeChangeDenominator = new(big.Int).SetUint64(params.BaseFeeChangeDenominator)
+		minimumBaseFee           = new(big.Int).SetUint64(params.MinimumBaseFee)
+	)
+	if parentGasTarget == 0 || parent.GasUsed() == parentGasTarget {
+		return parentBaseFee
+	}
+	num, baseFee := new(big.Int), new(big.Int)
+	if parent.GasUsed() > parentGasTarget {
+		// The parent used more gas than its target, the base fee increases by
+		// at least 1 wei.
+		num.SetUint64(parent.GasUsed() - parentGasTarget)
+		num.Mul(num, parentBaseFee)
+		num.Div(num, new(big.Int).SetUint64(parentGasTarget))
+		num.Div(num, baseFeeChangeDenominator)
+		if num.Cmp(common.Big1) < 0 {
+			num.Set(common.Big1)
+		}
+		return baseFee.Add(parentBaseFee, num)
+	}
+	// The parent used less gas than its target, the base fee decreases down
+	// to the network minimum.
+	num.SetUint64(parentGasTarget - parent.GasUsed())
+	num.Mul(num, parentBaseFee)
+	num.Div(num, new(big.Int).SetUint64(parentGasTarget))
+	num.Div(num, baseFeeChangeDenominator)
+	baseFee.Sub(parentBaseFee, num)
+	if baseFee.Cmp(minimumBaseFee) < 0 {
+		baseFee.Set(minimumBaseFee)
+	}
+	return baseFee
+}
+
 // CalcGasLimit computes the gas limit of the next block after parent. It aims
 // to keep the baseline gas above the provided floor, and increase it towards the
 // ceil if the blocks are full. If the ceil is exceeded, it will always decrease
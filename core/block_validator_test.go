@@ -0,0 +1,73 @@
+package core
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	blockfactory "github.com/harmony-one/harmony/block/factory"
+	"github.com/harmony-one/harmony/internal/params"
+)
+
+func londonConfig() *params.ChainConfig {
+	config := *params.TestChainConfig
+	config.BerlinEpoch = big.NewInt(0)
+	config.LondonEpoch = big.NewInt(0)
+	return &config
+}
+
+func TestCalcBaseFee(t *testing.T) {
+	config := londonConfig()
+	tests := []struct {
+		parentBaseFee   uint64
+		parentGasLimit  uint64
+		parentGasUsed   uint64
+		expectedBaseFee uint64
+	}{
+		{params.InitialBaseFee, 20000000, 10000000, params.InitialBaseFee},         // usage == target
+		{params.InitialBaseFee, 20000000, 20000000, params.InitialBaseFee * 9 / 8}, // usage full, +12.5%
+		{params.InitialBaseFee * 2, 20000000, 5000000, 187500000000},               // usage below target
+		{params.InitialBaseFee, 20000000, 0, params.MinimumBaseFee},                // floored at the minimum
+		{1, 20000000, 10000001, 2},                                                 // increases by at least 1
+	}
+	for i, test := range tests {
+		parent := blockfactory.NewFactory(config).NewHeader(common.Big0)
+		parent.SetBaseFee(new(big.Int).SetUint64(test.parentBaseFee))
+		parent.SetGasLimit(test.parentGasLimit)
+		parent.SetGasUsed(test.parentGasUsed)
+		if have, want := CalcBaseFee(config, parent), new(big.Int).SetUint64(test.expectedBaseFee); have.Cmp(want) != 0 {
+			t.Errorf("test %d: have %d, want %d", i, have, want)
+		}
+	}
+}
+
+func TestCalcBaseFeeForkBlock(t *testing.T) {
+	config := londonConfig()
+	config.LondonEpoch = big.NewInt(2)
+	parent := blockfactory.NewFactory(config).NewHeader(big.NewInt(1))
+	if parent.BaseFee() != nil {
+		t.Fatalf("pre-London header has a base fee: %v", parent.BaseFee())
+	}
+	if have := CalcBaseFee(config, parent); have.Cmp(new(big.Int).SetUint64(params.InitialBaseFee)) != 0 {
+		t.Fatalf("wrong initial base fee: have %v, want %v", have, params.InitialBaseFee)
+	}
+}
+
+func TestVerifyEIP1559Header(t *testing.T) {
+	config := londonConfig()
+	factory := blockfactory.NewFactory(config)
+	parent := factory.NewHeader(common.Big0)
+	parent.SetBaseFee(new(big.Int).SetUint64(params.InitialBaseFee))
+	parent.SetGasLimit(20000000)
+	parent.SetGasUsed(20000000)
+
+	header := factory.NewHeader(common.Big0)
+	header.SetBaseFee(CalcBaseFee(config, parent))
+	if err := VerifyEIP1559Header(config, parent, header); err != nil {
+		t.Fatalf("valid header rejected: %v", err)
+	}
+	header.SetBaseFee(new(big.Int).SetUint64(params.InitialBaseFee))
+	if err := VerifyEIP1559Header(config, parent, header); err == nil {
+		t.Fatal("header with wrong base fee accepted")
+	}
+}
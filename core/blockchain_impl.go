@@ -1328,7 +1328,7 @@ func (bc *BlockChainImpl) Rollback(chain []common.Hash) error {
 // SetReceiptsData computes all the non-consensus fields of the receipts
 func SetReceiptsData(config *params.ChainConfig, block *types.Block, receipts types.Receipts) error {
 	signer := types.MakeSigner(config, block.Epoch())
-	ethSigner := types.NewEIP155Signer(config.EthCompatibleChainID)
+	ethSigner := types.NewLondonSigner(config.EthCompatibleChainID)
 
 	transactions, stakingTransactions, logIndex := block.Transactions(), block.StakingTransactions(), uint(0)
 	if len(transactions)+len(stakingTransactions) != len(receipts) {
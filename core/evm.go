@@ -80,13 +80,14 @@ func NewEVMContext(msg Message, header *block.Header, chain ChainContext, author
 		GetVRF:                GetVRFFn(header, chain),
 		IsValidator:           IsValidator,
 		Origin:                msg.From(),
-		GasPrice:              new(big.Int).Set(msg.GasPrice()),
+		GasPrice:              new(big.Int).Set(effectiveGasPrice(msg, header.BaseFee())),
 		Coinbase:              beneficiary,
 		GasLimit:              header.GasLimit(),
 		BlockNumber:           header.Number(),
 		EpochNumber:           header.Epoch(),
 		Time:                  header.Time(),
 		VRF:                   vrf,
+		BaseFee:               header.BaseFee(),
 		TxType:                0,
 		CreateValidator:       CreateValidatorFn(header, chain),
 		EditValidator:         EditValidatorFn(header, chain),
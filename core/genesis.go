@@ -278,6 +278,12 @@ func (g *Genesis) ToBlock(db ethdb.Database) *types.Block {
 		ShardStateHash(g.ShardStateHash).
 		ShardState(shardStateBytes).
 		Header()
+	if g.Config != nil && g.Config.IsLondon(common.Big0) {
+		if err := head.SetBaseFee(new(big.Int).SetUint64(params.InitialBaseFee)); err != nil {
+			utils.Logger().Error().Err(err).Msg("failed to set the genesis base fee")
+			os.Exit(1)
+		}
+	}
 	statedb.Commit(false)
 	statedb.Database().TrieDB().Commit(root, true)
 
@@ -17,8 +17,10 @@ import (
 	"github.com/harmony-one/harmony/core/types"
 	"github.com/harmony-one/harmony/internal/utils"
 	"github.com/harmony-one/harmony/shard"
+	"github.com/harmony-one/harmony/staking/availability"
 	"github.com/harmony-one/harmony/staking/slash"
 	staking "github.com/harmony-one/harmony/staking/types"
+	"github.com/harmony-one/harmony/webhooks"
 	"github.com/pkg/errors"
 )
 
@@ -219,6 +221,16 @@ func (bc *BlockChainImpl) CommitOffChainData(
 			} else {
 				tempValidatorStats = stats
 			}
+			webhooks.Publish(webhooks.EventCommitteeElected, map[string]interface{}{
+				"epoch":      new(big.Int).Add(block.Epoch(), common.Big1),
+				"block-num":  block.NumberU64(),
+				"block-hash": block.Hash(),
+				"validators": shardState.StakedValidators().Addrs,
+				"committee":  shardState,
+			})
+			if isPreStaking && currentSuperCommittee != nil {
+				bc.publishEPOSStatusChanges(block, currentSuperCommittee, state)
+			}
 		} else {
 			utils.Logger().
 				Err(err).
@@ -268,6 +280,13 @@ func (bc *BlockChainImpl) CommitOffChainData(
 				if err := bc.DeleteFromPendingSlashingCandidates(records); err != nil {
 					utils.Logger().Debug().Err(err).Msg("could not deleting pending slashes")
 				}
+				if len(records) > 0 {
+					webhooks.Publish(webhooks.EventSlashingApplied, map[string]interface{}{
+						"block-num":  block.NumberU64(),
+						"block-hash": block.Hash(),
+						"records":    records,
+					})
+				}
 			}
 		} else {
 			if isNewEpoch && isPreStaking {
@@ -332,3 +351,38 @@ func (bc *BlockChainImpl) getNextBlockEpoch(header *block.Header) (*big.Int, err
 	}
 	return nextBlockEpoch, nil
 }
+
+// publishEPOSStatusChanges publishes the validators set to inactive by the EPoS
+// availability check of the committee selection block.
+func (bc *BlockChainImpl) publishEPOSStatusChanges(
+	block *types.Block, committee *shard.State, state *state.DB,
+) {
+	if !webhooks.DefaultDispatcher().Subscribed(webhooks.EventValidatorStatusChanged) {
+		return
+	}
+	parent := bc.GetHeaderByHash(block.ParentHash())
+	if parent == nil {
+		return
+	}
+	parentState, err := bc.StateAt(parent.Root())
+	if err != nil {
+		utils.Logger().Debug().Err(err).
+			Uint64("blockNum", block.NumberU64()).
+			Msg("could not read parent state for EPoS status changes")
+		return
+	}
+	changes := availability.EPOSStatusChanges(
+		bc, parentState, state, committee.StakedValidators().Addrs,
+	)
+	for _, change := range changes {
+		webhooks.Publish(webhooks.EventValidatorStatusChanged, map[string]interface{}{
+			"validator":       change.Validator,
+			"epoch":           change.Epoch,
+			"previous-status": change.PreviousStatus.String(),
+			"status":          change.Status.String(),
+			"computed":        change.Computed,
+			"block-num":       block.NumberU64(),
+			"block-hash":      block.Hash(),
+		})
+	}
+}
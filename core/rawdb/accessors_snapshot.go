@@ -208,3 +208,25 @@ func WriteSnapshotSyncStatus(db ethdb.KeyValueWriter, status []byte) {
 		utils.Logger().Error().Err(err).Msg("Failed to store snapshot sync status")
 	}
 }
+
+// ReadStatePruningMarker retrieves the key up to which the state pruning has
+// deleted the stale entries.
+func ReadStatePruningMarker(db ethdb.KeyValueReader) []byte {
+	data, _ := db.Get(statePruningMarkerKey)
+	return data
+}
+
+// WriteStatePruningMarker stores the key up to which the state pruning has
+// deleted the stale entries.
+func WriteStatePruningMarker(db ethdb.KeyValueWriter, marker []byte) {
+	if err := db.Put(statePruningMarkerKey, marker); err != nil {
+		utils.Logger().Error().Err(err).Msg("Failed to store state pruning marker")
+	}
+}
+
+// DeleteStatePruningMarker deletes the state pruning marker.
+func DeleteStatePruningMarker(db ethdb.KeyValueWriter) {
+	if err := db.Delete(statePruningMarkerKey); err != nil {
+		utils.Logger().Error().Err(err).Msg("Failed to remove state pruning marker")
+	}
+}
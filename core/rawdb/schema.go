@@ -67,6 +67,9 @@ var (
 	// snapshotSyncStatusKey tracks the snapshot sync status across restarts.
 	snapshotSyncStatusKey = []byte("SnapshotSyncStatus")
 
+	// statePruningMarkerKey tracks the progress of the state pruning across restarts.
+	statePruningMarkerKey = []byte("StatePruningMarker")
+
 	// skeletonSyncStatusKey tracks the skeleton sync status across restarts.
 	skeletonSyncStatusKey = []byte("SkeletonSyncStatus")
 
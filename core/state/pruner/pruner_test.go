@@ -0,0 +1,147 @@
+package pruner
+
+import (
+	"bytes"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	blockfactory "github.com/harmony-one/harmony/block/factory"
+	"github.com/harmony-one/harmony/core/rawdb"
+	"github.com/harmony-one/harmony/core/state"
+	"github.com/harmony-one/harmony/core/state/snapshot"
+	"github.com/harmony-one/harmony/core/types"
+)
+
+func TestStateBloomCodeKeys(t *testing.T) {
+	bloom, err := newStateBloomWithSize(1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var (
+		node      = crypto.Keccak256([]byte("node"))
+		code      = crypto.Keccak256Hash([]byte("code"))
+		validator = crypto.Keccak256Hash([]byte("validator"))
+	)
+	for _, key := range [][]byte{node, append(rawdb.CodePrefix, code.Bytes()...), append(rawdb.ValidatorCodePrefix, validator.Bytes()...)} {
+		if err := bloom.Put(key, nil); err != nil {
+			t.Fatal(err)
+		}
+	}
+	for _, key := range [][]byte{node, code.Bytes(), validator.Bytes()} {
+		if ok, _ := bloom.Contain(key); !ok {
+			t.Fatalf("key %x is not in the bloom", key)
+		}
+	}
+	if err := bloom.Put([]byte("LastBlock"), nil); err == nil {
+		t.Fatal("non-state key must be rejected")
+	}
+}
+
+func TestPrune(t *testing.T) {
+	var (
+		db        = rawdb.NewMemoryDatabase()
+		sdb       = state.NewDatabase(db)
+		account   = common.HexToAddress("0x01")
+		contract  = common.HexToAddress("0x02")
+		validator = common.HexToAddress("0x03")
+		code      = []byte{0x60, 0x00}
+		valCode   = []byte("validator wrapper")
+		roots     []common.Hash
+	)
+	commit := func(statedb *state.DB) {
+		root, err := statedb.Commit(true)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := sdb.TrieDB().Commit(root, false); err != nil {
+			t.Fatal(err)
+		}
+		number := big.NewInt(int64(len(roots)))
+		header := blockfactory.NewTestHeader().With().Number(number).Root(root).Header()
+		block := types.NewBlockWithHeader(header)
+		if err := rawdb.WriteBlock(db, block); err != nil {
+			t.Fatal(err)
+		}
+		if err := rawdb.WriteCanonicalHash(db, block.Hash(), block.NumberU64()); err != nil {
+			t.Fatal(err)
+		}
+		if err := rawdb.WriteHeadBlockHash(db, block.Hash()); err != nil {
+			t.Fatal(err)
+		}
+		roots = append(roots, root)
+	}
+
+	genesis, _ := state.New(common.Hash{}, sdb, nil)
+	genesis.SetBalance(account, big.NewInt(1))
+	genesis.SetCode(contract, code, false)
+	commit(genesis)
+
+	// the validator and its code are created after the genesis, the code
+	// must be kept along with the target state
+	created, _ := state.New(roots[0], sdb, nil)
+	created.SetCode(validator, valCode, true)
+	commit(created)
+
+	snaps, err := snapshot.New(snapshot.Config{CacheSize: 16}, db, sdb.TrieDB(), roots[1])
+	if err != nil {
+		t.Fatal(err)
+	}
+	for n := int64(2); n <= layersInMemory+2; n++ {
+		statedb, err := state.New(roots[n-1], sdb, snaps)
+		if err != nil {
+			t.Fatal(err)
+		}
+		statedb.SetBalance(account, big.NewInt(n+1))
+		statedb.SetState(contract, common.Hash{}, common.BigToHash(big.NewInt(n)))
+		commit(statedb)
+	}
+
+	bloom, err := newStateBloomWithSize(1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	head := rawdb.ReadHeadBlock(db)
+	bloomDir := t.TempDir()
+	p := &Pruner{
+		config:      Config{Datadir: bloomDir},
+		chainHeader: head.Header(),
+		db:          db,
+		stateBloom:  bloom,
+		snaptree:    snaps,
+	}
+	if err := p.Prune(common.Hash{}); err != nil {
+		t.Fatal(err)
+	}
+
+	// the target is the bottom-most diff layer, 127 blocks below the head
+	target := len(roots) - layersInMemory
+	statedb, err := state.New(roots[target], state.NewDatabase(db), nil)
+	if err != nil {
+		t.Fatalf("target state is pruned: %v", err)
+	}
+	if balance := statedb.GetBalance(account); balance.Cmp(big.NewInt(int64(target+1))) != 0 {
+		t.Fatalf("have balance %v, want %d", balance, target+1)
+	}
+	if !bytes.Equal(statedb.GetCode(contract), code) {
+		t.Fatal("contract code is pruned")
+	}
+	if !rawdb.HasValidatorCode(db, crypto.Keccak256Hash(valCode)) {
+		t.Fatal("validator code is pruned")
+	}
+	if !rawdb.HasLegacyTrieNode(db, roots[0]) {
+		t.Fatal("genesis state is pruned")
+	}
+	for _, n := range []int{2, target - 1, len(roots) - 1} {
+		if rawdb.HasLegacyTrieNode(db, roots[n]) {
+			t.Fatalf("state of block %d is not pruned", n)
+		}
+	}
+	if marker := rawdb.ReadStatePruningMarker(db); marker != nil {
+		t.Fatalf("pruning marker is left: %x", marker)
+	}
+	if path, _, _ := findBloomFilter(bloomDir); path != "" {
+		t.Fatalf("bloom filter is left: %s", path)
+	}
+}
@@ -76,11 +76,15 @@ func GenerateTrie(snaptree *Tree, root common.Hash, src ethdb.Database, dst ethd
 	got, err := generateTrieRoot(dst, scheme, acctIt, common.Hash{}, stackTrieGenerate, func(dst ethdb.KeyValueWriter, accountHash, codeHash common.Hash, stat *generateStats) (common.Hash, error) {
 		// Migrate the code first, commit the contract code into the tmp db.
 		if codeHash != types.EmptyCodeHash {
-			code := rawdb.ReadCode(src, codeHash)
-			if len(code) == 0 {
+			// The code of the validator accounts is kept apart from the
+			// contract code.
+			if code := rawdb.ReadCode(src, codeHash); len(code) != 0 {
+				rawdb.WriteCode(dst, codeHash, code)
+			} else if code := rawdb.ReadValidatorCode(src, codeHash); len(code) != 0 {
+				rawdb.WriteValidatorCode(dst, codeHash, code)
+			} else {
 				return common.Hash{}, errors.New("failed to read code")
 			}
-			rawdb.WriteCode(dst, codeHash, code)
 		}
 		// Then migrate all storage trie nodes into the tmp db.
 		storageIt, err := snaptree.StorageIterator(root, accountHash, common.Hash{})
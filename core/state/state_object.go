@@ -103,6 +103,10 @@ type Object struct {
 	dirtyCode        bool // true if the code was updated
 	suicided         bool
 	deleted          bool
+
+	// created is true if the object was created in the current transaction.
+	// It is used by EIP-6780 to decide whether SELFDESTRUCT removes it.
+	created bool
 }
 
 // empty returns whether the account is considered empty.
@@ -328,6 +332,8 @@ func (s *Object) finalise(prefetch bool) {
 	if len(s.dirtyStorage) > 0 {
 		s.dirtyStorage = make(Storage)
 	}
+	// The object survived the transaction, it's no longer new
+	s.created = false
 }
 
 // updateTrie writes cached storage modifications into the object's storage trie.
@@ -492,6 +498,7 @@ func (s *Object) deepCopy(db *DB) *Object {
 	stateObject.suicided = s.suicided
 	stateObject.dirtyCode = s.dirtyCode
 	stateObject.deleted = s.deleted
+	stateObject.created = s.created
 	return stateObject
 }
 
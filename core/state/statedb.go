@@ -525,6 +525,18 @@ func (db *DB) Suicide(addr common.Address) bool {
 	return true
 }
 
+// Suicide6780 marks the given account as suicided only if it was created in
+// the current transaction, as specified by EIP-6780.
+func (db *DB) Suicide6780(addr common.Address) {
+	Object := db.getStateObject(addr)
+	if Object == nil {
+		return
+	}
+	if Object.created {
+		db.Suicide(addr)
+	}
+}
+
 // SetTransientState sets transient storage for a given account. It
 // adds the change to the journal so that it can be rolled back
 // to its previous value if there is a revert.
@@ -549,6 +561,13 @@ func (db *DB) setTransientState(addr common.Address, key, value common.Hash) {
 	db.transientStorage.Set(addr, key, value)
 }
 
+// ClearTransientStorage discards the transient storage, which only lives for
+// the duration of a transaction. It should be invoked before transaction
+// execution.
+func (db *DB) ClearTransientStorage() {
+	db.transientStorage = newTransientStorage()
+}
+
 // GetTransientState gets transient storage for a given account.
 func (db *DB) GetTransientState(addr common.Address, key common.Hash) common.Hash {
 	return db.transientStorage.Get(addr, key)
@@ -685,6 +704,7 @@ func (db *DB) createObject(addr common.Address) (newobj, prev *Object) {
 		}
 	}
 	newobj = newObject(db, addr, types.StateAccount{})
+	newobj.created = true
 	if prev == nil {
 		db.journal.append(createObjectChange{account: &addr})
 	} else {
@@ -1172,6 +1192,35 @@ func (db *DB) Commit(deleteEmptyObjects bool) (common.Hash, error) {
 	return root, nil
 }
 
+// PrepareAccessList handles the preparatory steps for executing a state transition
+// with regards to EIP-2929 and EIP-2930:
+//
+// - Add sender to access list
+// - Add destination to access list
+// - Add precompiles to access list
+// - Add the contents of the optional tx access list
+//
+// This method should only be called if the Berlin fork is active.
+func (db *DB) PrepareAccessList(sender common.Address, dst *common.Address, precompiles []common.Address, list types2.AccessList) {
+	// Clear out any leftover from previous executions
+	db.accessList = newAccessList()
+
+	db.AddAddressToAccessList(sender)
+	if dst != nil {
+		db.AddAddressToAccessList(*dst)
+		// If it's a create-tx, the destination will be added inside evm.create
+	}
+	for _, addr := range precompiles {
+		db.AddAddressToAccessList(addr)
+	}
+	for _, el := range list {
+		db.AddAddressToAccessList(el.Address)
+		for _, key := range el.StorageKeys {
+			db.AddSlotToAccessList(el.Address, key)
+		}
+	}
+}
+
 // AddAddressToAccessList adds the given address to the access list
 func (db *DB) AddAddressToAccessList(addr common.Address) {
 	if db.accessList.AddAddress(addr) {
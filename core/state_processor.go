@@ -284,12 +284,19 @@ func ApplyTransaction(bc ChainContext, author *common.Address, gp *GasPool, stat
 		)
 	}
 
+	if tx.Type() != types.LegacyTxType && !config.IsBerlin(header.Epoch()) {
+		return nil, nil, nil, 0, types.ErrTxTypeNotSupported
+	}
+	if tx.Type() == types.DynamicFeeTxType && !config.IsLondon(header.Epoch()) {
+		return nil, nil, nil, 0, types.ErrTxTypeNotSupported
+	}
+
 	var signer types.Signer
 	if tx.IsEthCompatible() {
 		if !config.IsEthCompatible(header.Epoch()) {
 			return nil, nil, nil, 0, errors.New("ethereum compatible transactions not supported at current epoch")
 		}
-		signer = types.NewEIP155Signer(config.EthCompatibleChainID)
+		signer = types.NewLondonSigner(config.EthCompatibleChainID)
 	} else {
 		signer = types.MakeSigner(config, header.Epoch())
 	}
@@ -334,7 +341,7 @@ func ApplyTransaction(bc ChainContext, author *common.Address, gp *GasPool, stat
 	receipt := types.NewReceipt(root, failedExe, *usedGas)
 	receipt.TxHash = tx.Hash()
 	receipt.GasUsed = result.UsedGas
-	receipt.EffectiveGasPrice = tx.EffectiveGasPrice(big.NewInt(0), nil)
+	receipt.EffectiveGasPrice = tx.EffectiveGasPrice(big.NewInt(0), header.BaseFee())
 	// if the transaction created a contract, store the creation address in the receipt.
 	if msg.To() == nil {
 		receipt.ContractAddress = crypto.CreateAddress(vmenv.Context.Origin, tx.Nonce())
@@ -416,7 +423,7 @@ func ApplyStakingTransaction(
 	receipt = types.NewReceipt(root, false, *usedGas)
 	receipt.TxHash = tx.Hash()
 	receipt.GasUsed = gas
-	receipt.EffectiveGasPrice = tx.EffectiveGasPrice(big.NewInt(0), nil)
+	receipt.EffectiveGasPrice = tx.EffectiveGasPrice(big.NewInt(0), header.BaseFee())
 
 	if config.IsReceiptLog(header.Epoch()) {
 		receipt.Logs = statedb.GetLogs(tx.Hash(), header.Number().Uint64(), header.Hash())
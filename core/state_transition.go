@@ -21,9 +21,11 @@ import (
 	"math/big"
 
 	"github.com/ethereum/go-ethereum/common"
+	cmath "github.com/ethereum/go-ethereum/common/math"
 	"github.com/ethereum/go-ethereum/rlp"
 	"github.com/harmony-one/harmony/core/types"
 	"github.com/harmony-one/harmony/core/vm"
+	"github.com/harmony-one/harmony/internal/params"
 	"github.com/harmony-one/harmony/internal/utils"
 	"github.com/harmony-one/harmony/numeric"
 	"github.com/harmony-one/harmony/shard"
@@ -85,6 +87,8 @@ type Message interface {
 	To() *common.Address
 
 	GasPrice() *big.Int
+	GasFeeCap() *big.Int
+	GasTipCap() *big.Int
 	Gas() uint64
 	Value() *big.Int
 
@@ -93,6 +97,7 @@ type Message interface {
 	Data() []byte
 	Type() types.TransactionType
 	BlockNum() *big.Int
+	AccessList() types.AccessList
 }
 
 // ExecutionResult is the return value from a transaction committed to the DB
@@ -135,13 +140,23 @@ func NewStateTransition(evm *vm.EVM, msg Message, gp *GasPool) *StateTransition
 		gp:       gp,
 		evm:      evm,
 		msg:      msg,
-		gasPrice: msg.GasPrice(),
+		gasPrice: effectiveGasPrice(msg, evm.BaseFee),
 		value:    msg.Value(),
 		data:     msg.Data(),
 		state:    evm.StateDB,
 	}
 }
 
+// effectiveGasPrice returns the price per gas the message pays in a block
+// with the given base fee: the fee cap, limited to the base fee plus the tip.
+// Before the London fork the base fee is nil and the gas price is paid as is.
+func effectiveGasPrice(msg Message, baseFee *big.Int) *big.Int {
+	if baseFee == nil || msg.GasFeeCap() == nil || msg.GasTipCap() == nil {
+		return msg.GasPrice()
+	}
+	return cmath.BigMin(new(big.Int).Add(msg.GasTipCap(), baseFee), msg.GasFeeCap())
+}
+
 // ApplyMessage computes the new state by applying the given message
 // against the old state within the environment.
 //
@@ -177,10 +192,16 @@ func (st *StateTransition) useGas(amount uint64) error {
 
 func (st *StateTransition) buyGas() error {
 	mgval := new(big.Int).Mul(new(big.Int).SetUint64(st.msg.Gas()), st.gasPrice)
-	if have := st.state.GetBalance(st.msg.From()); have.Cmp(mgval) < 0 {
+	// The sender must be able to afford the gas at its fee cap, even though
+	// only the effective gas price is charged.
+	balanceCheck := mgval
+	if st.evm.BaseFee != nil && st.msg.GasFeeCap() != nil {
+		balanceCheck = new(big.Int).Mul(new(big.Int).SetUint64(st.msg.Gas()), st.msg.GasFeeCap())
+	}
+	if have := st.state.GetBalance(st.msg.From()); have.Cmp(balanceCheck) < 0 {
 		return errors.Wrapf(
 			errInsufficientBalanceForGas,
-			"had: %s but need: %s", have.String(), mgval.String(),
+			"had: %s but need: %s", have.String(), balanceCheck.String(),
 		)
 	}
 	if err := st.gp.SubGas(st.msg.Gas()); err != nil {
@@ -204,6 +225,19 @@ func (st *StateTransition) preCheck() error {
 			return ErrNonceTooLow
 		}
 	}
+	// Make sure that the fee caps are consistent and cover the base fee.
+	if baseFee := st.evm.BaseFee; baseFee != nil && st.msg.GasFeeCap() != nil && st.msg.GasTipCap() != nil {
+		if st.msg.GasFeeCap().Cmp(st.msg.GasTipCap()) < 0 {
+			return errors.Wrapf(ErrTipAboveFeeCap,
+				"address %v, maxPriorityFeePerGas: %s, maxFeePerGas: %s",
+				st.msg.From().Hex(), st.msg.GasTipCap(), st.msg.GasFeeCap())
+		}
+		if st.msg.GasFeeCap().Cmp(baseFee) < 0 {
+			return errors.Wrapf(ErrFeeCapTooLow,
+				"address %v, maxFeePerGas: %s, baseFee: %s",
+				st.msg.From().Hex(), st.msg.GasFeeCap(), baseFee)
+		}
+	}
 	return st.buyGas()
 }
 
@@ -218,19 +252,48 @@ func (st *StateTransition) TransitionDb() (ExecutionResult, error) {
 	sender := vm.AccountRef(msg.From())
 	homestead := st.evm.ChainConfig().IsS3(st.evm.EpochNumber) // s3 includes homestead
 	istanbul := st.evm.ChainConfig().IsIstanbul(st.evm.EpochNumber)
+	berlin := st.evm.ChainConfig().IsBerlin(st.evm.EpochNumber)
+	eip3860 := st.evm.ChainConfig().IsEIP3860(st.evm.EpochNumber)
 	contractCreation := msg.To() == nil
 
+	// Check whether the init code size has been exceeded.
+	if eip3860 && contractCreation && len(st.data) > params.MaxInitCodeSize {
+		return ExecutionResult{}, fmt.Errorf("%w: code size %v limit %v", ErrMaxInitCodeSizeExceeded, len(st.data), params.MaxInitCodeSize)
+	}
+
 	// Pay intrinsic gas
 	gas, err := vm.IntrinsicGas(st.data, contractCreation, homestead, istanbul, false)
 	if err != nil {
 		return ExecutionResult{}, err
 	}
+	if berlin {
+		accessListGas, err := vm.AccessListGas(msg.AccessList())
+		if err != nil {
+			return ExecutionResult{}, err
+		}
+		gas += accessListGas
+	}
+	if eip3860 && contractCreation {
+		initCodeGas, err := vm.InitCodeGas(st.data)
+		if err != nil {
+			return ExecutionResult{}, err
+		}
+		gas += initCodeGas
+	}
 	if err = st.useGas(gas); err != nil {
 		return ExecutionResult{}, fmt.Errorf("%w: have %d, want %d", ErrIntrinsicGas, st.gas, gas)
 	}
 
 	evm := st.evm
 
+	// Set up the initial access list.
+	if berlin {
+		rules := evm.ChainConfig().Rules(evm.EpochNumber)
+		st.state.PrepareAccessList(msg.From(), msg.To(), vm.ActivePrecompiles(rules), msg.AccessList())
+	}
+	// Transient storage only lives for the duration of the transaction.
+	st.state.ClearTransientStorage()
+
 	var ret []byte
 	// All VM errors are valid except for insufficient balance, therefore returned separately
 	var vmErr error
@@ -279,12 +342,22 @@ func (st *StateTransition) refundGas() {
 	st.gp.AddGas(st.gas)
 }
 
+// collectGas pays out the transaction fee. After the London fork the base fee
+// portion of the fee is burned, only the tip is paid out.
 func (st *StateTransition) collectGas() {
+	price := st.gasPrice
+	if baseFee := st.evm.BaseFee; baseFee != nil {
+		// messages without fee caps, e.g. calls, may pay less than the base fee
+		price = new(big.Int).Sub(st.gasPrice, baseFee)
+		if price.Sign() < 0 {
+			return
+		}
+	}
 	if config := st.evm.ChainConfig(); !config.IsStaking(st.evm.EpochNumber) {
 		// Before staking epoch, add the fees to the block producer
 		txFee := new(big.Int).Mul(
 			new(big.Int).SetUint64(st.gasUsed()),
-			st.gasPrice,
+			price,
 		)
 		st.state.AddBalance(st.evm.Coinbase, txFee)
 	} else if feeCollectors := shard.Schedule.InstanceForEpoch(
@@ -295,7 +368,7 @@ func (st *StateTransition) collectGas() {
 		txFee := numeric.NewDecFromBigInt(
 			new(big.Int).Mul(
 				new(big.Int).SetUint64(st.gasUsed()),
-				st.gasPrice,
+				price,
 			),
 		)
 		for address, percent := range feeCollectors {
@@ -0,0 +1,98 @@
+package core
+
+import (
+	"os"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/pkg/errors"
+
+	hmyCommon "github.com/harmony-one/harmony/internal/common"
+	"github.com/harmony-one/harmony/internal/utils"
+)
+
+// ReadBlacklistFile reads the set of blacklisted accounts from the file, one
+// address per line with optional `#` comments.
+func ReadBlacklistFile(file string) (map[common.Address]struct{}, error) {
+	utils.Logger().Debug().Msgf("Using blacklist file at `%s`", file)
+	dat, err := os.ReadFile(file)
+	if err != nil {
+		return nil, err
+	}
+	addrMap := make(map[common.Address]struct{})
+	for _, line := range strings.Split(string(dat), "\n") {
+		if len(line) != 0 { // blacklist file may have trailing empty string line
+			b32 := strings.TrimSpace(strings.Split(string(line), "#")[0])
+			addr, err := hmyCommon.ParseAddr(b32)
+			if err != nil {
+				return nil, err
+			}
+			addrMap[addr] = struct{}{}
+		}
+	}
+	return addrMap, nil
+}
+
+// ReadAllowedTxsFile reads the allowed transactions from the file. A missing
+// file is an empty set of allowed transactions.
+func ReadAllowedTxsFile(file string) (map[common.Address][]AllowedTxData, error) {
+	// check if the file exists
+	if _, err := os.Stat(file); err == nil {
+		// read the file and parse allowed transactions
+		utils.Logger().Debug().Msgf("Using AllowedTxs file at `%s`", file)
+		data, err := os.ReadFile(file)
+		if err != nil {
+			return nil, err
+		}
+		return ParseAllowedTxs(data)
+	} else if errors.Is(err, os.ErrNotExist) {
+		// file path does not exist
+		utils.Logger().Debug().
+			Str("AllowedTxsFile", file).
+			Msg("AllowedTxs file doesn't exist")
+		return make(map[common.Address][]AllowedTxData), nil
+	} else {
+		// some other errors happened
+		utils.Logger().Error().Err(err).Msg("setup allowedTxs failed")
+		return nil, err
+	}
+}
+
+// ParseAllowedTxs parses the allowed transactions, one `from->to:data` entry per line
+func ParseAllowedTxs(data []byte) (map[common.Address][]AllowedTxData, error) {
+	allowedTxs := make(map[common.Address][]AllowedTxData)
+	for _, line := range strings.Split(string(data), "\n") {
+		line = strings.TrimSpace(line)
+		if len(line) != 0 { // AllowedTxs file may have trailing empty string line
+			substrings := strings.Split(string(line), "->")
+			if len(substrings) != 2 {
+				return nil, errors.Errorf("invalid allowed transaction: %s", line)
+			}
+			fromStr := strings.TrimSpace(substrings[0])
+			txSubstrings := strings.Split(substrings[1], ":")
+			if len(txSubstrings) != 2 {
+				return nil, errors.Errorf("invalid allowed transaction: %s", line)
+			}
+			toStr := strings.TrimSpace(txSubstrings[0])
+			dataStr := strings.TrimSpace(txSubstrings[1])
+			from, err := hmyCommon.ParseAddr(fromStr)
+			if err != nil {
+				return nil, err
+			}
+			to, err := hmyCommon.ParseAddr(toStr)
+			if err != nil {
+				return nil, err
+			}
+			data, err := hexutil.Decode(dataStr)
+			if err != nil {
+				return nil, err
+			}
+			allowedTxs[from] = append(allowedTxs[from], AllowedTxData{
+				To:   to,
+				Data: data,
+			})
+		}
+	}
+	return allowedTxs, nil
+}
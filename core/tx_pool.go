@@ -102,6 +102,14 @@ var (
 	ErrBlacklistTo = errors.New("`to` address of transaction in blacklist")
 
 	ErrAllowedTxs = errors.New("transaction allowed whitelist check failed.")
+
+	// ErrEvictedTransaction is reported to the error sink for transactions that were
+	// evicted from the pool by an admin.
+	ErrEvictedTransaction = errors.New("evicted transaction")
+
+	// ErrCancelledTransaction is returned if a transaction that was cancelled by an
+	// admin is attempted to be added to the pool again.
+	ErrCancelledTransaction = errors.New("cancelled transaction")
 )
 
 var (
@@ -159,6 +167,7 @@ type TxPoolConfig struct {
 	NoLocals  bool             // Whether local transaction handling should be disabled
 	Journal   string           // Journal of local transactions to survive node restarts
 	Rejournal time.Duration    // Time interval to regenerate the local transaction journal
+	Snapshot  string           // Snapshot of all pooled transactions written on shutdown & reloaded on start, disabled if empty
 
 	PriceLimit uint64 // Minimum gas price to enforce for acceptance into the pool
 	PriceBump  uint64 // Minimum price bump to replace an already existing transaction (nonce)
@@ -176,6 +185,10 @@ type TxPoolConfig struct {
 	AllowedTxs map[common.Address][]AllowedTxData // Set of allowed transactions can break the blocklist
 }
 
+// TxPoolSnapshotFile is the name of the transaction pool snapshot file in the
+// data directory of the node.
+const TxPoolSnapshotFile = "txpool_snapshot.rlp"
+
 // DefaultTxPoolConfig contains the default configurations for the transaction
 // pool.
 var DefaultTxPoolConfig = TxPoolConfig{
@@ -293,6 +306,8 @@ type TxPool struct {
 	locals  *accountSet // Set of local transaction to exempt from eviction rules
 	journal *txJournal  // Journal of local transaction to back up to disk
 
+	cancelled map[common.Hash]time.Time // Transactions cancelled by an admin & the time of cancellation
+
 	pending map[common.Address]*txList   // All currently processable transactions
 	queue   map[common.Address]*txList   // Queued but non-processable transactions
 	beats   map[common.Address]time.Time // Last heartbeat from each known account
@@ -305,6 +320,9 @@ type TxPool struct {
 
 	homestead bool
 	istanbul  bool
+	berlin    bool
+	london    bool
+	eip3860   bool
 }
 
 // NewTxPool creates a new transaction pool to gather, sort and filter inbound
@@ -324,6 +342,7 @@ func NewTxPool(config TxPoolConfig, chainconfig *params.ChainConfig,
 		queue:       make(map[common.Address]*txList),
 		beats:       make(map[common.Address]time.Time),
 		all:         newTxLookup(),
+		cancelled:   make(map[common.Hash]time.Time),
 		chainHeadCh: make(chan ChainHeadEvent, chainHeadChanSize),
 		gasPrice:    new(big.Int).SetUint64(config.PriceLimit),
 		txErrorSink: txErrorSink,
@@ -334,6 +353,8 @@ func NewTxPool(config TxPoolConfig, chainconfig *params.ChainConfig,
 		pool.locals.add(addr)
 	}
 	pool.priced = newTxPricedList(pool.all)
+	// the forks must be known before the journal and the snapshot are validated
+	pool.updateForks(chain.CurrentBlock().Epoch())
 	pool.reset(nil, chain.CurrentBlock().Header())
 
 	// If local transactions and journaling is enabled, load from disk
@@ -347,6 +368,10 @@ func NewTxPool(config TxPoolConfig, chainconfig *params.ChainConfig,
 			utils.Logger().Warn().Err(err).Msg("Failed to rotate transaction journal")
 		}
 	}
+	// If a pool snapshot was written on the last shutdown, re-validate and load it
+	if config.Snapshot != "" {
+		pool.loadSnapshot()
+	}
 	// Subscribe events from blockchain
 	pool.chainHeadSub = pool.chain.SubscribeChainHeadEvent(pool.chainHeadCh)
 
@@ -357,6 +382,25 @@ func NewTxPool(config TxPoolConfig, chainconfig *params.ChainConfig,
 	return pool
 }
 
+// updateForks enables the validation rules of the forks active at the epoch
+func (pool *TxPool) updateForks(epoch *big.Int) {
+	if pool.chainconfig.IsS3(epoch) {
+		pool.homestead = true
+	}
+	if pool.chainconfig.IsIstanbul(epoch) {
+		pool.istanbul = true
+	}
+	if pool.chainconfig.IsBerlin(epoch) {
+		pool.berlin = true
+	}
+	if pool.chainconfig.IsLondon(epoch) {
+		pool.london = true
+	}
+	if pool.chainconfig.IsEIP3860(epoch) {
+		pool.eip3860 = true
+	}
+}
+
 // loop is the transaction pool's main event loop, waiting for and reacting to
 // outside blockchain events as well as for various reporting and transaction
 // eviction events.
@@ -385,12 +429,7 @@ func (pool *TxPool) loop() {
 		case ev := <-pool.chainHeadCh:
 			if ev.Block != nil {
 				pool.mu.Lock()
-				if pool.chainconfig.IsS3(ev.Block.Epoch()) {
-					pool.homestead = true
-				}
-				if pool.chainconfig.IsIstanbul(ev.Block.Epoch()) {
-					pool.istanbul = true
-				}
+				pool.updateForks(ev.Block.Epoch())
 				pool.reset(head.Header(), ev.Block.Header())
 				head = ev.Block
 				pool.mu.Unlock()
@@ -435,6 +474,12 @@ func (pool *TxPool) loop() {
 					}
 				}
 			}
+			// Forget cancellations old enough for the transaction to have left the network
+			for hash, cancelled := range pool.cancelled {
+				if time.Since(cancelled) > pool.config.Lifetime {
+					delete(pool.cancelled, hash)
+				}
+			}
 			pool.mu.Unlock()
 
 		// Handle local transaction journal rotation
@@ -589,6 +634,9 @@ func (pool *TxPool) Stop() {
 	pool.chainHeadSub.Unsubscribe()
 	pool.wg.Wait()
 
+	if pool.config.Snapshot != "" {
+		pool.saveSnapshot()
+	}
 	if pool.journal != nil {
 		pool.journal.close()
 	}
@@ -624,6 +672,38 @@ func (pool *TxPool) SetGasPrice(price *big.Int) {
 	utils.Logger().Info().Str("price", price.String()).Msg("Transaction pool price threshold updated")
 }
 
+// SetTxFilters replaces the blacklist and the allowed transactions of the pool,
+// and evicts the pooled transactions of the newly blacklisted senders.
+func (pool *TxPool) SetTxFilters(
+	blacklist map[common.Address]struct{}, allowedTxs map[common.Address][]AllowedTxData,
+) types.PoolTransactions {
+	pool.mu.Lock()
+	defer pool.mu.Unlock()
+
+	pool.config.Blacklist = blacklist
+	pool.config.AllowedTxs = allowedTxs
+
+	txs := types.PoolTransactions{}
+	for addr := range blacklist {
+		if _, allowed := allowedTxs[addr]; allowed {
+			continue
+		}
+		if list := pool.pending[addr]; list != nil {
+			txs = append(txs, list.Flatten()...)
+		}
+		if list := pool.queue[addr]; list != nil {
+			txs = append(txs, list.Flatten()...)
+		}
+	}
+	evicted := pool.evictTxs(txs, "sender is blacklisted", false)
+	utils.Logger().Info().
+		Int("blacklist", len(blacklist)).
+		Int("allowedTxs", len(allowedTxs)).
+		Int("evicted", len(evicted)).
+		Msg("Transaction pool filters updated")
+	return evicted
+}
+
 // State returns the virtual managed state of the transaction pool.
 func (pool *TxPool) State() *state.ManagedState {
 	pool.mu.RLock()
@@ -672,6 +752,23 @@ func (pool *TxPool) Content() (map[common.Address]types.PoolTransactions, map[co
 	return pending, queued
 }
 
+// ContentFrom retrieves the data content of the transaction pool, returning the
+// pending as well as queued transactions of this address, sorted by nonce.
+func (pool *TxPool) ContentFrom(addr common.Address) (types.PoolTransactions, types.PoolTransactions) {
+	pool.mu.Lock()
+	defer pool.mu.Unlock()
+
+	var pending types.PoolTransactions
+	if list, ok := pool.pending[addr]; ok {
+		pending = list.Flatten()
+	}
+	var queued types.PoolTransactions
+	if list, ok := pool.queue[addr]; ok {
+		queued = list.Flatten()
+	}
+	return pending, queued
+}
+
 // Pending retrieves all currently executable transactions, grouped by origin
 // account and sorted by nonce. The returned transaction set is a copy and can be
 // freely modified by calling code.
@@ -730,10 +827,29 @@ func (pool *TxPool) validateTx(tx types.PoolTransaction, local bool) error {
 	if tx.ShardID() != pool.chain.CurrentBlock().ShardID() {
 		return errors.WithMessagef(ErrInvalidShard, "transaction shard is %d", tx.ShardID())
 	}
+	// Reject typed transactions until the Berlin fork activates.
+	plainTx, isPlainTx := tx.(*types.Transaction)
+	if isPlainTx && plainTx.Type() != types.LegacyTxType && !pool.berlin {
+		return errors.WithMessagef(types.ErrTxTypeNotSupported, "transaction type is %d", plainTx.Type())
+	}
+	// Reject dynamic fee transactions until the London fork activates.
+	if isPlainTx && plainTx.Type() == types.DynamicFeeTxType {
+		if !pool.london {
+			return errors.WithMessagef(types.ErrTxTypeNotSupported, "transaction type is %d", plainTx.Type())
+		}
+		if plainTx.GasFeeCap().Cmp(plainTx.GasTipCap()) < 0 {
+			return errors.WithMessagef(ErrTipAboveFeeCap, "transaction tip is %s, fee cap is %s",
+				plainTx.GasTipCap(), plainTx.GasFeeCap())
+		}
+	}
 	// For DOS prevention, reject excessively large transactions.
 	if tx.Size() >= types.MaxPoolTransactionDataSize {
 		return errors.WithMessagef(ErrOversizedData, "transaction size is %s", tx.Size().String())
 	}
+	// Check whether the init code size has been exceeded.
+	if pool.eip3860 && isPlainTx && tx.To() == nil && len(tx.Data()) > params.MaxInitCodeSize {
+		return errors.WithMessagef(ErrMaxInitCodeSizeExceeded, "code size %v limit %v", len(tx.Data()), params.MaxInitCodeSize)
+	}
 	// Transactions can't be negative. This may never happen using RLP decoded
 	// transactions but may occur if you create a transaction using the RPC.
 	if tx.Value().Sign() < 0 {
@@ -788,7 +904,8 @@ func (pool *TxPool) validateTx(tx types.PoolTransaction, local bool) error {
 			}
 		}
 	}
-	// Drop non-local transactions under our own minimal accepted gas price
+	// Drop non-local transactions under our own minimal accepted gas price.
+	// For dynamic fee transactions the gas price is the fee cap.
 	local = local || pool.locals.contains(from) // account may be local even if the transaction arrived from the network
 	if !local && pool.gasPrice.Cmp(tx.GasPrice()) > 0 {
 		gasPrice := new(big.Float).SetInt64(tx.GasPrice().Int64())
@@ -827,6 +944,20 @@ func (pool *TxPool) validateTx(tx types.PoolTransaction, local bool) error {
 	if err != nil {
 		return err
 	}
+	if isPlainTx && pool.berlin {
+		accessListGas, err := vm.AccessListGas(plainTx.AccessList())
+		if err != nil {
+			return err
+		}
+		intrGas += accessListGas
+	}
+	if isPlainTx && pool.eip3860 && tx.To() == nil {
+		initCodeGas, err := vm.InitCodeGas(tx.Data())
+		if err != nil {
+			return err
+		}
+		intrGas += initCodeGas
+	}
 	if tx.GasLimit() < intrGas {
 		return errors.WithMessagef(ErrIntrinsicGas, "transaction gas is %d", tx.GasLimit())
 	}
@@ -1004,6 +1135,11 @@ func (pool *TxPool) add(tx types.PoolTransaction, local bool) (replaced bool, er
 		logger.Debug().Str("hash", hash.Hex()).Msg("Discarding already known transaction")
 		return false, errors.WithMessagef(ErrKnownTransaction, "transaction hash %x", hash)
 	}
+	// If the transaction was cancelled, don't let it back in through a rebroadcast
+	if _, ok := pool.cancelled[hash]; ok {
+		logger.Debug().Str("hash", hash.Hex()).Msg("Discarding cancelled transaction")
+		return false, errors.WithMessagef(ErrCancelledTransaction, "transaction hash %x", hash)
+	}
 	// If the transaction fails basic validation, discard it
 	if err := pool.validateTx(tx, local); err != nil {
 		logger.Debug().Err(err).Str("hash", hash.Hex()).Msg("Discarding invalid transaction")
@@ -1316,6 +1452,69 @@ func (pool *TxPool) Get(hash common.Hash) types.PoolTransaction {
 	return pool.all.Get(hash)
 }
 
+// EvictTransactions removes the transactions with the given hashes from the pool,
+// reporting the reason of the eviction to the error sink. If cancel is set, the
+// evicted transactions are also refused re-entry until they would have expired.
+// The evicted transactions are returned.
+func (pool *TxPool) EvictTransactions(hashes []common.Hash, reason string, cancel bool) types.PoolTransactions {
+	pool.mu.Lock()
+	defer pool.mu.Unlock()
+
+	txs := types.PoolTransactions{}
+	for _, hash := range hashes {
+		if tx := pool.all.Get(hash); tx != nil {
+			txs = append(txs, tx)
+		}
+	}
+	return pool.evictTxs(txs, reason, cancel)
+}
+
+// EvictTransactionsFrom removes all pending & queued transactions of the sender
+// from the pool, the same way as EvictTransactions.
+func (pool *TxPool) EvictTransactionsFrom(addr common.Address, reason string, cancel bool) types.PoolTransactions {
+	pool.mu.Lock()
+	defer pool.mu.Unlock()
+
+	txs := types.PoolTransactions{}
+	if list := pool.pending[addr]; list != nil {
+		txs = append(txs, list.Flatten()...)
+	}
+	if list := pool.queue[addr]; list != nil {
+		txs = append(txs, list.Flatten()...)
+	}
+	return pool.evictTxs(txs, reason, cancel)
+}
+
+// evictTxs removes the given pooled transactions, whilst assuming the transaction
+// pool lock is already held.
+func (pool *TxPool) evictTxs(txs types.PoolTransactions, reason string, cancel bool) types.PoolTransactions {
+	evictErr := errors.WithMessage(ErrEvictedTransaction, reason)
+	if cancel {
+		evictErr = errors.WithMessage(ErrCancelledTransaction, reason)
+	}
+	evictedLocal := false
+	for _, tx := range txs {
+		evictedLocal = evictedLocal || pool.locals.containsTx(tx)
+		pool.removeTx(tx.Hash(), true)
+		if cancel {
+			pool.cancelled[tx.Hash()] = time.Now()
+		}
+		pool.txErrorSink.Add(tx, evictErr)
+		utils.Logger().Info().
+			Str("hash", tx.Hash().Hex()).
+			Str("reason", reason).
+			Bool("cancel", cancel).
+			Msg("Evicted transaction from pool")
+	}
+	// Drop the evicted local transactions from the journal as well
+	if evictedLocal && pool.journal != nil {
+		if err := pool.journal.rotate(pool.local()); err != nil {
+			utils.Logger().Warn().Err(err).Msg("Failed to rotate local tx journal")
+		}
+	}
+	return txs
+}
+
 // removeTx removes a single transaction from the queue, moving all subsequent
 // transactions back to the future queue.
 func (pool *TxPool) removeTx(hash common.Hash, outofbound bool) {
@@ -1628,7 +1827,7 @@ type accountSet struct {
 func newAccountSet(chainID *big.Int) *accountSet {
 	return &accountSet{
 		accounts: make(map[common.Address]struct{}),
-		signer:   types.NewEIP155Signer(chainID),
+		signer:   types.NewLondonSigner(chainID),
 	}
 }
 
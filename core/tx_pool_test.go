@@ -44,6 +44,7 @@ import (
 	"github.com/harmony-one/harmony/internal/params"
 	"github.com/harmony-one/harmony/numeric"
 	staking "github.com/harmony-one/harmony/staking/types"
+	"github.com/pkg/errors"
 )
 
 var (
@@ -60,6 +61,7 @@ var (
 func init() {
 	testTxPoolConfig = DefaultTxPoolConfig
 	testTxPoolConfig.Journal = ""
+	testTxPoolConfig.Snapshot = ""
 }
 
 type testBlockChain struct {
@@ -1457,6 +1459,264 @@ func testTransactionJournaling(t *testing.T, nolocals bool) {
 	pool.Stop()
 }
 
+// TestTransactionPoolSnapshot tests that remote and queued transactions survive
+// a restart through the pool snapshot, and are re-validated against the head state.
+func TestTransactionPoolSnapshot(t *testing.T) {
+	t.Parallel()
+
+	// Create a temporary file for the snapshot
+	file, err := os.CreateTemp("", "")
+	if err != nil {
+		t.Fatalf("failed to create temporary snapshot: %v", err)
+	}
+	snapshot := file.Name()
+	defer os.Remove(snapshot)
+
+	// Clean up the temporary file, we only need the path for now
+	file.Close()
+	os.Remove(snapshot)
+
+	statedb, _ := state.New(common.Hash{}, state.NewDatabase(rawdb.NewMemoryDatabase()), nil)
+	blockchain := &testBlockChain{statedb, 1000000, new(event.Feed)}
+
+	config := testTxPoolConfig
+	config.Snapshot = snapshot
+
+	pool := NewTxPool(config, params.TestChainConfig, blockchain, dummyErrorSink)
+
+	remote, _ := crypto.GenerateKey()
+	stale, _ := crypto.GenerateKey()
+	pool.currentState.AddBalance(crypto.PubkeyToAddress(remote.PublicKey), big.NewInt(9_000_000_000e9))
+	pool.currentState.AddBalance(crypto.PubkeyToAddress(stale.PublicKey), big.NewInt(9_000_000_000e9))
+
+	// Add two pending and a queued remote transaction, plus one that gets stale
+	errs := pool.AddRemotes(types.PoolTransactions{
+		pricedTransaction(0, 0, 100000, big.NewInt(100e9), remote),
+		pricedTransaction(0, 1, 100000, big.NewInt(100e9), remote),
+		pricedTransaction(0, 3, 100000, big.NewInt(100e9), remote),
+		pricedTransaction(0, 0, 100000, big.NewInt(100e9), stale),
+	})
+	for i, err := range errs {
+		if err != nil {
+			t.Fatalf("failed to add remote transaction %d: %v", i, err)
+		}
+	}
+	pending, queued := pool.Stats()
+	if pending != 3 {
+		t.Fatalf("pending transactions mismatched: have %d, want %d", pending, 3)
+	}
+	if queued != 1 {
+		t.Fatalf("queued transactions mismatched: have %d, want %d", queued, 1)
+	}
+	pool.Stop()
+
+	if _, err := os.Stat(snapshot); err != nil {
+		t.Fatalf("expected snapshot to be written: %v", err)
+	}
+	// Restart with the stale transaction included in the head state
+	statedb.SetNonce(crypto.PubkeyToAddress(stale.PublicKey), 1)
+	blockchain = &testBlockChain{statedb, 1000000, new(event.Feed)}
+	pool = NewTxPool(config, params.TestChainConfig, blockchain, dummyErrorSink)
+	defer pool.Stop()
+
+	pending, queued = pool.Stats()
+	if pending != 2 {
+		t.Fatalf("pending transactions mismatched: have %d, want %d", pending, 2)
+	}
+	if queued != 1 {
+		t.Fatalf("queued transactions mismatched: have %d, want %d", queued, 1)
+	}
+	if err := validateTxPoolInternals(pool); err != nil {
+		t.Fatalf("pool internal state corrupted: %v", err)
+	}
+	if _, err := os.Stat(snapshot); !os.IsNotExist(err) {
+		t.Fatalf("expected snapshot to be removed after loading, got %v", err)
+	}
+}
+
+// TestTransactionPoolSnapshotTyped tests that typed transactions survive a restart
+// through the pool snapshot, before any new head is seen by the restarted pool.
+func TestTransactionPoolSnapshotTyped(t *testing.T) {
+	t.Parallel()
+
+	file, err := os.CreateTemp("", "")
+	if err != nil {
+		t.Fatalf("failed to create temporary snapshot: %v", err)
+	}
+	snapshot := file.Name()
+	defer os.Remove(snapshot)
+	file.Close()
+	os.Remove(snapshot)
+
+	statedb, _ := state.New(common.Hash{}, state.NewDatabase(rawdb.NewMemoryDatabase()), nil)
+	blockchain := &testBlockChain{statedb, 1000000, new(event.Feed)}
+
+	config := testTxPoolConfig
+	config.Snapshot = snapshot
+
+	pool := NewTxPool(config, params.TestChainConfig, blockchain, dummyErrorSink)
+
+	key, _ := crypto.GenerateKey()
+	pool.currentState.AddBalance(crypto.PubkeyToAddress(key.PublicKey), big.NewInt(9_000_000_000e9))
+
+	chainID := params.TestChainConfig.ChainID
+	to := common.HexToAddress("0x000000000000000000000000000000000000dead")
+	tx, err := types.SignTx(types.NewAccessListTransaction(chainID, 0, &to, 0, 0, big.NewInt(1), 100000, big.NewInt(100e9), nil, types.AccessList{
+		{Address: to, StorageKeys: []common.Hash{{0}, {1}}},
+	}), types.NewEIP2930Signer(chainID), key)
+	if err != nil {
+		t.Fatalf("failed to sign access list transaction: %v", err)
+	}
+	if err := pool.AddRemote(tx); err != nil {
+		t.Fatalf("failed to add access list transaction: %v", err)
+	}
+	pool.Stop()
+
+	pool = NewTxPool(config, params.TestChainConfig, blockchain, dummyErrorSink)
+	defer pool.Stop()
+
+	if pending, _ := pool.Stats(); pending != 1 {
+		t.Fatalf("pending transactions mismatched: have %d, want %d", pending, 1)
+	}
+	loaded, ok := pool.Get(tx.Hash()).(*types.Transaction)
+	if !ok {
+		t.Fatalf("access list transaction %x not loaded from the snapshot", tx.Hash())
+	}
+	if loaded.Type() != types.AccessListTxType || len(loaded.AccessList()) != 1 {
+		t.Fatalf("access list transaction not preserved: type %d, access list %v", loaded.Type(), loaded.AccessList())
+	}
+}
+
+// TestTransactionEviction tests that transactions can be evicted & cancelled by
+// hash or sender, with the reason reported to the error sink.
+func TestTransactionEviction(t *testing.T) {
+	t.Parallel()
+
+	pool, key := setupTxPool(nil)
+	defer pool.Stop()
+
+	testTxErrorSink := types.NewTransactionErrorSink()
+	pool.txErrorSink = testTxErrorSink
+
+	from := crypto.PubkeyToAddress(key.PublicKey)
+	pool.currentState.AddBalance(from, big.NewInt(9_000_000_000e9))
+
+	txs := types.PoolTransactions{}
+	for i := uint64(0); i < 4; i++ {
+		txs = append(txs, transaction(0, i, 100000, key))
+	}
+	for i, err := range pool.AddRemotes(txs) {
+		if err != nil {
+			t.Fatalf("failed to add transaction %d: %v", i, err)
+		}
+	}
+
+	// Evicting a pending transaction demotes the subsequent ones
+	evicted := pool.EvictTransactions([]common.Hash{txs[2].Hash()}, "test eviction", false)
+	if len(evicted) != 1 || evicted[0].Hash() != txs[2].Hash() {
+		t.Fatalf("evicted transactions mismatched: have %v, want %v", evicted, txs[2:3])
+	}
+	pending, queued := pool.Stats()
+	if pending != 2 || queued != 1 {
+		t.Fatalf("pool stats mismatched: have %d/%d, want %d/%d", pending, queued, 2, 1)
+	}
+	if !testTxErrorSink.Contains(txs[2].Hash().String()) {
+		t.Error("expected evicted transaction in error sink")
+	}
+	// An evicted transaction may be submitted again
+	if err := pool.AddRemote(txs[2]); err != nil {
+		t.Fatalf("failed to re-add evicted transaction: %v", err)
+	}
+
+	// Cancelling all transactions of the sender refuses them afterwards
+	evicted = pool.EvictTransactionsFrom(from, "test cancellation", true)
+	if len(evicted) != len(txs) {
+		t.Fatalf("cancelled transactions mismatched: have %d, want %d", len(evicted), len(txs))
+	}
+	if pending, queued := pool.Stats(); pending != 0 || queued != 0 {
+		t.Fatalf("pool stats mismatched: have %d/%d, want %d/%d", pending, queued, 0, 0)
+	}
+	if err := pool.AddRemote(txs[0]); err != ErrCancelledTransaction {
+		t.Fatalf("expected %v, got %v", ErrCancelledTransaction, err)
+	}
+	if err := validateTxPoolInternals(pool); err != nil {
+		t.Fatalf("pool internal state corrupted: %v", err)
+	}
+}
+
+// TestTransactionPoolSetTxFilters tests that reloading the blacklist evicts the
+// transactions of the newly blacklisted senders and refuses them afterwards.
+func TestTransactionPoolSetTxFilters(t *testing.T) {
+	t.Parallel()
+
+	pool, key := setupTxPool(nil)
+	defer pool.Stop()
+
+	from := crypto.PubkeyToAddress(key.PublicKey)
+	pool.currentState.AddBalance(from, big.NewInt(9_000_000_000e9))
+
+	txs := types.PoolTransactions{transaction(0, 0, 100000, key), transaction(0, 1, 100000, key)}
+	for i, err := range pool.AddRemotes(txs) {
+		if err != nil {
+			t.Fatalf("failed to add transaction %d: %v", i, err)
+		}
+	}
+	// An allowlisted sender keeps its pooled transactions
+	allowedTxs := map[common.Address][]AllowedTxData{from: {{To: common.Address{}}}}
+	if evicted := pool.SetTxFilters(map[common.Address]struct{}{from: {}}, allowedTxs); len(evicted) != 0 {
+		t.Fatalf("evicted transactions of allowlisted sender: %v", evicted)
+	}
+	evicted := pool.SetTxFilters(map[common.Address]struct{}{from: {}}, map[common.Address][]AllowedTxData{})
+	if len(evicted) != len(txs) {
+		t.Fatalf("evicted transactions mismatched: have %d, want %d", len(evicted), len(txs))
+	}
+	if pending, queued := pool.Stats(); pending != 0 || queued != 0 {
+		t.Fatalf("pool stats mismatched: have %d/%d, want %d/%d", pending, queued, 0, 0)
+	}
+	if err := pool.AddRemote(txs[0]); errors.Cause(err) != ErrBlacklistFrom {
+		t.Fatalf("expected %v, got %v", ErrBlacklistFrom, err)
+	}
+	if err := validateTxPoolInternals(pool); err != nil {
+		t.Fatalf("pool internal state corrupted: %v", err)
+	}
+}
+
+// TestStakingTransactionReplacement tests that plain & staking transactions
+// share the nonce space of the sender and can replace each other by fee.
+func TestStakingTransactionReplacement(t *testing.T) {
+	t.Parallel()
+
+	pool, _ := setupTxPool(createBlockChain())
+	defer pool.Stop()
+
+	fromKey, _ := crypto.GenerateKey()
+	stx, err := stakingCreateValidatorTransaction(fromKey)
+	if err != nil {
+		t.Fatalf("cannot create new staking transaction, %v\n", err)
+	}
+	from, _ := stx.SenderAddress()
+	pool.currentState.AddBalance(from, hundredKOnes)
+	pool.currentState.AddBalance(from, cost)
+
+	if err := pool.AddRemote(stx); err != nil {
+		t.Fatalf("failed to add staking transaction: %v", err)
+	}
+	// Same nonce at the same price is not enough of a bump
+	if err := pool.AddRemote(pricedTransaction(0, 0, 100000, big.NewInt(100e9), fromKey)); err != ErrReplaceUnderpriced {
+		t.Fatalf("expected %v, got %v", ErrReplaceUnderpriced, err)
+	}
+	tx := pricedTransaction(0, 0, 100000, big.NewInt(110e9), fromKey)
+	if err := pool.AddRemote(tx); err != nil {
+		t.Fatalf("failed to replace staking transaction: %v", err)
+	}
+	if pool.Get(stx.Hash()) != nil {
+		t.Error("expected staking transaction to be replaced")
+	}
+	if pool.pending[from] == nil || pool.pending[from].Len() != 1 || pool.Get(tx.Hash()) == nil {
+		t.Error("expected replacement transaction to be pending")
+	}
+}
+
 // TestTransactionStatusCheck tests that the pool can correctly retrieve the
 // pending status of individual transactions.
 func TestTransactionStatusCheck(t *testing.T) {
@@ -0,0 +1,126 @@
+package core
+
+import (
+	"io"
+	"os"
+
+	"github.com/ethereum/go-ethereum/rlp"
+	"github.com/pkg/errors"
+
+	"github.com/harmony-one/harmony/core/types"
+	"github.com/harmony-one/harmony/internal/utils"
+	staking "github.com/harmony-one/harmony/staking/types"
+)
+
+// writePoolSnapshot writes all the given transactions to the snapshot file at path,
+// using the same encoding as the transaction journal. The file is replaced atomically.
+func writePoolSnapshot(path string, txs types.PoolTransactions) error {
+	output, err := os.OpenFile(path+".new", os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0644)
+	if err != nil {
+		return err
+	}
+	for _, tx := range txs {
+		if err := writeJournalTx(output, tx); err != nil {
+			output.Close()
+			return err
+		}
+	}
+	if err := output.Close(); err != nil {
+		return err
+	}
+	return os.Rename(path+".new", path)
+}
+
+// readPoolSnapshot reads all transactions of the snapshot file at path.
+// A missing snapshot file is not an error.
+func readPoolSnapshot(path string) (types.PoolTransactions, error) {
+	input, err := os.Open(path)
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	defer input.Close()
+
+	txs := types.PoolTransactions{}
+	stream := rlp.NewStream(input, 0)
+	for {
+		var tx types.PoolTransaction
+		txType, err := stream.Uint64()
+		if err == io.EOF {
+			return txs, nil
+		}
+		if err != nil {
+			return txs, err
+		}
+		switch txType {
+		case plainTxID:
+			tx = new(types.Transaction)
+		case stakingTxID:
+			tx = new(staking.StakingTransaction)
+		default:
+			return txs, errors.Errorf("unknown snapshot transaction type %v", txType)
+		}
+		if err := stream.Decode(tx); err != nil {
+			return txs, err
+		}
+		txs = append(txs, tx)
+	}
+}
+
+// saveSnapshot writes all pending & queued transactions of the pool to the snapshot file.
+func (pool *TxPool) saveSnapshot() {
+	pool.mu.RLock()
+	txs := types.PoolTransactions{}
+	for _, list := range pool.pending {
+		txs = append(txs, list.Flatten()...)
+	}
+	for _, list := range pool.queue {
+		txs = append(txs, list.Flatten()...)
+	}
+	pool.mu.RUnlock()
+
+	if err := writePoolSnapshot(pool.config.Snapshot, txs); err != nil {
+		utils.Logger().Warn().Err(err).Msg("Failed to write transaction pool snapshot")
+		return
+	}
+	utils.Logger().Info().
+		Int("transactions", txs.Len()).
+		Msg("Wrote transaction pool snapshot")
+}
+
+// loadSnapshot re-adds the transactions of the snapshot file to the pool, validating
+// them against the current head state, and removes the snapshot afterwards.
+// Transactions of local accounts keep their local status.
+func (pool *TxPool) loadSnapshot() {
+	txs, err := readPoolSnapshot(pool.config.Snapshot)
+	if err != nil {
+		utils.Logger().Warn().Err(err).Msg("Failed to read transaction pool snapshot")
+	}
+	if txs.Len() == 0 {
+		return
+	}
+	locals, remotes := types.PoolTransactions{}, types.PoolTransactions{}
+	for _, tx := range txs {
+		if !pool.config.NoLocals && pool.locals.containsTx(tx) {
+			locals = append(locals, tx)
+		} else {
+			remotes = append(remotes, tx)
+		}
+	}
+	dropped := 0
+	for _, err := range append(pool.AddLocals(locals), pool.AddRemotes(remotes)...) {
+		// Local transactions may already be loaded from the journal
+		if err != nil && errors.Cause(err) != ErrKnownTransaction {
+			dropped++
+		}
+	}
+	if err := os.Remove(pool.config.Snapshot); err != nil {
+		utils.Logger().Warn().Err(err).Msg("Failed to remove transaction pool snapshot")
+	}
+	utils.Logger().Info().
+		Int("transactions", txs.Len()).
+		Int("dropped", dropped).
+		Msg("Loaded transaction pool snapshot")
+}
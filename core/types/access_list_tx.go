@@ -0,0 +1,311 @@
+package types
+
+import (
+	"bytes"
+	"errors"
+	"math/big"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/rlp"
+)
+
+// Transaction types.
+const (
+	LegacyTxType     = 0x00
+	AccessListTxType = 0x01
+	DynamicFeeTxType = 0x02
+)
+
+// Errors for typed transactions.
+var (
+	ErrTxTypeNotSupported = errors.New("transaction type not supported")
+	errEmptyTypedTx       = errors.New("empty typed transaction bytes")
+)
+
+// AccessList is an EIP-2930 access list.
+type AccessList []AccessTuple
+
+// AccessTuple is the element type of an access list.
+type AccessTuple struct {
+	Address     common.Address `json:"address"     gencodec:"required"`
+	StorageKeys []common.Hash  `json:"storageKeys" gencodec:"required"`
+}
+
+// StorageKeys returns the total number of storage keys in the access list.
+func (al AccessList) StorageKeys() int {
+	sum := 0
+	for _, tuple := range al {
+		sum += len(tuple.StorageKeys)
+	}
+	return sum
+}
+
+// Copy returns a deep copy of the access list.
+func (al AccessList) Copy() AccessList {
+	if al == nil {
+		return nil
+	}
+	cpy := make(AccessList, len(al))
+	for i, tuple := range al {
+		cpy[i] = AccessTuple{
+			Address:     tuple.Address,
+			StorageKeys: append(tuple.StorageKeys[:0:0], tuple.StorageKeys...),
+		}
+	}
+	return cpy
+}
+
+// accessListTxdata is the consensus encoding of a harmony access list
+// transaction, carried after the AccessListTxType prefix byte.
+type accessListTxdata struct {
+	ChainID      *big.Int
+	AccountNonce uint64
+	Price        *big.Int
+	GasLimit     uint64
+	ShardID      uint32
+	ToShardID    uint32
+	Recipient    *common.Address `rlp:"nil"` // nil means contract creation
+	Amount       *big.Int
+	Payload      []byte
+	AccessList   AccessList
+
+	// Signature values
+	V *big.Int
+	R *big.Int
+	S *big.Int
+}
+
+// ethAccessListTxdata is the consensus encoding of an ethereum-compatible
+// access list transaction, as specified by EIP-2930.
+type ethAccessListTxdata struct {
+	ChainID      *big.Int
+	AccountNonce uint64
+	Price        *big.Int
+	GasLimit     uint64
+	Recipient    *common.Address `rlp:"nil"` // nil means contract creation
+	Amount       *big.Int
+	Payload      []byte
+	AccessList   AccessList
+
+	// Signature values
+	V *big.Int
+	R *big.Int
+	S *big.Int
+}
+
+// NewAccessListTransaction returns a new access list transaction.
+func NewAccessListTransaction(chainID *big.Int, nonce uint64, to *common.Address, shardID uint32, toShardID uint32, amount *big.Int, gasLimit uint64, gasPrice *big.Int, data []byte, accessList AccessList) *Transaction {
+	tx := newCrossShardTransaction(nonce, to, shardID, toShardID, amount, gasLimit, gasPrice, data)
+	tx.data.Type = AccessListTxType
+	tx.data.ChainID = new(big.Int)
+	if chainID != nil {
+		tx.data.ChainID.Set(chainID)
+	}
+	tx.data.AccessList = accessList.Copy()
+	return tx
+}
+
+// NewEthAccessListTransaction returns a new ethereum-compatible access list transaction.
+func NewEthAccessListTransaction(chainID *big.Int, nonce uint64, to *common.Address, amount *big.Int, gasLimit uint64, gasPrice *big.Int, data []byte, accessList AccessList) *EthTransaction {
+	tx := newEthTransaction(nonce, to, amount, gasLimit, gasPrice, data)
+	tx.data.Type = AccessListTxType
+	tx.data.ChainID = new(big.Int)
+	if chainID != nil {
+		tx.data.ChainID.Set(chainID)
+	}
+	tx.data.AccessList = accessList.Copy()
+	return tx
+}
+
+func (d *txdata) typedPayload() interface{} {
+	if d.Type == DynamicFeeTxType {
+		return d.dynamicFeePayload()
+	}
+	return &accessListTxdata{
+		ChainID:      d.ChainID,
+		AccountNonce: d.AccountNonce,
+		Price:        d.Price,
+		GasLimit:     d.GasLimit,
+		ShardID:      d.ShardID,
+		ToShardID:    d.ToShardID,
+		Recipient:    d.Recipient,
+		Amount:       d.Amount,
+		Payload:      d.Payload,
+		AccessList:   d.AccessList,
+		V:            d.V,
+		R:            d.R,
+		S:            d.S,
+	}
+}
+
+func (d *ethTxdata) typedPayload() interface{} {
+	if d.Type == DynamicFeeTxType {
+		return d.dynamicFeePayload()
+	}
+	return &ethAccessListTxdata{
+		ChainID:      d.ChainID,
+		AccountNonce: d.AccountNonce,
+		Price:        d.Price,
+		GasLimit:     d.GasLimit,
+		Recipient:    d.Recipient,
+		Amount:       d.Amount,
+		Payload:      d.Payload,
+		AccessList:   d.AccessList,
+		V:            d.V,
+		R:            d.R,
+		S:            d.S,
+	}
+}
+
+// encodeTyped writes the canonical type || rlp(payload) encoding.
+func encodeTyped(txType uint8, payload interface{}) ([]byte, error) {
+	var buf bytes.Buffer
+	buf.WriteByte(txType)
+	if err := rlp.Encode(&buf, payload); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+func decodeTypedTxdata(b []byte) (txdata, error) {
+	if len(b) == 0 {
+		return txdata{}, errEmptyTypedTx
+	}
+	switch b[0] {
+	case AccessListTxType:
+	case DynamicFeeTxType:
+		return decodeDynamicFeeTxdata(b)
+	default:
+		return txdata{}, ErrTxTypeNotSupported
+	}
+	var inner accessListTxdata
+	if err := rlp.DecodeBytes(b[1:], &inner); err != nil {
+		return txdata{}, err
+	}
+	return txdata{
+		Type:         b[0],
+		ChainID:      inner.ChainID,
+		AccountNonce: inner.AccountNonce,
+		Price:        inner.Price,
+		GasLimit:     inner.GasLimit,
+		ShardID:      inner.ShardID,
+		ToShardID:    inner.ToShardID,
+		Recipient:    inner.Recipient,
+		Amount:       inner.Amount,
+		Payload:      inner.Payload,
+		AccessList:   inner.AccessList,
+		V:            inner.V,
+		R:            inner.R,
+		S:            inner.S,
+	}, nil
+}
+
+func decodeTypedEthTxdata(b []byte) (ethTxdata, error) {
+	if len(b) == 0 {
+		return ethTxdata{}, errEmptyTypedTx
+	}
+	switch b[0] {
+	case AccessListTxType:
+	case DynamicFeeTxType:
+		return decodeDynamicFeeEthTxdata(b)
+	default:
+		return ethTxdata{}, ErrTxTypeNotSupported
+	}
+	var inner ethAccessListTxdata
+	if err := rlp.DecodeBytes(b[1:], &inner); err != nil {
+		return ethTxdata{}, err
+	}
+	return ethTxdata{
+		Type:         b[0],
+		ChainID:      inner.ChainID,
+		AccountNonce: inner.AccountNonce,
+		Price:        inner.Price,
+		GasLimit:     inner.GasLimit,
+		Recipient:    inner.Recipient,
+		Amount:       inner.Amount,
+		Payload:      inner.Payload,
+		AccessList:   inner.AccessList,
+		V:            inner.V,
+		R:            inner.R,
+		S:            inner.S,
+	}, nil
+}
+
+// MarshalBinary returns the canonical encoding of the transaction.
+// For legacy transactions, it returns the RLP encoding. For typed
+// transactions, it returns the type and payload.
+func (tx *Transaction) MarshalBinary() ([]byte, error) {
+	if tx.data.Type == LegacyTxType {
+		return rlp.EncodeToBytes(&tx.data)
+	}
+	return encodeTyped(tx.data.Type, tx.data.typedPayload())
+}
+
+// UnmarshalBinary decodes the canonical encoding of transactions.
+// It supports legacy RLP transactions and typed transactions.
+func (tx *Transaction) UnmarshalBinary(b []byte) error {
+	if len(b) > 0 && b[0] > 0x7f {
+		// It's a legacy transaction.
+		var data txdata
+		if err := rlp.DecodeBytes(b, &data); err != nil {
+			return err
+		}
+		tx.setDecoded(data, len(b))
+		return nil
+	}
+	data, err := decodeTypedTxdata(b)
+	if err != nil {
+		return err
+	}
+	tx.setDecoded(data, len(b))
+	return nil
+}
+
+// setDecoded sets the inner transaction data and size after decoding.
+func (tx *Transaction) setDecoded(data txdata, size int) {
+	tx.data = data
+	tx.time = time.Now()
+	if size > 0 {
+		tx.size.Store(common.StorageSize(size))
+	}
+}
+
+// MarshalBinary returns the canonical encoding of the transaction.
+// For legacy transactions, it returns the RLP encoding. For typed
+// transactions, it returns the type and payload.
+func (tx *EthTransaction) MarshalBinary() ([]byte, error) {
+	if tx.data.Type == LegacyTxType {
+		return rlp.EncodeToBytes(&tx.data)
+	}
+	return encodeTyped(tx.data.Type, tx.data.typedPayload())
+}
+
+// UnmarshalBinary decodes the canonical encoding of transactions.
+// It supports legacy RLP transactions and typed transactions.
+func (tx *EthTransaction) UnmarshalBinary(b []byte) error {
+	if len(b) > 0 && b[0] > 0x7f {
+		// It's a legacy transaction.
+		var data ethTxdata
+		if err := rlp.DecodeBytes(b, &data); err != nil {
+			return err
+		}
+		tx.setDecoded(data, len(b))
+		return nil
+	}
+	data, err := decodeTypedEthTxdata(b)
+	if err != nil {
+		return err
+	}
+	tx.setDecoded(data, len(b))
+	return nil
+}
+
+// setDecoded sets the inner transaction data and size after decoding.
+func (tx *EthTransaction) setDecoded(data ethTxdata, size int) {
+	tx.data = data
+	tx.time = time.Now()
+	if size > 0 {
+		tx.size.Store(common.StorageSize(size))
+	}
+}
@@ -0,0 +1,192 @@
+package types
+
+import (
+	"bytes"
+	"encoding/json"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/rlp"
+)
+
+var testAccessList = AccessList{
+	{
+		Address:     common.HexToAddress("0x0000000000000000000000000000000000000001"),
+		StorageKeys: []common.Hash{{0}, {1}},
+	},
+	{
+		Address: common.HexToAddress("0x0000000000000000000000000000000000000002"),
+	},
+}
+
+func TestAccessListTxSigning(t *testing.T) {
+	key, addr := defaultTestKey()
+	chainID := big.NewInt(2)
+	signer := NewEIP2930Signer(chainID)
+
+	to := common.HexToAddress("0x000000000000000000000000000000000000dead")
+	tx, err := SignTx(NewAccessListTransaction(chainID, 1, &to, 0, 0, big.NewInt(10), 50000, big.NewInt(1), nil, testAccessList), signer, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if tx.Type() != AccessListTxType {
+		t.Fatalf("wrong tx type: got %d, want %d", tx.Type(), AccessListTxType)
+	}
+	if !tx.Protected() {
+		t.Fatal("expected access list tx to be protected")
+	}
+	if tx.ChainID().Cmp(chainID) != 0 {
+		t.Fatalf("wrong chain id: got %v, want %v", tx.ChainID(), chainID)
+	}
+	from, err := Sender(signer, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if from != addr {
+		t.Fatalf("wrong sender: got %x, want %x", from, addr)
+	}
+	if _, err := NewEIP155Signer(chainID).Sender(tx); err != ErrTxTypeNotSupported {
+		t.Fatalf("expected %v from legacy signer, got %v", ErrTxTypeNotSupported, err)
+	}
+	if _, err := Sender(NewEIP2930Signer(big.NewInt(3)), tx); err != ErrInvalidChainID {
+		t.Fatalf("expected %v from wrong chain signer, got %v", ErrInvalidChainID, err)
+	}
+}
+
+func TestAccessListTxEncoding(t *testing.T) {
+	key, _ := defaultTestKey()
+	chainID := big.NewInt(2)
+	signer := NewEIP2930Signer(chainID)
+
+	tx, err := SignTx(NewAccessListTransaction(chainID, 3, nil, 0, 1, big.NewInt(0), 90000, big.NewInt(1), []byte{0x60, 0x00}, testAccessList), signer, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// canonical binary encoding
+	enc, err := tx.MarshalBinary()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if enc[0] != AccessListTxType {
+		t.Fatalf("wrong envelope prefix: got %#x", enc[0])
+	}
+	var binTx Transaction
+	if err := binTx.UnmarshalBinary(enc); err != nil {
+		t.Fatal(err)
+	}
+	assertEqualTx(t, tx, &binTx)
+	if tx.Size() != common.StorageSize(len(enc)) {
+		t.Fatalf("wrong size: got %v, want %v", tx.Size(), len(enc))
+	}
+
+	// rlp encoding, as used in block bodies
+	rlpEnc, err := rlp.EncodeToBytes(tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var rlpTx Transaction
+	if err := rlp.DecodeBytes(rlpEnc, &rlpTx); err != nil {
+		t.Fatal(err)
+	}
+	assertEqualTx(t, tx, &rlpTx)
+
+	// json encoding
+	data, err := json.Marshal(tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var jsonTx Transaction
+	if err := json.Unmarshal(data, &jsonTx); err != nil {
+		t.Fatal(err)
+	}
+	assertEqualTx(t, tx, &jsonTx)
+
+	// unknown envelope types are rejected
+	enc[0] = 0x7f
+	if err := new(Transaction).UnmarshalBinary(enc); err != ErrTxTypeNotSupported {
+		t.Fatalf("expected %v, got %v", ErrTxTypeNotSupported, err)
+	}
+}
+
+func TestLegacyTxBinaryEncoding(t *testing.T) {
+	key, _ := defaultTestKey()
+	signer := NewEIP2930Signer(big.NewInt(2))
+
+	tx, err := SignTx(NewTransaction(1, common.Address{}, 0, big.NewInt(1), 21000, big.NewInt(1), nil), signer, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	enc, err := tx.MarshalBinary()
+	if err != nil {
+		t.Fatal(err)
+	}
+	rlpEnc, err := rlp.EncodeToBytes(tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(enc, rlpEnc) {
+		t.Fatal("legacy binary encoding should match the rlp encoding")
+	}
+	var decoded Transaction
+	if err := decoded.UnmarshalBinary(enc); err != nil {
+		t.Fatal(err)
+	}
+	if decoded.Type() != LegacyTxType || decoded.Hash() != tx.Hash() {
+		t.Fatal("legacy tx changed through binary encoding")
+	}
+}
+
+func TestEthAccessListTxConversion(t *testing.T) {
+	key, addr := defaultTestKey()
+	chainID := big.NewInt(1666700000)
+	signer := NewEIP2930Signer(chainID)
+
+	to := common.HexToAddress("0x000000000000000000000000000000000000dead")
+	ethTx, err := SignEthTx(NewEthAccessListTransaction(chainID, 1, &to, big.NewInt(10), 50000, big.NewInt(1), nil, testAccessList), signer, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	enc, err := ethTx.MarshalBinary()
+	if err != nil {
+		t.Fatal(err)
+	}
+	var decoded EthTransaction
+	if err := decoded.UnmarshalBinary(enc); err != nil {
+		t.Fatal(err)
+	}
+	if decoded.Hash() != ethTx.Hash() {
+		t.Fatalf("hash mismatch: got %x, want %x", decoded.Hash(), ethTx.Hash())
+	}
+	hmyTx := decoded.ConvertToHmy()
+	if hmyTx.Type() != AccessListTxType || hmyTx.AccessList().StorageKeys() != testAccessList.StorageKeys() {
+		t.Fatal("typed fields lost in conversion")
+	}
+	if hmyTx.HashByType() != ethTx.Hash() {
+		t.Fatalf("eth hash mismatch: got %x, want %x", hmyTx.HashByType(), ethTx.Hash())
+	}
+	from, err := Sender(signer, hmyTx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if from != addr {
+		t.Fatalf("wrong sender: got %x, want %x", from, addr)
+	}
+}
+
+func assertEqualTx(t *testing.T, want, have *Transaction) {
+	t.Helper()
+	if want.Hash() != have.Hash() {
+		t.Fatalf("hash mismatch: want %x, have %x", want.Hash(), have.Hash())
+	}
+	if want.Type() != have.Type() {
+		t.Fatalf("type mismatch: want %d, have %d", want.Type(), have.Type())
+	}
+	if want.ChainID().Cmp(have.ChainID()) != 0 {
+		t.Fatalf("chain id mismatch: want %v, have %v", want.ChainID(), have.ChainID())
+	}
+	if len(want.AccessList()) != len(have.AccessList()) || want.AccessList().StorageKeys() != have.AccessList().StorageKeys() {
+		t.Fatalf("access list mismatch: want %v, have %v", want.AccessList(), have.AccessList())
+	}
+}
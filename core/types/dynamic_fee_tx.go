@@ -0,0 +1,220 @@
+package types
+
+import (
+	"errors"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/math"
+	"github.com/ethereum/go-ethereum/rlp"
+)
+
+// ErrGasFeeCapTooLow is returned if the transaction fee cap is less than the
+// base fee of the block.
+var ErrGasFeeCapTooLow = errors.New("fee cap less than base fee")
+
+// dynamicFeeTxdata is the consensus encoding of a harmony dynamic fee
+// transaction, carried after the DynamicFeeTxType prefix byte.
+type dynamicFeeTxdata struct {
+	ChainID      *big.Int
+	AccountNonce uint64
+	GasTipCap    *big.Int
+	GasFeeCap    *big.Int
+	GasLimit     uint64
+	ShardID      uint32
+	ToShardID    uint32
+	Recipient    *common.Address `rlp:"nil"` // nil means contract creation
+	Amount       *big.Int
+	Payload      []byte
+	AccessList   AccessList
+
+	// Signature values
+	V *big.Int
+	R *big.Int
+	S *big.Int
+}
+
+// ethDynamicFeeTxdata is the consensus encoding of an ethereum-compatible
+// dynamic fee transaction, as specified by EIP-1559.
+type ethDynamicFeeTxdata struct {
+	ChainID      *big.Int
+	AccountNonce uint64
+	GasTipCap    *big.Int
+	GasFeeCap    *big.Int
+	GasLimit     uint64
+	Recipient    *common.Address `rlp:"nil"` // nil means contract creation
+	Amount       *big.Int
+	Payload      []byte
+	AccessList   AccessList
+
+	// Signature values
+	V *big.Int
+	R *big.Int
+	S *big.Int
+}
+
+// NewDynamicFeeTransaction returns a new dynamic fee transaction. The fee cap
+// is stored as the gas price of the transaction.
+func NewDynamicFeeTransaction(chainID *big.Int, nonce uint64, to *common.Address, shardID uint32, toShardID uint32, amount *big.Int, gasLimit uint64, gasTipCap, gasFeeCap *big.Int, data []byte, accessList AccessList) *Transaction {
+	tx := NewAccessListTransaction(chainID, nonce, to, shardID, toShardID, amount, gasLimit, gasFeeCap, data, accessList)
+	tx.data.Type = DynamicFeeTxType
+	tx.data.GasTipCap = new(big.Int)
+	if gasTipCap != nil {
+		tx.data.GasTipCap.Set(gasTipCap)
+	}
+	return tx
+}
+
+// NewEthDynamicFeeTransaction returns a new ethereum-compatible dynamic fee transaction.
+func NewEthDynamicFeeTransaction(chainID *big.Int, nonce uint64, to *common.Address, amount *big.Int, gasLimit uint64, gasTipCap, gasFeeCap *big.Int, data []byte, accessList AccessList) *EthTransaction {
+	tx := NewEthAccessListTransaction(chainID, nonce, to, amount, gasLimit, gasFeeCap, data, accessList)
+	tx.data.Type = DynamicFeeTxType
+	tx.data.GasTipCap = new(big.Int)
+	if gasTipCap != nil {
+		tx.data.GasTipCap.Set(gasTipCap)
+	}
+	return tx
+}
+
+func (d *txdata) dynamicFeePayload() *dynamicFeeTxdata {
+	return &dynamicFeeTxdata{
+		ChainID:      d.ChainID,
+		AccountNonce: d.AccountNonce,
+		GasTipCap:    d.GasTipCap,
+		GasFeeCap:    d.Price,
+		GasLimit:     d.GasLimit,
+		ShardID:      d.ShardID,
+		ToShardID:    d.ToShardID,
+		Recipient:    d.Recipient,
+		Amount:       d.Amount,
+		Payload:      d.Payload,
+		AccessList:   d.AccessList,
+		V:            d.V,
+		R:            d.R,
+		S:            d.S,
+	}
+}
+
+func (d *ethTxdata) dynamicFeePayload() *ethDynamicFeeTxdata {
+	return &ethDynamicFeeTxdata{
+		ChainID:      d.ChainID,
+		AccountNonce: d.AccountNonce,
+		GasTipCap:    d.GasTipCap,
+		GasFeeCap:    d.Price,
+		GasLimit:     d.GasLimit,
+		Recipient:    d.Recipient,
+		Amount:       d.Amount,
+		Payload:      d.Payload,
+		AccessList:   d.AccessList,
+		V:            d.V,
+		R:            d.R,
+		S:            d.S,
+	}
+}
+
+func decodeDynamicFeeTxdata(b []byte) (txdata, error) {
+	var inner dynamicFeeTxdata
+	if err := rlp.DecodeBytes(b[1:], &inner); err != nil {
+		return txdata{}, err
+	}
+	return txdata{
+		Type:         DynamicFeeTxType,
+		ChainID:      inner.ChainID,
+		AccountNonce: inner.AccountNonce,
+		GasTipCap:    inner.GasTipCap,
+		Price:        inner.GasFeeCap,
+		GasLimit:     inner.GasLimit,
+		ShardID:      inner.ShardID,
+		ToShardID:    inner.ToShardID,
+		Recipient:    inner.Recipient,
+		Amount:       inner.Amount,
+		Payload:      inner.Payload,
+		AccessList:   inner.AccessList,
+		V:            inner.V,
+		R:            inner.R,
+		S:            inner.S,
+	}, nil
+}
+
+func decodeDynamicFeeEthTxdata(b []byte) (ethTxdata, error) {
+	var inner ethDynamicFeeTxdata
+	if err := rlp.DecodeBytes(b[1:], &inner); err != nil {
+		return ethTxdata{}, err
+	}
+	return ethTxdata{
+		Type:         DynamicFeeTxType,
+		ChainID:      inner.ChainID,
+		AccountNonce: inner.AccountNonce,
+		GasTipCap:    inner.GasTipCap,
+		Price:        inner.GasFeeCap,
+		GasLimit:     inner.GasLimit,
+		Recipient:    inner.Recipient,
+		Amount:       inner.Amount,
+		Payload:      inner.Payload,
+		AccessList:   inner.AccessList,
+		V:            inner.V,
+		R:            inner.R,
+		S:            inner.S,
+	}, nil
+}
+
+// gasTipCap returns the tip cap of the payload. Transactions without a
+// separate tip cap pay their whole gas price as tip.
+func gasTipCap(txType uint8, price, tipCap *big.Int) *big.Int {
+	if txType == DynamicFeeTxType {
+		return tipCap
+	}
+	return price
+}
+
+// effectiveGasPrice returns the price paid per unit of gas once included in a
+// block with the given base fee, which is nil before the London fork.
+func effectiveGasPrice(dst *big.Int, txType uint8, price, tipCap, baseFee *big.Int) *big.Int {
+	if txType != DynamicFeeTxType || baseFee == nil {
+		return dst.Set(price)
+	}
+	return dst.Set(math.BigMin(new(big.Int).Add(tipCap, baseFee), price))
+}
+
+// effectiveGasTip returns the tip paid to the block producer per unit of gas.
+func effectiveGasTip(txType uint8, price, tipCap, baseFee *big.Int) (*big.Int, error) {
+	if baseFee == nil {
+		return new(big.Int).Set(gasTipCap(txType, price, tipCap)), nil
+	}
+	var err error
+	if price.Cmp(baseFee) < 0 {
+		err = ErrGasFeeCapTooLow
+	}
+	return math.BigMin(gasTipCap(txType, price, tipCap), new(big.Int).Sub(price, baseFee)), err
+}
+
+// GasTipCap returns the maximum tip per gas the sender is willing to pay.
+// It is the gas price for transactions that predate dynamic fees.
+func (tx *Transaction) GasTipCap() *big.Int {
+	return new(big.Int).Set(gasTipCap(tx.data.Type, tx.data.Price, tx.data.GasTipCap))
+}
+
+// GasFeeCap returns the maximum fee per gas the sender is willing to pay.
+// It is the gas price for transactions that predate dynamic fees.
+func (tx *Transaction) GasFeeCap() *big.Int {
+	return new(big.Int).Set(tx.data.Price)
+}
+
+// EffectiveGasTip returns the tip per gas the transaction pays on top of the
+// given base fee. It returns ErrGasFeeCapTooLow, along with a negative tip,
+// if the fee cap does not cover the base fee.
+func (tx *Transaction) EffectiveGasTip(baseFee *big.Int) (*big.Int, error) {
+	return effectiveGasTip(tx.data.Type, tx.data.Price, tx.data.GasTipCap, baseFee)
+}
+
+// GasTipCap returns the maximum tip per gas the sender is willing to pay.
+// It is the gas price for transactions that predate dynamic fees.
+func (tx *EthTransaction) GasTipCap() *big.Int {
+	return new(big.Int).Set(gasTipCap(tx.data.Type, tx.data.Price, tx.data.GasTipCap))
+}
+
+// GasFeeCap returns the maximum fee per gas the sender is willing to pay.
+// It is the gas price for transactions that predate dynamic fees.
+func (tx *EthTransaction) GasFeeCap() *big.Int {
+	return new(big.Int).Set(tx.data.Price)
+}
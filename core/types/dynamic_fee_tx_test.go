@@ -0,0 +1,160 @@
+package types
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+func TestDynamicFeeTxSigning(t *testing.T) {
+	key, addr := defaultTestKey()
+	chainID := big.NewInt(2)
+	signer := NewLondonSigner(chainID)
+
+	to := common.HexToAddress("0x000000000000000000000000000000000000dead")
+	tx, err := SignTx(NewDynamicFeeTransaction(chainID, 1, &to, 0, 0, big.NewInt(10), 50000, big.NewInt(2), big.NewInt(10), nil, testAccessList), signer, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if tx.Type() != DynamicFeeTxType {
+		t.Fatalf("wrong tx type: got %d, want %d", tx.Type(), DynamicFeeTxType)
+	}
+	from, err := Sender(signer, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if from != addr {
+		t.Fatalf("wrong sender: got %x, want %x", from, addr)
+	}
+	if _, err := NewEIP2930Signer(chainID).Sender(tx); err != ErrTxTypeNotSupported {
+		t.Fatalf("expected %v from access list signer, got %v", ErrTxTypeNotSupported, err)
+	}
+	if _, err := Sender(NewLondonSigner(big.NewInt(3)), tx); err != ErrInvalidChainID {
+		t.Fatalf("expected %v from wrong chain signer, got %v", ErrInvalidChainID, err)
+	}
+}
+
+func TestDynamicFeeTxEncoding(t *testing.T) {
+	key, _ := defaultTestKey()
+	chainID := big.NewInt(2)
+	signer := NewLondonSigner(chainID)
+
+	tx, err := SignTx(NewDynamicFeeTransaction(chainID, 3, nil, 0, 1, big.NewInt(0), 90000, big.NewInt(1), big.NewInt(5), []byte{0x60, 0x00}, testAccessList), signer, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	enc, err := tx.MarshalBinary()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if enc[0] != DynamicFeeTxType {
+		t.Fatalf("wrong envelope prefix: got %#x", enc[0])
+	}
+	var decoded Transaction
+	if err := decoded.UnmarshalBinary(enc); err != nil {
+		t.Fatal(err)
+	}
+	assertEqualTx(t, tx, &decoded)
+	if decoded.GasTipCap().Cmp(tx.GasTipCap()) != 0 || decoded.GasFeeCap().Cmp(tx.GasFeeCap()) != 0 {
+		t.Fatalf("fee caps changed through encoding: got %v/%v, want %v/%v",
+			decoded.GasTipCap(), decoded.GasFeeCap(), tx.GasTipCap(), tx.GasFeeCap())
+	}
+}
+
+func TestEthDynamicFeeTxConversion(t *testing.T) {
+	key, addr := defaultTestKey()
+	chainID := big.NewInt(1666700000)
+	signer := NewLondonSigner(chainID)
+
+	to := common.HexToAddress("0x000000000000000000000000000000000000dead")
+	ethTx, err := SignEthTx(NewEthDynamicFeeTransaction(chainID, 1, &to, big.NewInt(10), 50000, big.NewInt(2), big.NewInt(10), nil, nil), signer, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	hmyTx := ethTx.ConvertToHmy()
+	if hmyTx.Type() != DynamicFeeTxType || hmyTx.GasTipCap().Cmp(big.NewInt(2)) != 0 {
+		t.Fatal("typed fields lost in conversion")
+	}
+	if hmyTx.HashByType() != ethTx.Hash() {
+		t.Fatalf("eth hash mismatch: got %x, want %x", hmyTx.HashByType(), ethTx.Hash())
+	}
+	from, err := Sender(signer, hmyTx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if from != addr {
+		t.Fatalf("wrong sender: got %x, want %x", from, addr)
+	}
+}
+
+func TestEffectiveGasTip(t *testing.T) {
+	to := common.Address{}
+	legacy := NewTransaction(0, to, 0, big.NewInt(0), 21000, big.NewInt(10), nil)
+	dynamic := NewDynamicFeeTransaction(big.NewInt(2), 0, &to, 0, 0, big.NewInt(0), 21000, big.NewInt(3), big.NewInt(10), nil, nil)
+
+	tests := []struct {
+		tx      *Transaction
+		baseFee *big.Int
+		tip     int64
+		err     error
+	}{
+		{legacy, nil, 10, nil},
+		{legacy, big.NewInt(4), 6, nil},
+		{dynamic, nil, 3, nil},
+		{dynamic, big.NewInt(4), 3, nil},
+		{dynamic, big.NewInt(8), 2, nil},
+		{dynamic, big.NewInt(11), -1, ErrGasFeeCapTooLow},
+	}
+	for i, test := range tests {
+		tip, err := test.tx.EffectiveGasTip(test.baseFee)
+		if err != test.err {
+			t.Fatalf("test %d: expected error %v, got %v", i, test.err, err)
+		}
+		if tip.Cmp(big.NewInt(test.tip)) != 0 {
+			t.Fatalf("test %d: wrong tip: got %v, want %v", i, tip, test.tip)
+		}
+	}
+}
+
+// Tests that transactions are selected by the tip they pay on top of the base
+// fee, and that transactions unable to pay the base fee are skipped.
+func TestTransactionTipOrdering(t *testing.T) {
+	chainID := big.NewInt(2)
+	signer := NewLondonSigner(chainID)
+	baseFee := big.NewInt(10)
+
+	tips := []int64{1, 5, 3}
+	groups := map[common.Address]Transactions{}
+	for i, tip := range tips {
+		key, _ := crypto.GenerateKey()
+		tx, _ := SignTx(NewDynamicFeeTransaction(chainID, 0, &common.Address{}, 0, 0, big.NewInt(0), 21000, big.NewInt(tip), big.NewInt(100), nil, nil), signer, key)
+		from, _ := Sender(signer, tx)
+		groups[from] = Transactions{tx}
+		if i == 0 {
+			// a second account whose only transaction cannot pay the base fee
+			key, _ := crypto.GenerateKey()
+			tx, _ := SignTx(NewDynamicFeeTransaction(chainID, 0, &common.Address{}, 0, 0, big.NewInt(0), 21000, big.NewInt(50), big.NewInt(9), nil, nil), signer, key)
+			from, _ := Sender(signer, tx)
+			groups[from] = Transactions{tx}
+		}
+	}
+	txset := NewTransactionsByPriceAndNonce(signer, signer, groups, baseFee)
+
+	var got []int64
+	for tx := txset.Peek(); tx != nil; tx = txset.Peek() {
+		tip, _ := tx.EffectiveGasTip(baseFee)
+		got = append(got, tip.Int64())
+		txset.Shift()
+	}
+	want := []int64{5, 3, 1}
+	if len(got) != len(want) {
+		t.Fatalf("wrong number of transactions: got %v, want %v", got, want)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Fatalf("wrong ordering: got %v, want %v", got, want)
+		}
+	}
+}
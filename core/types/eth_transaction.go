@@ -17,6 +17,7 @@
 package types
 
 import (
+	"errors"
 	"io"
 	"math/big"
 	"sync/atomic"
@@ -64,6 +65,13 @@ type ethTxdata struct {
 
 	// This is only used when marshaling to JSON.
 	Hash *common.Hash `json:"hash" rlp:"-"`
+
+	// Typed transaction fields, carried in the envelope rather than the legacy RLP list.
+	Type       uint8      `json:"type,omitempty"       rlp:"-"`
+	ChainID    *big.Int   `json:"chainId,omitempty"    rlp:"-"`
+	AccessList AccessList `json:"accessList,omitempty" rlp:"-"`
+	// GasTipCap is only set for dynamic fee transactions, whose Price is the fee cap.
+	GasTipCap *big.Int `json:"maxPriorityFeePerGas,omitempty" rlp:"-"`
 }
 
 func (d *ethTxdata) CopyFrom(d2 *ethTxdata) {
@@ -77,6 +85,10 @@ func (d *ethTxdata) CopyFrom(d2 *ethTxdata) {
 	d.R = new(big.Int).Set(d2.R)
 	d.S = new(big.Int).Set(d2.S)
 	d.Hash = copyHash(d2.Hash)
+	d.Type = d2.Type
+	d.ChainID = copyBig(d2.ChainID)
+	d.AccessList = d2.AccessList.Copy()
+	d.GasTipCap = copyBig(d2.GasTipCap)
 }
 
 type ethTxdataMarshaling struct {
@@ -88,6 +100,9 @@ type ethTxdataMarshaling struct {
 	V            *hexutil.Big
 	R            *hexutil.Big
 	S            *hexutil.Big
+	Type         hexutil.Uint64
+	ChainID      *hexutil.Big
+	GasTipCap    *hexutil.Big
 }
 
 // NewEthTransaction returns new ethereum-compatible transaction, which works as a intra-shard transaction
@@ -177,11 +192,28 @@ func (tx *EthTransaction) shardID() uint32 {
 
 // ChainID returns which chain id this transaction was signed for (if at all)
 func (tx *EthTransaction) ChainID() *big.Int {
+	if tx.data.Type != LegacyTxType {
+		return new(big.Int).Set(tx.data.ChainID)
+	}
 	return deriveChainID(tx.data.V)
 }
 
+// Type returns the transaction type.
+func (tx *EthTransaction) Type() uint8 {
+	return tx.data.Type
+}
+
+// AccessList returns the access list of the transaction, nil for legacy transactions.
+func (tx *EthTransaction) AccessList() AccessList {
+	return tx.data.AccessList
+}
+
 // Protected returns whether the transaction is protected from replay protection.
+// Typed transactions always carry their chain id and are therefore protected.
 func (tx *EthTransaction) Protected() bool {
+	if tx.data.Type != LegacyTxType {
+		return true
+	}
 	return isProtectedV(tx.data.V)
 }
 
@@ -208,6 +240,10 @@ func (tx *EthTransaction) ConvertToHmy() *Transaction {
 	d2.V = new(big.Int).Set(d.V)
 	d2.R = new(big.Int).Set(d.R)
 	d2.S = new(big.Int).Set(d.S)
+	d2.Type = d.Type
+	d2.ChainID = copyBig(d.ChainID)
+	d2.AccessList = d.AccessList.Copy()
+	d2.GasTipCap = copyBig(d.GasTipCap)
 
 	d2.ShardID = tx.ShardID()
 	d2.ToShardID = tx.ToShardID()
@@ -221,20 +257,42 @@ func (tx *EthTransaction) ConvertToHmy() *Transaction {
 }
 
 // EncodeRLP implements rlp.Encoder
+// Typed transactions are encoded as an RLP string wrapping the envelope.
 func (tx *EthTransaction) EncodeRLP(w io.Writer) error {
-	return rlp.Encode(w, &tx.data)
+	if tx.data.Type == LegacyTxType {
+		return rlp.Encode(w, &tx.data)
+	}
+	enc, err := encodeTyped(tx.data.Type, tx.data.typedPayload())
+	if err != nil {
+		return err
+	}
+	return rlp.Encode(w, enc)
 }
 
 // DecodeRLP implements rlp.Decoder
 func (tx *EthTransaction) DecodeRLP(s *rlp.Stream) error {
-	_, size, _ := s.Kind()
-	err := s.Decode(&tx.data)
-	if err == nil {
-		tx.size.Store(common.StorageSize(rlp.ListSize(size)))
-		tx.time = time.Now()
+	kind, size, err := s.Kind()
+	if err != nil {
+		return err
 	}
-
-	return err
+	if kind == rlp.List {
+		var data ethTxdata
+		if err := s.Decode(&data); err != nil {
+			return err
+		}
+		tx.setDecoded(data, int(rlp.ListSize(size)))
+		return nil
+	}
+	b, err := s.Bytes()
+	if err != nil {
+		return err
+	}
+	data, err := decodeTypedEthTxdata(b)
+	if err != nil {
+		return err
+	}
+	tx.setDecoded(data, len(b))
+	return nil
 }
 
 // MarshalJSON encodes the web3 RPC transaction format.
@@ -252,10 +310,15 @@ func (tx *EthTransaction) UnmarshalJSON(input []byte) error {
 		return err
 	}
 
+	if dec.Type != LegacyTxType && dec.ChainID == nil {
+		return errors.New("missing required field 'chainId' for typed transaction")
+	}
 	withSignature := dec.V.Sign() != 0 || dec.R.Sign() != 0 || dec.S.Sign() != 0
 	if withSignature {
 		var V byte
-		if isProtectedV(dec.V) {
+		if dec.Type != LegacyTxType {
+			V = byte(dec.V.Uint64())
+		} else if isProtectedV(dec.V) {
 			chainID := deriveChainID(dec.V).Uint64()
 			V = byte(dec.V.Uint64() - 35 - 2*chainID)
 		} else {
@@ -306,7 +369,12 @@ func (tx *EthTransaction) Hash() common.Hash {
 	if hash := tx.hash.Load(); hash != nil {
 		return hash.(common.Hash)
 	}
-	v := hash.FromRLP(tx)
+	var v common.Hash
+	if tx.data.Type == LegacyTxType {
+		v = hash.FromRLP(tx)
+	} else {
+		v = hash.FromTypedRLP(tx.data.Type, tx.data.typedPayload())
+	}
 	tx.hash.Store(v)
 	return v
 }
@@ -318,7 +386,12 @@ func (tx *EthTransaction) Size() common.StorageSize {
 		return size.(common.StorageSize)
 	}
 	c := writeCounter(0)
-	rlp.Encode(&c, &tx.data)
+	if tx.data.Type == LegacyTxType {
+		rlp.Encode(&c, &tx.data)
+	} else {
+		c.Write([]byte{tx.data.Type})
+		rlp.Encode(&c, tx.data.typedPayload())
+	}
 	tx.size.Store(common.StorageSize(c))
 	return common.StorageSize(c)
 }
@@ -337,7 +410,7 @@ func (tx *EthTransaction) SenderAddress() (common.Address, error) {
 	if !tx.Protected() {
 		signer = HomesteadSigner{}
 	} else {
-		signer = NewEIP155Signer(tx.ChainID())
+		signer = NewLondonSigner(tx.ChainID())
 	}
 	addr, err := Sender(signer, tx)
 	if err != nil {
@@ -361,9 +434,12 @@ func (tx *EthTransaction) AsMessage(s Signer) (Message, error) {
 		nonce:      tx.data.AccountNonce,
 		gasLimit:   tx.data.GasLimit,
 		gasPrice:   new(big.Int).Set(tx.data.Price),
+		gasFeeCap:  tx.GasFeeCap(),
+		gasTipCap:  tx.GasTipCap(),
 		to:         tx.data.Recipient,
 		amount:     tx.data.Amount,
 		data:       tx.data.Payload,
+		accessList: tx.data.AccessList,
 		checkNonce: true,
 	}
 
@@ -26,6 +26,10 @@ func (e ethTxdata) MarshalJSON() ([]byte, error) {
 		R            *hexutil.Big    `json:"r" gencodec:"required"`
 		S            *hexutil.Big    `json:"s" gencodec:"required"`
 		Hash         *common.Hash    `json:"hash" rlp:"-"`
+		Type         hexutil.Uint64  `json:"type,omitempty"       rlp:"-"`
+		ChainID      *hexutil.Big    `json:"chainId,omitempty"    rlp:"-"`
+		AccessList   AccessList      `json:"accessList,omitempty" rlp:"-"`
+		GasTipCap    *hexutil.Big    `json:"maxPriorityFeePerGas,omitempty" rlp:"-"`
 	}
 	var enc ethTxdata
 	enc.AccountNonce = hexutil.Uint64(e.AccountNonce)
@@ -38,6 +42,10 @@ func (e ethTxdata) MarshalJSON() ([]byte, error) {
 	enc.R = (*hexutil.Big)(e.R)
 	enc.S = (*hexutil.Big)(e.S)
 	enc.Hash = e.Hash
+	enc.Type = hexutil.Uint64(e.Type)
+	enc.ChainID = (*hexutil.Big)(e.ChainID)
+	enc.AccessList = e.AccessList
+	enc.GasTipCap = (*hexutil.Big)(e.GasTipCap)
 	return json.Marshal(&enc)
 }
 
@@ -54,6 +62,10 @@ func (e *ethTxdata) UnmarshalJSON(input []byte) error {
 		R            *hexutil.Big    `json:"r" gencodec:"required"`
 		S            *hexutil.Big    `json:"s" gencodec:"required"`
 		Hash         *common.Hash    `json:"hash" rlp:"-"`
+		Type         *hexutil.Uint64 `json:"type,omitempty"       rlp:"-"`
+		ChainID      *hexutil.Big    `json:"chainId,omitempty"    rlp:"-"`
+		AccessList   *AccessList     `json:"accessList,omitempty" rlp:"-"`
+		GasTipCap    *hexutil.Big    `json:"maxPriorityFeePerGas,omitempty" rlp:"-"`
 	}
 	var dec ethTxdata
 	if err := json.Unmarshal(input, &dec); err != nil {
@@ -97,5 +109,17 @@ func (e *ethTxdata) UnmarshalJSON(input []byte) error {
 	if dec.Hash != nil {
 		e.Hash = dec.Hash
 	}
+	if dec.Type != nil {
+		e.Type = uint8(*dec.Type)
+	}
+	if dec.ChainID != nil {
+		e.ChainID = (*big.Int)(dec.ChainID)
+	}
+	if dec.AccessList != nil {
+		e.AccessList = *dec.AccessList
+	}
+	if dec.GasTipCap != nil {
+		e.GasTipCap = (*big.Int)(dec.GasTipCap)
+	}
 	return nil
 }
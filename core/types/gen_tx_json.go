@@ -28,6 +28,10 @@ func (t txdata) MarshalJSON() ([]byte, error) {
 		R            *hexutil.Big    `json:"r" gencodec:"required"`
 		S            *hexutil.Big    `json:"s" gencodec:"required"`
 		Hash         *common.Hash    `json:"hash" rlp:"-"`
+		Type         hexutil.Uint64  `json:"type,omitempty"       rlp:"-"`
+		ChainID      *hexutil.Big    `json:"chainId,omitempty"    rlp:"-"`
+		AccessList   AccessList      `json:"accessList,omitempty" rlp:"-"`
+		GasTipCap    *hexutil.Big    `json:"maxPriorityFeePerGas,omitempty" rlp:"-"`
 	}
 	var enc txdata
 	enc.AccountNonce = hexutil.Uint64(t.AccountNonce)
@@ -42,6 +46,10 @@ func (t txdata) MarshalJSON() ([]byte, error) {
 	enc.R = (*hexutil.Big)(t.R)
 	enc.S = (*hexutil.Big)(t.S)
 	enc.Hash = t.Hash
+	enc.Type = hexutil.Uint64(t.Type)
+	enc.ChainID = (*hexutil.Big)(t.ChainID)
+	enc.AccessList = t.AccessList
+	enc.GasTipCap = (*hexutil.Big)(t.GasTipCap)
 	return json.Marshal(&enc)
 }
 
@@ -60,6 +68,10 @@ func (t *txdata) UnmarshalJSON(input []byte) error {
 		R            *hexutil.Big    `json:"r" gencodec:"required"`
 		S            *hexutil.Big    `json:"s" gencodec:"required"`
 		Hash         *common.Hash    `json:"hash" rlp:"-"`
+		Type         *hexutil.Uint64 `json:"type,omitempty"       rlp:"-"`
+		ChainID      *hexutil.Big    `json:"chainId,omitempty"    rlp:"-"`
+		AccessList   *AccessList     `json:"accessList,omitempty" rlp:"-"`
+		GasTipCap    *hexutil.Big    `json:"maxPriorityFeePerGas,omitempty" rlp:"-"`
 	}
 	var dec txdata
 	if err := json.Unmarshal(input, &dec); err != nil {
@@ -111,5 +123,17 @@ func (t *txdata) UnmarshalJSON(input []byte) error {
 	if dec.Hash != nil {
 		t.Hash = dec.Hash
 	}
+	if dec.Type != nil {
+		t.Type = uint8(*dec.Type)
+	}
+	if dec.ChainID != nil {
+		t.ChainID = (*big.Int)(dec.ChainID)
+	}
+	if dec.AccessList != nil {
+		t.AccessList = *dec.AccessList
+	}
+	if dec.GasTipCap != nil {
+		t.GasTipCap = (*big.Int)(dec.GasTipCap)
+	}
 	return nil
 }
@@ -75,6 +75,12 @@ type InternalTransaction interface {
 
 	IsEthCompatible() bool
 	AsMessage(s Signer) (Message, error)
+
+	// Typed transaction values
+	Type() uint8
+	AccessList() AccessList
+	GasTipCap() *big.Int
+	GasFeeCap() *big.Int
 }
 
 // CoreTransaction defines the core funcs of any transactions
@@ -145,6 +151,13 @@ type txdata struct {
 
 	// This is only used when marshaling to JSON.
 	Hash *common.Hash `json:"hash" rlp:"-"`
+
+	// Typed transaction fields, carried in the envelope rather than the legacy RLP list.
+	Type       uint8      `json:"type,omitempty"       rlp:"-"`
+	ChainID    *big.Int   `json:"chainId,omitempty"    rlp:"-"`
+	AccessList AccessList `json:"accessList,omitempty" rlp:"-"`
+	// GasTipCap is only set for dynamic fee transactions, whose Price is the fee cap.
+	GasTipCap *big.Int `json:"maxPriorityFeePerGas,omitempty" rlp:"-"`
 }
 
 func copyAddr(addr *common.Address) *common.Address {
@@ -163,6 +176,13 @@ func copyHash(hash *common.Hash) *common.Hash {
 	return &copy
 }
 
+func copyBig(x *big.Int) *big.Int {
+	if x == nil {
+		return nil
+	}
+	return new(big.Int).Set(x)
+}
+
 func (d *txdata) CopyFrom(d2 *txdata) {
 	d.AccountNonce = d2.AccountNonce
 	d.Price = new(big.Int).Set(d2.Price)
@@ -176,10 +196,14 @@ func (d *txdata) CopyFrom(d2 *txdata) {
 	d.R = new(big.Int).Set(d2.R)
 	d.S = new(big.Int).Set(d2.S)
 	d.Hash = copyHash(d2.Hash)
+	d.Type = d2.Type
+	d.ChainID = copyBig(d2.ChainID)
+	d.AccessList = d2.AccessList.Copy()
+	d.GasTipCap = copyBig(d2.GasTipCap)
 }
 
 func (d *txdata) effectiveGasPrice(dst *big.Int, baseFee *big.Int) *big.Int {
-	return dst.Set(d.Price)
+	return effectiveGasPrice(dst, d.Type, d.Price, d.GasTipCap, baseFee)
 }
 
 type txdataMarshaling struct {
@@ -191,6 +215,9 @@ type txdataMarshaling struct {
 	V            *hexutil.Big
 	R            *hexutil.Big
 	S            *hexutil.Big
+	Type         hexutil.Uint64
+	ChainID      *hexutil.Big
+	GasTipCap    *hexutil.Big
 }
 
 // NewTransaction returns new transaction, this method is to create same shard transaction
@@ -304,9 +331,22 @@ func (tx *Transaction) Data() []byte {
 
 // ChainID returns which chain id this transaction was signed for (if at all)
 func (tx *Transaction) ChainID() *big.Int {
+	if tx.data.Type != LegacyTxType {
+		return new(big.Int).Set(tx.data.ChainID)
+	}
 	return deriveChainID(tx.data.V)
 }
 
+// Type returns the transaction type.
+func (tx *Transaction) Type() uint8 {
+	return tx.data.Type
+}
+
+// AccessList returns the access list of the transaction, nil for legacy transactions.
+func (tx *Transaction) AccessList() AccessList {
+	return tx.data.AccessList
+}
+
 // ShardID returns which shard id this transaction was signed for (if at all)
 func (tx *Transaction) ShardID() uint32 {
 	return tx.data.ShardID
@@ -323,7 +363,11 @@ func (tx *Transaction) Time() time.Time {
 }
 
 // Protected returns whether the transaction is protected from replay protection.
+// Typed transactions always carry their chain id and are therefore protected.
 func (tx *Transaction) Protected() bool {
+	if tx.data.Type != LegacyTxType {
+		return true
+	}
 	return isProtectedV(tx.data.V)
 }
 
@@ -337,20 +381,42 @@ func isProtectedV(V *big.Int) bool {
 }
 
 // EncodeRLP implements rlp.Encoder
+// Typed transactions are encoded as an RLP string wrapping the envelope.
 func (tx *Transaction) EncodeRLP(w io.Writer) error {
-	return rlp.Encode(w, &tx.data)
+	if tx.data.Type == LegacyTxType {
+		return rlp.Encode(w, &tx.data)
+	}
+	enc, err := encodeTyped(tx.data.Type, tx.data.typedPayload())
+	if err != nil {
+		return err
+	}
+	return rlp.Encode(w, enc)
 }
 
 // DecodeRLP implements rlp.Decoder
 func (tx *Transaction) DecodeRLP(s *rlp.Stream) error {
-	_, size, _ := s.Kind()
-	err := s.Decode(&tx.data)
-	if err == nil {
-		tx.size.Store(common.StorageSize(rlp.ListSize(size)))
-		tx.time = time.Now()
+	kind, size, err := s.Kind()
+	if err != nil {
+		return err
 	}
-
-	return err
+	if kind == rlp.List {
+		var data txdata
+		if err := s.Decode(&data); err != nil {
+			return err
+		}
+		tx.setDecoded(data, int(rlp.ListSize(size)))
+		return nil
+	}
+	b, err := s.Bytes()
+	if err != nil {
+		return err
+	}
+	data, err := decodeTypedTxdata(b)
+	if err != nil {
+		return err
+	}
+	tx.setDecoded(data, len(b))
+	return nil
 }
 
 // MarshalJSON encodes the web3 RPC transaction format.
@@ -368,10 +434,15 @@ func (tx *Transaction) UnmarshalJSON(input []byte) error {
 		return err
 	}
 
+	if dec.Type != LegacyTxType && dec.ChainID == nil {
+		return errors.New("missing required field 'chainId' for typed transaction")
+	}
 	withSignature := dec.V.Sign() != 0 || dec.R.Sign() != 0 || dec.S.Sign() != 0
 	if withSignature {
 		var V byte
-		if isProtectedV(dec.V) {
+		if dec.Type != LegacyTxType {
+			V = byte(dec.V.Uint64())
+		} else if isProtectedV(dec.V) {
 			chainID := deriveChainID(dec.V).Uint64()
 			V = byte(dec.V.Uint64() - 35 - 2*chainID)
 		} else {
@@ -412,7 +483,12 @@ func (tx *Transaction) Hash() common.Hash {
 	if hash := tx.hash.Load(); hash != nil {
 		return hash.(common.Hash)
 	}
-	v := hash.FromRLP(tx)
+	var v common.Hash
+	if tx.data.Type == LegacyTxType {
+		v = hash.FromRLP(tx)
+	} else {
+		v = hash.FromTypedRLP(tx.data.Type, tx.data.typedPayload())
+	}
 	tx.hash.Store(v)
 	return v
 }
@@ -433,7 +509,12 @@ func (tx *Transaction) Size() common.StorageSize {
 		return size.(common.StorageSize)
 	}
 	c := writeCounter(0)
-	rlp.Encode(&c, &tx.data)
+	if tx.data.Type == LegacyTxType {
+		rlp.Encode(&c, &tx.data)
+	} else {
+		c.Write([]byte{tx.data.Type})
+		rlp.Encode(&c, tx.data.typedPayload())
+	}
 	tx.size.Store(common.StorageSize(c))
 	return common.StorageSize(c)
 }
@@ -458,6 +539,10 @@ func (tx *Transaction) ConvertToEth() *EthTransaction {
 	d2.V = new(big.Int).Set(d.V)
 	d2.R = new(big.Int).Set(d.R)
 	d2.S = new(big.Int).Set(d.S)
+	d2.Type = d.Type
+	d2.ChainID = copyBig(d.ChainID)
+	d2.AccessList = d.AccessList.Copy()
+	d2.GasTipCap = copyBig(d.GasTipCap)
 
 	copy := tx2.Hash()
 	d2.Hash = &copy
@@ -477,9 +562,12 @@ func (tx *Transaction) AsMessage(s Signer) (Message, error) {
 		nonce:      tx.data.AccountNonce,
 		gasLimit:   tx.data.GasLimit,
 		gasPrice:   new(big.Int).Set(tx.data.Price),
+		gasFeeCap:  tx.GasFeeCap(),
+		gasTipCap:  tx.GasTipCap(),
 		to:         tx.data.Recipient,
 		amount:     tx.data.Amount,
 		data:       tx.data.Payload,
+		accessList: tx.data.AccessList,
 		checkNonce: true,
 	}
 
@@ -527,7 +615,7 @@ func (tx *Transaction) SenderAddress() (common.Address, error) {
 	if !tx.Protected() {
 		signer = HomesteadSigner{}
 	} else {
-		signer = NewEIP155Signer(tx.ChainID())
+		signer = NewLondonSigner(tx.ChainID())
 	}
 	addr, err := Sender(signer, tx)
 	if err != nil {
@@ -600,29 +688,91 @@ func (s *TxByPriceAndTime) Pop() interface{} {
 	return x
 }
 
+// txByTipAndTime implements both the sort and the heap interface, ordering
+// transactions by the tip they pay on top of the base fee, then by the time
+// they were first seen. Before the London fork the base fee is nil and the
+// tip is the whole gas price.
+type txByTipAndTime struct {
+	txs     Transactions
+	tips    []*big.Int
+	baseFee *big.Int
+}
+
+func (s *txByTipAndTime) Len() int { return len(s.txs) }
+func (s *txByTipAndTime) Less(i, j int) bool {
+	// If the tips are equal, use the time the transaction was first seen for
+	// deterministic sorting
+	cmp := s.tips[i].Cmp(s.tips[j])
+	if cmp == 0 {
+		return s.txs[i].time.Before(s.txs[j].time)
+	}
+	return cmp > 0
+}
+func (s *txByTipAndTime) Swap(i, j int) {
+	s.txs[i], s.txs[j] = s.txs[j], s.txs[i]
+	s.tips[i], s.tips[j] = s.tips[j], s.tips[i]
+}
+
+func (s *txByTipAndTime) Push(x interface{}) {
+	tx := x.(*Transaction)
+	tip, _ := tx.EffectiveGasTip(s.baseFee)
+	s.txs = append(s.txs, tx)
+	s.tips = append(s.tips, tip)
+}
+
+func (s *txByTipAndTime) Pop() interface{} {
+	n := len(s.txs)
+	x := s.txs[n-1]
+	s.txs, s.tips = s.txs[:n-1], s.tips[:n-1]
+	return x
+}
+
+// set replaces the transaction at index i, returning false if it cannot pay
+// the base fee.
+func (s *txByTipAndTime) set(i int, tx *Transaction) bool {
+	tip, err := tx.EffectiveGasTip(s.baseFee)
+	if err != nil {
+		return false
+	}
+	s.txs[i], s.tips[i] = tx, tip
+	return true
+}
+
 // TransactionsByPriceAndNonce represents a set of transactions that can return
 // transactions in a profit-maximizing sorted order, while supporting removing
 // entire batches of transactions for non-executable accounts.
 type TransactionsByPriceAndNonce struct {
 	txs       map[common.Address]Transactions // Per account nonce-sorted list of transactions
-	heads     TxByPriceAndTime                // Next transaction for each unique account (price heap)
+	heads     *txByTipAndTime                 // Next transaction for each unique account (tip heap)
 	signer    Signer                          // Signer for the set of transactions
 	ethSigner Signer                          // Signer for the set of transactions
 }
 
 // NewTransactionsByPriceAndNonce creates a transaction set that can retrieve
-// price sorted transactions in a nonce-honouring way.
+// tip sorted transactions in a nonce-honouring way. Accounts whose next
+// transaction cannot pay the given base fee are skipped; baseFee is nil
+// before the London fork, in which case transactions are sorted by price.
 //
 // Note, the input map is reowned so the caller should not interact any more with
 // if after providing it to the constructor.
-func NewTransactionsByPriceAndNonce(hmySigner Signer, ethSigner Signer, txs map[common.Address]Transactions) *TransactionsByPriceAndNonce {
-	// Initialize a price based heap with the head transactions
-	heads := make(TxByPriceAndTime, 0, len(txs))
+func NewTransactionsByPriceAndNonce(hmySigner Signer, ethSigner Signer, txs map[common.Address]Transactions, baseFee *big.Int) *TransactionsByPriceAndNonce {
+	// Initialize a tip based heap with the head transactions
+	heads := &txByTipAndTime{
+		txs:     make(Transactions, 0, len(txs)),
+		tips:    make([]*big.Int, 0, len(txs)),
+		baseFee: baseFee,
+	}
 	for from, accTxs := range txs {
 		if accTxs.Len() == 0 {
 			continue
 		}
-		heads = append(heads, accTxs[0])
+		tip, err := accTxs[0].EffectiveGasTip(baseFee)
+		if err != nil {
+			delete(txs, from)
+			continue
+		}
+		heads.txs = append(heads.txs, accTxs[0])
+		heads.tips = append(heads.tips, tip)
 		// Ensure the sender address is from the signer
 		signer := hmySigner
 		if accTxs[0].IsEthCompatible() {
@@ -634,7 +784,7 @@ func NewTransactionsByPriceAndNonce(hmySigner Signer, ethSigner Signer, txs map[
 			delete(txs, from)
 		}
 	}
-	heap.Init(&heads)
+	heap.Init(heads)
 
 	// Assemble and return the transaction set
 	return &TransactionsByPriceAndNonce{
@@ -645,37 +795,39 @@ func NewTransactionsByPriceAndNonce(hmySigner Signer, ethSigner Signer, txs map[
 	}
 }
 
-// Peek returns the next transaction by price.
+// Peek returns the next transaction by tip.
 func (t *TransactionsByPriceAndNonce) Peek() *Transaction {
-	if len(t.heads) == 0 {
+	if t.heads.Len() == 0 {
 		return nil
 	}
-	return t.heads[0]
+	return t.heads.txs[0]
 }
 
 // Shift replaces the current best head with the next one from the same account.
 func (t *TransactionsByPriceAndNonce) Shift() {
-	if len(t.heads) == 0 {
+	if t.heads.Len() == 0 {
 		return
 	}
 	signer := t.signer
-	if t.heads[0].IsEthCompatible() {
+	if t.heads.txs[0].IsEthCompatible() {
 		signer = t.ethSigner
 	}
-	acc, _ := Sender(signer, t.heads[0])
+	acc, _ := Sender(signer, t.heads.txs[0])
 	if txs, ok := t.txs[acc]; ok && len(txs) > 0 {
-		t.heads[0], t.txs[acc] = txs[0], txs[1:]
-		heap.Fix(&t.heads, 0)
-	} else {
-		heap.Pop(&t.heads)
+		if t.heads.set(0, txs[0]) {
+			t.txs[acc] = txs[1:]
+			heap.Fix(t.heads, 0)
+			return
+		}
 	}
+	heap.Pop(t.heads)
 }
 
 // Pop removes the best transaction, *not* replacing it with the next one from
 // the same account. This should be used when a transaction cannot be executed
 // and hence all subsequent ones should be discarded from the same account.
 func (t *TransactionsByPriceAndNonce) Pop() {
-	heap.Pop(&t.heads)
+	heap.Pop(t.heads)
 }
 
 // Message is a fully derived transaction and implements core.Message
@@ -687,10 +839,13 @@ type Message struct {
 	amount     *big.Int
 	gasLimit   uint64
 	gasPrice   *big.Int
+	gasFeeCap  *big.Int
+	gasTipCap  *big.Int
 	data       []byte
 	checkNonce bool
 	blockNum   *big.Int
 	txType     TransactionType
+	accessList AccessList
 }
 
 // NewMessage returns new message.
@@ -702,6 +857,8 @@ func NewMessage(from common.Address, to *common.Address, nonce uint64, amount *b
 		amount:     amount,
 		gasLimit:   gasLimit,
 		gasPrice:   gasPrice,
+		gasFeeCap:  gasPrice,
+		gasTipCap:  gasPrice,
 		data:       data,
 		checkNonce: checkNonce,
 	}
@@ -715,6 +872,8 @@ func NewStakingMessage(from common.Address, nonce uint64, gasLimit uint64, gasPr
 		nonce:      nonce,
 		gasLimit:   gasLimit,
 		gasPrice:   new(big.Int).Set(gasPrice),
+		gasFeeCap:  new(big.Int).Set(gasPrice),
+		gasTipCap:  new(big.Int).Set(gasPrice),
 		data:       data,
 		checkNonce: true,
 		blockNum:   blockNum,
@@ -736,6 +895,21 @@ func (m Message) GasPrice() *big.Int {
 	return m.gasPrice
 }
 
+// GasFeeCap returns the maximum fee per gas from Message.
+func (m Message) GasFeeCap() *big.Int {
+	return m.gasFeeCap
+}
+
+// GasTipCap returns the maximum tip per gas from Message.
+func (m Message) GasTipCap() *big.Int {
+	return m.gasTipCap
+}
+
+// SetGasFeeCaps sets the maximum fee and tip per gas of the Message.
+func (m *Message) SetGasFeeCaps(gasFeeCap, gasTipCap *big.Int) {
+	m.gasFeeCap, m.gasTipCap = gasFeeCap, gasTipCap
+}
+
 // Value returns the value amount from Message.
 func (m Message) Value() *big.Int {
 	return m.amount
@@ -776,6 +950,16 @@ func (m Message) BlockNum() *big.Int {
 	return m.blockNum
 }
 
+// AccessList returns the access list of the Message.
+func (m Message) AccessList() AccessList {
+	return m.accessList
+}
+
+// SetAccessList sets the access list of the Message.
+func (m *Message) SetAccessList(accessList AccessList) {
+	m.accessList = accessList
+}
+
 // RecentTxsStats is a recent transactions stats map tracking stats like BlockTxsCounts.
 type RecentTxsStats map[uint64]BlockTxsCounts
 
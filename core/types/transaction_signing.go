@@ -46,6 +46,10 @@ type sigCache struct {
 func MakeSigner(config *params.ChainConfig, epochNumber *big.Int) Signer {
 	var signer Signer
 	switch {
+	case config.IsLondon(epochNumber):
+		signer = NewLondonSigner(config.ChainID)
+	case config.IsBerlin(epochNumber):
+		signer = NewEIP2930Signer(config.ChainID)
 	case config.IsEIP155(epochNumber):
 		signer = NewEIP155Signer(config.ChainID)
 	default:
@@ -114,6 +118,172 @@ type Signer interface {
 	Equal(Signer) bool
 }
 
+// LondonSigner implements Signer using the EIP-1559 rules. It accepts
+// dynamic fee transactions as well as all transactions accepted by EIP2930Signer.
+type LondonSigner struct{ EIP2930Signer }
+
+// NewLondonSigner creates a LondonSigner given chainID.
+func NewLondonSigner(chainID *big.Int) LondonSigner {
+	return LondonSigner{NewEIP2930Signer(chainID)}
+}
+
+// Equal checks if the given LondonSigner is equal to another Signer.
+func (s LondonSigner) Equal(s2 Signer) bool {
+	x, ok := s2.(LondonSigner)
+	return ok && x.chainID.Cmp(s.chainID) == 0
+}
+
+// Sender returns the sender address of the given signer.
+func (s LondonSigner) Sender(tx InternalTransaction) (common.Address, error) {
+	if tx.Type() != DynamicFeeTxType {
+		return s.EIP2930Signer.Sender(tx)
+	}
+	ethChainID := nodeconfig.GetDefaultConfig().GetNetworkType().ChainConfig().EthCompatibleChainID
+	if tx.ChainID().Cmp(ethChainID) != 0 && tx.ChainID().Cmp(s.chainID) != 0 {
+		return common.Address{}, ErrInvalidChainID
+	}
+	// Typed transactions carry the bare signature parity in V.
+	V := new(big.Int).Add(tx.V(), big.NewInt(27))
+	return recoverPlain(s.Hash(tx), tx.R(), tx.S(), V, true)
+}
+
+// SignatureValues returns signature values. This signature
+// needs to be in the [R || S || V] format where V is 0 or 1.
+func (s LondonSigner) SignatureValues(tx InternalTransaction, sig []byte) (R, S, V *big.Int, err error) {
+	if tx.Type() != DynamicFeeTxType {
+		return s.EIP2930Signer.SignatureValues(tx, sig)
+	}
+	if tx.ChainID().Sign() != 0 && tx.ChainID().Cmp(s.chainID) != 0 {
+		return nil, nil, nil, ErrInvalidChainID
+	}
+	R, S, _, err = HomesteadSigner{}.SignatureValues(tx, sig)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	V = big.NewInt(int64(sig[64]))
+	return R, S, V, nil
+}
+
+// Hash returns the hash to be signed by the sender.
+// It does not uniquely identify the transaction.
+func (s LondonSigner) Hash(tx InternalTransaction) common.Hash {
+	if tx.Type() != DynamicFeeTxType {
+		return s.EIP2930Signer.Hash(tx)
+	}
+	if params.IsEthCompatible(s.chainID) {
+		return hash.FromTypedRLP(tx.Type(), []interface{}{
+			s.chainID,
+			tx.Nonce(),
+			tx.GasTipCap(),
+			tx.GasFeeCap(),
+			tx.GasLimit(),
+			tx.To(),
+			tx.Value(),
+			tx.Data(),
+			tx.AccessList(),
+		})
+	}
+	return hash.FromTypedRLP(tx.Type(), []interface{}{
+		s.chainID,
+		tx.Nonce(),
+		tx.GasTipCap(),
+		tx.GasFeeCap(),
+		tx.GasLimit(),
+		tx.ShardID(),
+		tx.ToShardID(),
+		tx.To(),
+		tx.Value(),
+		tx.Data(),
+		tx.AccessList(),
+	})
+}
+
+// EIP2930Signer implements Signer using the EIP-2930 rules. It accepts
+// access list transactions as well as EIP-155 legacy transactions.
+type EIP2930Signer struct{ EIP155Signer }
+
+// NewEIP2930Signer creates a EIP2930Signer given chainID.
+func NewEIP2930Signer(chainID *big.Int) EIP2930Signer {
+	return EIP2930Signer{NewEIP155Signer(chainID)}
+}
+
+// Equal checks if the given EIP2930Signer is equal to another Signer.
+func (s EIP2930Signer) Equal(s2 Signer) bool {
+	x, ok := s2.(EIP2930Signer)
+	return ok && x.chainID.Cmp(s.chainID) == 0
+}
+
+// Sender returns the sender address of the given signer.
+func (s EIP2930Signer) Sender(tx InternalTransaction) (common.Address, error) {
+	switch tx.Type() {
+	case LegacyTxType:
+		return s.EIP155Signer.Sender(tx)
+	case AccessListTxType:
+	default:
+		return common.Address{}, ErrTxTypeNotSupported
+	}
+	ethChainID := nodeconfig.GetDefaultConfig().GetNetworkType().ChainConfig().EthCompatibleChainID
+	if tx.ChainID().Cmp(ethChainID) != 0 && tx.ChainID().Cmp(s.chainID) != 0 {
+		return common.Address{}, ErrInvalidChainID
+	}
+	// Typed transactions carry the bare signature parity in V.
+	V := new(big.Int).Add(tx.V(), big.NewInt(27))
+	return recoverPlain(s.Hash(tx), tx.R(), tx.S(), V, true)
+}
+
+// SignatureValues returns signature values. This signature
+// needs to be in the [R || S || V] format where V is 0 or 1.
+func (s EIP2930Signer) SignatureValues(tx InternalTransaction, sig []byte) (R, S, V *big.Int, err error) {
+	switch tx.Type() {
+	case LegacyTxType:
+		return s.EIP155Signer.SignatureValues(tx, sig)
+	case AccessListTxType:
+	default:
+		return nil, nil, nil, ErrTxTypeNotSupported
+	}
+	if tx.ChainID().Sign() != 0 && tx.ChainID().Cmp(s.chainID) != 0 {
+		return nil, nil, nil, ErrInvalidChainID
+	}
+	R, S, _, err = HomesteadSigner{}.SignatureValues(tx, sig)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	V = big.NewInt(int64(sig[64]))
+	return R, S, V, nil
+}
+
+// Hash returns the hash to be signed by the sender.
+// It does not uniquely identify the transaction.
+func (s EIP2930Signer) Hash(tx InternalTransaction) common.Hash {
+	if tx.Type() != AccessListTxType {
+		return s.EIP155Signer.Hash(tx)
+	}
+	if params.IsEthCompatible(s.chainID) {
+		return hash.FromTypedRLP(tx.Type(), []interface{}{
+			s.chainID,
+			tx.Nonce(),
+			tx.GasPrice(),
+			tx.GasLimit(),
+			tx.To(),
+			tx.Value(),
+			tx.Data(),
+			tx.AccessList(),
+		})
+	}
+	return hash.FromTypedRLP(tx.Type(), []interface{}{
+		s.chainID,
+		tx.Nonce(),
+		tx.GasPrice(),
+		tx.GasLimit(),
+		tx.ShardID(),
+		tx.ToShardID(),
+		tx.To(),
+		tx.Value(),
+		tx.Data(),
+		tx.AccessList(),
+	})
+}
+
 // EIP155Signer implements Signer using the EIP155 rules.
 type EIP155Signer struct {
 	chainID, chainIDMul *big.Int
@@ -140,6 +310,9 @@ var big8 = big.NewInt(8)
 
 // Sender returns the sender address of the given signer.
 func (s EIP155Signer) Sender(tx InternalTransaction) (common.Address, error) {
+	if tx.Type() != LegacyTxType {
+		return common.Address{}, ErrTxTypeNotSupported
+	}
 	if !tx.Protected() {
 		return HomesteadSigner{}.Sender(tx)
 	}
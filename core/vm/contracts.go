@@ -46,6 +46,42 @@ type PrecompiledContract interface {
 	Run(input []byte) ([]byte, error) // Run runs the precompiled contract
 }
 
+// ActivePrecompiles returns the addresses of the precompiles enabled with the
+// given rules, including the write capable ones.
+func ActivePrecompiles(rules params.Rules) []common.Address {
+	precompiles := PrecompiledContractsHomestead
+	var writeCapablePrecompiles map[common.Address]WriteCapablePrecompiledContract
+	if rules.IsS3 {
+		precompiles = PrecompiledContractsByzantium
+	}
+	if rules.IsIstanbul {
+		precompiles = PrecompiledContractsIstanbul
+	}
+	if rules.IsVRF {
+		precompiles = PrecompiledContractsVRF
+	}
+	if rules.IsSHA3 {
+		precompiles = PrecompiledContractsSHA3FIPS
+	}
+	if rules.IsStakingPrecompile {
+		precompiles = PrecompiledContractsStaking
+		writeCapablePrecompiles = WriteCapablePrecompiledContractsStaking
+	}
+	if rules.IsCrossShardXferPrecompile {
+		writeCapablePrecompiles = WriteCapablePrecompiledContractsCrossXfer
+	}
+	addresses := make([]common.Address, 0, len(precompiles)+len(writeCapablePrecompiles))
+	for addr, p := range precompiles {
+		if p != nil {
+			addresses = append(addresses, addr)
+		}
+	}
+	for addr := range writeCapablePrecompiles {
+		addresses = append(addresses, addr)
+	}
+	return addresses
+}
+
 // PrecompiledContractsHomestead contains the default set of pre-compiled Ethereum
 // contracts used in the Frontier and Homestead releases.
 var PrecompiledContractsHomestead = map[common.Address]PrecompiledContract{
@@ -0,0 +1,142 @@
+package vm
+
+import (
+	"bytes"
+	"math/big"
+	"strings"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/math"
+	"github.com/harmony-one/harmony/internal/params"
+)
+
+func TestOpMCopy(t *testing.T) {
+	// Test cases from https://eips.ethereum.org/EIPS/eip-5656#test-cases
+	for i, tc := range []struct {
+		dst, src, len string
+		pre           string
+		want          string
+		wantGas       uint64
+	}{
+		{ // MCOPY 0 32 32 - copy 32 bytes from offset 32 to offset 0.
+			dst: "0x0", src: "0x20", len: "0x20",
+			pre:     "0000000000000000000000000000000000000000000000000000000000000000 000102030405060708090a0b0c0d0e0f101112131415161718191a1b1c1d1e1f",
+			want:    "000102030405060708090a0b0c0d0e0f101112131415161718191a1b1c1d1e1f 000102030405060708090a0b0c0d0e0f101112131415161718191a1b1c1d1e1f",
+			wantGas: 6,
+		},
+		{ // MCOPY 0 0 32 - copy 32 bytes from offset 0 to offset 0.
+			dst: "0x0", src: "0x0", len: "0x20",
+			pre:     "0101010101010101010101010101010101010101010101010101010101010101",
+			want:    "0101010101010101010101010101010101010101010101010101010101010101",
+			wantGas: 6,
+		},
+		{ // MCOPY 0 1 8 - copy 8 bytes from offset 1 to offset 0 (overlapping).
+			dst: "0x0", src: "0x1", len: "0x8",
+			pre:     "000102030405060708 000000000000000000000000000000000000000000000000",
+			want:    "010203040506070808 000000000000000000000000000000000000000000000000",
+			wantGas: 6,
+		},
+		{ // MCOPY 1 0 8 - copy 8 bytes from offset 0 to offset 1 (overlapping).
+			dst: "0x1", src: "0x0", len: "0x8",
+			pre:     "000102030405060708 000000000000000000000000000000000000000000000000",
+			want:    "000001020304050607 000000000000000000000000000000000000000000000000",
+			wantGas: 6,
+		},
+		{ // MCOPY 0xFFFFFFFFFFFF 0xFFFFFFFFFFFF 0 - copy zero bytes from out-of-bounds index(overlapping).
+			dst: "0xFFFFFFFFFFFF", src: "0xFFFFFFFFFFFF", len: "0x0",
+			pre:     "11",
+			want:    "11",
+			wantGas: 3,
+		},
+		{ // MCOPY 0xFFFFFFFFFFFF 0 0 - copy zero bytes from start of mem to out-of-bounds.
+			dst: "0xFFFFFFFFFFFF", src: "0x0", len: "0x0",
+			pre:     "11",
+			want:    "11",
+			wantGas: 3,
+		},
+		{ // MCOPY 0 0xFFFFFFFFFFFF 0 - copy zero bytes from out-of-bounds to start of mem
+			dst: "0x0", src: "0xFFFFFFFFFFFF", len: "0x0",
+			pre:     "11",
+			want:    "11",
+			wantGas: 3,
+		},
+		{ // MCOPY 0x10 0x20 2 - copy 2 bytes from offset 32 to offset 16.
+			dst: "0x10", src: "0x20", len: "0x2",
+			pre:     "0000000000000000000000000000000000000000000000000000000000000000 000102030405060708090a0b0c0d0e0f101112131415161718191a1b1c1d1e1f",
+			want:    "0000000000000000000000000000000000010000000000000000000000000000 000102030405060708090a0b0c0d0e0f101112131415161718191a1b1c1d1e1f",
+			wantGas: 6,
+		},
+	} {
+		var (
+			env            = NewEVM(Context{}, nil, params.TestChainConfig, Config{})
+			stack          = newstack()
+			pc             = uint64(0)
+			evmInterpreter = env.interpreter.(*EVMInterpreter)
+		)
+		evmInterpreter.intPool = poolOfIntPools.get()
+		data := common.FromHex(strings.ReplaceAll(tc.pre, " ", ""))
+		// Set pre
+		mem := NewMemory()
+		mem.Resize(uint64(len(data)))
+		mem.Set(0, uint64(len(data)), data)
+		// Push stack args
+		length, _ := new(big.Int).SetString(tc.len[2:], 16)
+		src, _ := new(big.Int).SetString(tc.src[2:], 16)
+		dst, _ := new(big.Int).SetString(tc.dst[2:], 16)
+		stack.pushN(length, src, dst)
+
+		memorySize, overflow := memoryMcopy(stack)
+		if overflow {
+			t.Fatalf("test %d: unexpected memory size overflow", i)
+		}
+		// The interpreter expands memory to word size
+		if memorySize, overflow = math.SafeMul(toWordSize(memorySize), 32); overflow {
+			t.Fatalf("test %d: unexpected memory size overflow", i)
+		}
+		haveGas := GasFastestStep
+		if dynamicCost, err := gasMcopy(env, nil, stack, mem, memorySize); err != nil {
+			t.Fatalf("test %d: %v", i, err)
+		} else {
+			haveGas += dynamicCost
+		}
+		if memorySize > 0 {
+			mem.Resize(memorySize)
+		}
+		// Do the copy
+		opMcopy(&pc, evmInterpreter, nil, mem, stack)
+		want := common.FromHex(strings.ReplaceAll(tc.want, " ", ""))
+		if have := mem.store; !bytes.Equal(want, have) {
+			t.Errorf("test %d: want %x, have %x", i, want, have)
+		}
+		if haveGas != tc.wantGas {
+			t.Errorf("test %d: gas want %d, have %d", i, tc.wantGas, haveGas)
+		}
+		poolOfIntPools.put(evmInterpreter.intPool)
+	}
+}
+
+func TestOpPush0(t *testing.T) {
+	var (
+		env            = NewEVM(Context{}, nil, params.TestChainConfig, Config{})
+		stack          = newstack()
+		pc             = uint64(0)
+		evmInterpreter = env.interpreter.(*EVMInterpreter)
+	)
+	// Stuff a nonzero bigint into the pool, to ensure that PUSH0 does not
+	// rely on pooled integers to be zero
+	evmInterpreter.intPool = poolOfIntPools.get()
+	evmInterpreter.intPool.put(big.NewInt(-1337))
+
+	opPush0(&pc, evmInterpreter, nil, nil, stack)
+	if have := stack.pop(); have.Sign() != 0 {
+		t.Fatalf("PUSH0 pushed %v", have)
+	}
+	if jt := newShanghaiInstructionSet(); jt[PUSH0].constantGas != GasQuickStep {
+		t.Fatalf("wrong PUSH0 gas: have %d, want %d", jt[PUSH0].constantGas, GasQuickStep)
+	}
+	if jt := newLondonInstructionSet(); jt[PUSH0].valid {
+		t.Fatal("PUSH0 enabled before Shanghai")
+	}
+	poolOfIntPools.put(evmInterpreter.intPool)
+}
@@ -20,6 +20,7 @@ import (
 	"math"
 	"math/big"
 
+	"github.com/harmony-one/harmony/core/types"
 	"github.com/harmony-one/harmony/internal/params"
 )
 
@@ -93,3 +94,31 @@ func IntrinsicGas(data []byte, contractCreation, homestead, istanbul, isValidato
 	}
 	return gas, nil
 }
+
+// AccessListGas computes the intrinsic gas charged for the addresses and
+// storage keys of an EIP-2930 access list.
+func AccessListGas(accessList types.AccessList) (uint64, error) {
+	var gas uint64
+	addresses := uint64(len(accessList))
+	if (math.MaxUint64-gas)/params.TxAccessListAddressGas < addresses {
+		return 0, ErrOutOfGas
+	}
+	gas += addresses * params.TxAccessListAddressGas
+
+	keys := uint64(accessList.StorageKeys())
+	if (math.MaxUint64-gas)/params.TxAccessListStorageKeyGas < keys {
+		return 0, ErrOutOfGas
+	}
+	gas += keys * params.TxAccessListStorageKeyGas
+	return gas, nil
+}
+
+// InitCodeGas computes the intrinsic gas charged for each word of the init
+// code of a contract creation transaction, as specified by EIP-3860.
+func InitCodeGas(data []byte) (uint64, error) {
+	lenWords := toWordSize(uint64(len(data)))
+	if (math.MaxUint64)/params.InitCodeWordGas < lenWords {
+		return 0, ErrOutOfGas
+	}
+	return lenWords * params.InitCodeWordGas, nil
+}
@@ -91,6 +91,7 @@ var (
 	gasCodeCopy       = memoryCopierGas(2)
 	gasExtCodeCopy    = memoryCopierGas(3)
 	gasReturnDataCopy = memoryCopierGas(2)
+	gasMcopy          = memoryCopierGas(2)
 )
 
 func gasSStore(evm *EVM, contract *Contract, stack *Stack, mem *Memory, memorySize uint64) (uint64, error) {
@@ -281,6 +282,44 @@ var (
 	gasCreate  = pureMemoryGascost
 )
 
+// gasCreateEip3860 charges the memory expansion and the EIP-3860 initcode
+// word cost of CREATE.
+func gasCreateEip3860(evm *EVM, contract *Contract, stack *Stack, mem *Memory, memorySize uint64) (uint64, error) {
+	gas, err := memoryGasCost(mem, memorySize)
+	if err != nil {
+		return 0, err
+	}
+	size, overflow := bigUint64(stack.Back(2))
+	if overflow || size > params.MaxInitCodeSize {
+		return 0, errGasUintOverflow
+	}
+	// Since size <= params.MaxInitCodeSize, these multiplication cannot overflow
+	moreGas := params.InitCodeWordGas * ((size + 31) / 32)
+	if gas, overflow = math.SafeAdd(gas, moreGas); overflow {
+		return 0, errGasUintOverflow
+	}
+	return gas, nil
+}
+
+// gasCreate2Eip3860 charges the memory expansion, the hashing cost and the
+// EIP-3860 initcode word cost of CREATE2.
+func gasCreate2Eip3860(evm *EVM, contract *Contract, stack *Stack, mem *Memory, memorySize uint64) (uint64, error) {
+	gas, err := memoryGasCost(mem, memorySize)
+	if err != nil {
+		return 0, err
+	}
+	size, overflow := bigUint64(stack.Back(2))
+	if overflow || size > params.MaxInitCodeSize {
+		return 0, errGasUintOverflow
+	}
+	// Since size <= params.MaxInitCodeSize, these multiplication cannot overflow
+	moreGas := (params.InitCodeWordGas + params.Sha3WordGas) * ((size + 31) / 32)
+	if gas, overflow = math.SafeAdd(gas, moreGas); overflow {
+		return 0, errGasUintOverflow
+	}
+	return gas, nil
+}
+
 func gasCreate2(evm *EVM, contract *Contract, stack *Stack, mem *Memory, memorySize uint64) (uint64, error) {
 	gas, err := memoryGasCost(mem, memorySize)
 	if err != nil {
@@ -59,8 +59,27 @@ type StateDB interface {
 	GetState(common.Address, common.Hash) common.Hash
 	SetState(common.Address, common.Hash, common.Hash)
 
+	PrepareAccessList(sender common.Address, dst *common.Address, precompiles []common.Address, list types.AccessList)
+	AddressInAccessList(addr common.Address) bool
+	SlotInAccessList(addr common.Address, slot common.Hash) (addressOk bool, slotOk bool)
+	// AddAddressToAccessList adds the given address to the access list. This operation is safe to perform
+	// even if the feature/fork is not active yet
+	AddAddressToAccessList(addr common.Address)
+	// AddSlotToAccessList adds the given (address,slot) to the access list. This operation is safe to perform
+	// even if the feature/fork is not active yet
+	AddSlotToAccessList(addr common.Address, slot common.Hash)
+
 	Suicide(common.Address) bool
 	HasSuicided(common.Address) bool
+	// Suicide6780 suicides the account only if it was created in the
+	// current transaction, as specified by EIP-6780
+	Suicide6780(common.Address)
+
+	GetTransientState(addr common.Address, key common.Hash) common.Hash
+	SetTransientState(addr common.Address, key, value common.Hash)
+	// ClearTransientStorage discards the transient storage left over by the
+	// previous transaction
+	ClearTransientStorage()
 
 	// Exist reports whether the given account exists in state.
 	// Notably this should also return true for suicided accounts.
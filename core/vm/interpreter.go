@@ -104,6 +104,14 @@ func NewEVMInterpreter(evm *EVM, cfg Config) *EVMInterpreter {
 	if !cfg.JumpTable[STOP].valid {
 		var jt JumpTable
 		switch {
+		case evm.chainRules.IsCancun:
+			jt = cancunInstructionSet
+		case evm.chainRules.IsShanghai:
+			jt = shanghaiInstructionSet
+		case evm.chainRules.IsLondon:
+			jt = londonInstructionSet
+		case evm.chainRules.IsBerlin:
+			jt = berlinInstructionSet
 		case evm.chainRules.IsIstanbul:
 			jt = istanbulInstructionSet
 		case evm.chainRules.IsS3:
@@ -111,6 +119,14 @@ func NewEVMInterpreter(evm *EVM, cfg Config) *EVMInterpreter {
 		default:
 			jt = frontierInstructionSet
 		}
+		// The initcode limit and the selfdestruct restriction have their
+		// own epochs, so they are applied on top of the instruction set.
+		if evm.chainRules.IsEIP3860 {
+			enable3860(&jt)
+		}
+		if evm.chainRules.IsEIP6780 {
+			enable6780(&jt)
+		}
 		for i, eip := range cfg.ExtraEips {
 			if err := EnableEIP(eip, &jt); err != nil {
 				// Disable it, so caller can check if it's activated or not
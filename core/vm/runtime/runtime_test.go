@@ -17,6 +17,7 @@
 package runtime
 
 import (
+	"bytes"
 	"math/big"
 	"strings"
 	"testing"
@@ -200,3 +201,216 @@ func BenchmarkEVM_CREATE2_1200(bench *testing.B) {
 	// initcode size 1200K, repeatedly calls CREATE2 and then modifies the mem contents
 	benchmarkEVMCreate(bench, "5b5862124f80600080f5600152600056")
 }
+
+// cancunConfig returns a chain config with all the post-Istanbul EVM changes
+// active from the genesis epoch.
+func cancunConfig() *params.ChainConfig {
+	config := *params.TestChainConfig
+	config.BerlinEpoch = big.NewInt(0)
+	config.LondonEpoch = big.NewInt(0)
+	config.ShanghaiEpoch = big.NewInt(0)
+	config.CancunEpoch = big.NewInt(0)
+	config.EIP3860Epoch = big.NewInt(0)
+	config.EIP6780Epoch = big.NewInt(0)
+	return &config
+}
+
+func newTestState() *state.DB {
+	statedb, _ := state.New(common.Hash{}, state.NewDatabase(rawdb.NewMemoryDatabase()), nil)
+	return statedb
+}
+
+func TestEIP3855Push0(t *testing.T) {
+	address := common.HexToAddress("0x0a")
+	// Test cases from https://eips.ethereum.org/EIPS/eip-3855#test-cases
+	for i, tc := range []struct {
+		config  *params.ChainConfig
+		code    []byte
+		gasUsed uint64
+		fail    bool
+	}{
+		{cancunConfig(), []byte{byte(vm.PUSH0)}, 2, false},
+		{cancunConfig(), bytes.Repeat([]byte{byte(vm.PUSH0)}, 1024), 2048, false},
+		{cancunConfig(), bytes.Repeat([]byte{byte(vm.PUSH0)}, 1025), 0, true},
+		{params.TestChainConfig, []byte{byte(vm.PUSH0)}, 0, true},
+	} {
+		statedb := newTestState()
+		statedb.SetCode(address, tc.code, false)
+		_, leftOverGas, err := Call(address, nil, &Config{State: statedb, ChainConfig: tc.config, GasLimit: 10000})
+		if tc.fail {
+			if err == nil {
+				t.Errorf("test %d: expected failure", i)
+			}
+			continue
+		}
+		if err != nil {
+			t.Fatalf("test %d: unexpected error: %v", i, err)
+		}
+		if gasUsed := 10000 - leftOverGas; gasUsed != tc.gasUsed {
+			t.Errorf("test %d: gas used %d, want %d", i, gasUsed, tc.gasUsed)
+		}
+	}
+}
+
+func TestEIP1153TransientStorage(t *testing.T) {
+	var (
+		config  = cancunConfig()
+		statedb = newTestState()
+		writer  = common.HexToAddress("0x0a")
+		reader  = common.HexToAddress("0x0b")
+		static  = common.HexToAddress("0x0c")
+	)
+	// tstore(1, 42), then return tload(1)
+	statedb.SetCode(writer, []byte{
+		byte(vm.PUSH1), 42,
+		byte(vm.PUSH1), 1,
+		byte(vm.TSTORE),
+		byte(vm.PUSH1), 1,
+		byte(vm.TLOAD),
+		byte(vm.PUSH0),
+		byte(vm.MSTORE),
+		byte(vm.PUSH1), 32,
+		byte(vm.PUSH0),
+		byte(vm.RETURN),
+	}, false)
+	// return tload(1)
+	statedb.SetCode(reader, []byte{
+		byte(vm.PUSH1), 1,
+		byte(vm.TLOAD),
+		byte(vm.PUSH0),
+		byte(vm.MSTORE),
+		byte(vm.PUSH1), 32,
+		byte(vm.PUSH0),
+		byte(vm.RETURN),
+	}, false)
+	// return staticcall(gas, writer, 0, 0, 0, 0)
+	statedb.SetCode(static, []byte{
+		byte(vm.PUSH0),
+		byte(vm.PUSH0),
+		byte(vm.PUSH0),
+		byte(vm.PUSH0),
+		byte(vm.PUSH1), 0x0a,
+		byte(vm.GAS),
+		byte(vm.STATICCALL),
+		byte(vm.PUSH0),
+		byte(vm.MSTORE),
+		byte(vm.PUSH1), 32,
+		byte(vm.PUSH0),
+		byte(vm.RETURN),
+	}, false)
+
+	ret, _, err := Call(writer, nil, &Config{State: statedb, ChainConfig: config})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if have := new(big.Int).SetBytes(ret); have.Cmp(big.NewInt(42)) != 0 {
+		t.Fatalf("tload after tstore returned %v, want 42", have)
+	}
+	if have := statedb.GetState(writer, common.BigToHash(big.NewInt(1))); have != (common.Hash{}) {
+		t.Fatalf("tstore leaked into persistent storage: %x", have)
+	}
+	// Transient storage is only visible to the account that wrote it
+	ret, _, err = Call(reader, nil, &Config{State: statedb, ChainConfig: config})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if have := new(big.Int).SetBytes(ret); have.Sign() != 0 {
+		t.Fatalf("tload of another account returned %v, want 0", have)
+	}
+	// TSTORE is a state modifying operation, not allowed in a static context
+	ret, _, err = Call(static, nil, &Config{State: statedb, ChainConfig: config})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if have := new(big.Int).SetBytes(ret); have.Sign() != 0 {
+		t.Fatal("tstore succeeded in a static call")
+	}
+	// Transient storage is discarded at the end of the transaction
+	statedb.ClearTransientStorage()
+	if have := statedb.GetTransientState(writer, common.BigToHash(big.NewInt(1))); have != (common.Hash{}) {
+		t.Fatalf("transient storage survived the transaction: %x", have)
+	}
+}
+
+func TestEIP3860InitCodeLimit(t *testing.T) {
+	// create(0, 0, size)
+	createCode := func(size uint16) []byte {
+		return []byte{
+			byte(vm.PUSH2), byte(size >> 8), byte(size),
+			byte(vm.PUSH0),
+			byte(vm.PUSH0),
+			byte(vm.CREATE),
+			byte(vm.STOP),
+		}
+	}
+	preConfig := cancunConfig()
+	preConfig.EIP3860Epoch = params.EpochTBD
+	address := common.HexToAddress("0x0a")
+	run := func(config *params.ChainConfig, size uint16) (uint64, error) {
+		statedb := newTestState()
+		statedb.SetCode(address, createCode(size), false)
+		_, leftOverGas, err := Call(address, nil, &Config{State: statedb, ChainConfig: config, GasLimit: 10000000})
+		return leftOverGas, err
+	}
+
+	limit := uint16(params.MaxInitCodeSize)
+	if _, err := run(cancunConfig(), limit+1); err == nil {
+		t.Fatal("expected initcode above the limit to fail")
+	}
+	if _, err := run(preConfig, limit+1); err != nil {
+		t.Fatalf("initcode above the limit failed before EIP-3860: %v", err)
+	}
+	leftOver, err := run(cancunConfig(), limit)
+	if err != nil {
+		t.Fatal(err)
+	}
+	preLeftOver, err := run(preConfig, limit)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// initcode is charged per word
+	if want := params.InitCodeWordGas * uint64(limit) / 32; preLeftOver-leftOver != want {
+		t.Fatalf("wrong initcode gas: have %d, want %d", preLeftOver-leftOver, want)
+	}
+}
+
+func TestEIP6780Selfdestruct(t *testing.T) {
+	var (
+		address     = common.HexToAddress("0x0a")
+		beneficiary = common.HexToAddress("0x0b")
+		// selfdestruct(beneficiary)
+		code = []byte{byte(vm.PUSH1), 0x0b, byte(vm.SELFDESTRUCT)}
+	)
+	preConfig := cancunConfig()
+	preConfig.EIP6780Epoch = params.EpochTBD
+
+	for i, tc := range []struct {
+		config *params.ChainConfig
+		// whether the contract was deployed in an earlier transaction
+		preexisting bool
+		destructed  bool
+	}{
+		{cancunConfig(), true, false},
+		{cancunConfig(), false, true},
+		{preConfig, true, true},
+	} {
+		statedb := newTestState()
+		statedb.SetCode(address, code, false)
+		statedb.AddBalance(address, big.NewInt(100))
+		if tc.preexisting {
+			statedb.Finalise(true)
+		}
+		if _, _, err := Call(address, nil, &Config{State: statedb, ChainConfig: tc.config}); err != nil {
+			t.Fatalf("test %d: %v", i, err)
+		}
+		if have := statedb.HasSuicided(address); have != tc.destructed {
+			t.Errorf("test %d: destructed %v, want %v", i, have, tc.destructed)
+		}
+		if have := statedb.GetBalance(beneficiary); have.Cmp(big.NewInt(100)) != 0 {
+			t.Errorf("test %d: beneficiary balance %v, want 100", i, have)
+		}
+		if have := statedb.GetBalance(address); have.Sign() != 0 {
+			t.Errorf("test %d: contract balance %v, want 0", i, have)
+		}
+	}
+}
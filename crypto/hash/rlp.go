@@ -46,3 +46,18 @@ func FromRLPNew256(x interface{}) (h common.Hash) {
 	hw.Sum(h[:0])
 	return h
 }
+
+// FromTypedRLP hashes the RLP representation of the given object prefixed
+// with the given type byte, as used by typed transaction envelopes.
+func FromTypedRLP(prefix byte, x interface{}) (h common.Hash) {
+	hw := kec256Pool.Get().(hash.Hash)
+	defer func() {
+		hw.Reset()
+		kec256Pool.Put(hw)
+	}()
+
+	hw.Write([]byte{prefix})
+	rlp.Encode(hw, x)
+	hw.Sum(h[:0])
+	return h
+}
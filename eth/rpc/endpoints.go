@@ -18,12 +18,23 @@ package rpc
 
 import (
 	"net"
+	"net/http"
 
 	"github.com/ethereum/go-ethereum/log"
 )
 
 // StartHTTPEndpoint starts the HTTP RPC endpoint, configured with cors/vhosts/modules
 func StartHTTPEndpoint(endpoint string, apis []API, modules []string, rmf *RpcMethodFilter, cors []string, vhosts []string, timeouts HTTPTimeouts) (net.Listener, *Server, error) {
+	return startHTTPEndpoint(endpoint, apis, modules, rmf, cors, vhosts, timeouts, "")
+}
+
+// StartAuthHTTPEndpoint starts the HTTP RPC endpoint like StartHTTPEndpoint, requiring
+// the bearer token in the Authorization header of every request if the token is set
+func StartAuthHTTPEndpoint(endpoint string, apis []API, modules []string, rmf *RpcMethodFilter, cors []string, vhosts []string, timeouts HTTPTimeouts, token string) (net.Listener, *Server, error) {
+	return startHTTPEndpoint(endpoint, apis, modules, rmf, cors, vhosts, timeouts, token)
+}
+
+func startHTTPEndpoint(endpoint string, apis []API, modules []string, rmf *RpcMethodFilter, cors []string, vhosts []string, timeouts HTTPTimeouts, token string) (net.Listener, *Server, error) {
 	// Generate the whitelist based on the allowed modules
 	whitelist := make(map[string]bool)
 	for _, module := range modules {
@@ -47,7 +58,11 @@ func StartHTTPEndpoint(endpoint string, apis []API, modules []string, rmf *RpcMe
 	if listener, err = net.Listen("tcp", endpoint); err != nil {
 		return nil, nil, err
 	}
-	go NewHTTPServer(cors, vhosts, timeouts, handler).Serve(listener)
+	var srv http.Handler = handler
+	if token != "" {
+		srv = newTokenAuthHandler(token, handler)
+	}
+	go NewHTTPServer(cors, vhosts, timeouts, srv).Serve(listener)
 	return listener, handler, err
 }
 
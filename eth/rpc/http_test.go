@@ -52,3 +52,27 @@ func testHTTPErrorResponse(t *testing.T, method, contentType, body string, expec
 		t.Fatalf("response code should be %d not %d", expected, code)
 	}
 }
+
+func TestHTTPTokenAuth(t *testing.T) {
+	handler := newTokenAuthHandler("secret", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	tests := []struct {
+		auth     string
+		expected int
+	}{
+		{"", http.StatusUnauthorized},
+		{"secret", http.StatusUnauthorized},
+		{"Bearer wrong", http.StatusUnauthorized},
+		{"Bearer secret", http.StatusOK},
+	}
+	for i, test := range tests {
+		request := httptest.NewRequest(http.MethodPost, "http://url.com", strings.NewReader(""))
+		if test.auth != "" {
+			request.Header.Set("Authorization", test.auth)
+		}
+		recorder := httptest.NewRecorder()
+		handler.ServeHTTP(recorder, request)
+		if recorder.Code != test.expected {
+			t.Errorf("test %d: response code should be %d not %d", i, test.expected, recorder.Code)
+		}
+	}
+}
@@ -298,6 +298,17 @@ func (sp *testSyncProtocol) NumStreams() int {
 	return len(sp.streamIDs)
 }
 
+func (sp *testSyncProtocol) StreamScores() map[sttypes.StreamID]streammanager.StreamScore {
+	sp.lock.Lock()
+	defer sp.lock.Unlock()
+
+	res := make(map[sttypes.StreamID]streammanager.StreamScore)
+	for _, stid := range sp.streamIDs {
+		res[stid] = streammanager.StreamScore{Score: streammanager.MaxScore}
+	}
+	return res
+}
+
 func (sp *testSyncProtocol) SubscribeAddStreamEvent(ch chan<- streammanager.EvtStreamAdded) event.Subscription {
 	var evtFeed event.Feed
 	go func() {
@@ -18,6 +18,7 @@ import (
 	"github.com/harmony-one/harmony/p2p"
 	"github.com/harmony-one/harmony/p2p/stream/common/streammanager"
 	"github.com/harmony-one/harmony/p2p/stream/protocols/sync"
+	sttypes "github.com/harmony-one/harmony/p2p/stream/types"
 )
 
 type (
@@ -120,6 +121,11 @@ func (d *Downloader) NumPeers() int {
 	return d.syncProtocol.NumStreams()
 }
 
+// PeerScores returns the scores of the streams of a specific shard.
+func (d *Downloader) PeerScores() map[sttypes.StreamID]streammanager.StreamScore {
+	return d.syncProtocol.StreamScores()
+}
+
 // IsSyncing return the current sync status
 func (d *Downloader) SyncStatus() (bool, uint64, uint64) {
 	current := d.bc.CurrentBlock().NumberU64()
@@ -0,0 +1,151 @@
+package hmy
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"sort"
+
+	"github.com/harmony-one/harmony/core"
+	"github.com/harmony-one/harmony/core/types"
+	"github.com/harmony-one/harmony/eth/rpc"
+)
+
+const (
+	// maxFeeHistory is the maximum number of blocks that can be retrieved for a
+	// fee history request.
+	maxFeeHistory = 1024
+	// maxRewardPercentiles is the maximum number of reward percentiles that can
+	// be requested for each block.
+	maxRewardPercentiles = 100
+)
+
+var (
+	errInvalidPercentile = errors.New("invalid reward percentile")
+	errRequestBeyondHead = errors.New("request beyond head block")
+)
+
+type txGasAndReward struct {
+	gasUsed uint64
+	reward  *big.Int
+}
+
+type sortGasAndReward []txGasAndReward
+
+func (s sortGasAndReward) Len() int           { return len(s) }
+func (s sortGasAndReward) Swap(i, j int)      { s[i], s[j] = s[j], s[i] }
+func (s sortGasAndReward) Less(i, j int) bool { return s[i].reward.Cmp(s[j].reward) < 0 }
+
+// FeeHistory returns the base fee, gas used ratio and the effective tips at
+// the requested percentiles of the given range of blocks, ending with
+// lastBlock. The base fee slice has one more entry than the number of blocks,
+// holding the base fee of the block after the range. Base fees are zero for
+// blocks before the London fork.
+func (gpo *Oracle) FeeHistory(
+	ctx context.Context, blocks int, lastBlock rpc.BlockNumber, rewardPercentiles []float64,
+) (*big.Int, [][]*big.Int, []*big.Int, []float64, error) {
+	if blocks < 1 {
+		return new(big.Int), nil, nil, nil, nil
+	}
+	if blocks > maxFeeHistory {
+		blocks = maxFeeHistory
+	}
+	if len(rewardPercentiles) > maxRewardPercentiles {
+		return nil, nil, nil, nil, errInvalidPercentile
+	}
+	for i, p := range rewardPercentiles {
+		if p < 0 || p > 100 || (i > 0 && p < rewardPercentiles[i-1]) {
+			return nil, nil, nil, nil, errInvalidPercentile
+		}
+	}
+	head := gpo.backend.CurrentBlock().NumberU64()
+	last := head
+	if lastBlock >= 0 {
+		if uint64(lastBlock) > head {
+			return nil, nil, nil, nil, errRequestBeyondHead
+		}
+		last = uint64(lastBlock)
+	}
+	if uint64(blocks) > last+1 {
+		blocks = int(last + 1)
+	}
+	oldest := last + 1 - uint64(blocks)
+
+	var (
+		config  = gpo.backend.ChainConfig()
+		reward  = make([][]*big.Int, blocks)
+		baseFee = make([]*big.Int, blocks+1)
+		ratio   = make([]float64, blocks)
+	)
+	for i := 0; i < blocks; i++ {
+		block, err := gpo.backend.BlockByNumber(ctx, rpc.BlockNumber(oldest+uint64(i)))
+		if err != nil {
+			return nil, nil, nil, nil, err
+		}
+		if block == nil {
+			return nil, nil, nil, nil, errors.New("block not found")
+		}
+		header := block.Header()
+		if baseFee[i] = header.BaseFee(); baseFee[i] == nil {
+			baseFee[i] = new(big.Int)
+		}
+		if header.GasLimit() > 0 {
+			ratio[i] = float64(header.GasUsed()) / float64(header.GasLimit())
+		}
+		if i == blocks-1 {
+			baseFee[i+1] = new(big.Int)
+			if config.IsLondon(header.Epoch()) {
+				baseFee[i+1] = core.CalcBaseFee(config, header)
+			}
+		}
+		if len(rewardPercentiles) == 0 {
+			continue
+		}
+		receipts, err := gpo.backend.GetReceipts(ctx, block.Hash())
+		if err != nil {
+			return nil, nil, nil, nil, err
+		}
+		reward[i], err = blockRewards(block, receipts, rewardPercentiles)
+		if err != nil {
+			return nil, nil, nil, nil, err
+		}
+	}
+	if len(rewardPercentiles) == 0 {
+		reward = nil
+	}
+	return new(big.Int).SetUint64(oldest), reward, baseFee, ratio, nil
+}
+
+// blockRewards returns the effective tips paid by the transactions of the
+// block at the given percentiles, weighted by the gas each one used.
+func blockRewards(block *types.Block, receipts types.Receipts, percentiles []float64) ([]*big.Int, error) {
+	reward := make([]*big.Int, len(percentiles))
+	txs := block.Transactions()
+	if len(txs) == 0 {
+		for i := range reward {
+			reward[i] = new(big.Int)
+		}
+		return reward, nil
+	}
+	if len(receipts) < len(txs) {
+		return nil, errors.New("missing receipts")
+	}
+	sorter := make(sortGasAndReward, len(txs))
+	for i, tx := range txs {
+		tip, _ := tx.EffectiveGasTip(block.BaseFee())
+		sorter[i] = txGasAndReward{gasUsed: receipts[i].GasUsed, reward: tip}
+	}
+	sort.Stable(sorter)
+
+	var txIndex int
+	sumGasUsed := sorter[0].gasUsed
+	for i, p := range percentiles {
+		thresholdGasUsed := uint64(float64(block.GasUsed()) * p / 100)
+		for sumGasUsed < thresholdGasUsed && txIndex < len(txs)-1 {
+			txIndex++
+			sumGasUsed += sorter[txIndex].gasUsed
+		}
+		reward[i] = sorter[txIndex].reward
+	}
+	return reward, nil
+}
@@ -121,9 +121,28 @@ func NewOracle(backend *Harmony, params *harmony.GasPriceOracleConfig) *Oracle {
 }
 
 // SuggestPrice returns a gasprice so that newly created transaction can
-// have a very high chance to be included in the following blocks.
+// have a very high chance to be included in the following blocks. Once the
+// base fee is active, the price is the suggested tip on top of the base fee
+// of the latest block.
 func (gpo *Oracle) SuggestPrice(ctx context.Context) (*big.Int, error) {
 	head, _ := gpo.backend.HeaderByNumber(ctx, rpc.LatestBlockNumber)
+	tip, err := gpo.suggestTipCap(ctx, head)
+	if head.BaseFee() == nil {
+		return tip, err
+	}
+	return new(big.Int).Add(tip, head.BaseFee()), err
+}
+
+// SuggestTipCap returns a tip cap so that newly created dynamic fee
+// transactions can have a very high chance to be included in the following
+// blocks. Before the base fee is active, the tip is the whole gas price.
+func (gpo *Oracle) SuggestTipCap(ctx context.Context) (*big.Int, error) {
+	head, _ := gpo.backend.HeaderByNumber(ctx, rpc.LatestBlockNumber)
+	return gpo.suggestTipCap(ctx, head)
+}
+
+// suggestTipCap suggests the tip cap for the blocks following the head
+func (gpo *Oracle) suggestTipCap(ctx context.Context, head *block.Header) (*big.Int, error) {
 	headHash := head.Hash()
 
 	// If the latest gasprice is still available, return it.
@@ -193,10 +212,10 @@ func (gpo *Oracle) SuggestPrice(ctx context.Context) (*big.Int, error) {
 	// approximation that only holds when the gas limits, of all blocks that are sampled, are equal
 	usage := usageSum / float64(sent)
 	if usage < gpo.lowUsageThreshold {
-		price = new(big.Int).Set(gpo.defaultPrice)
+		price = tipBelow(gpo.defaultPrice, head.BaseFee())
 	}
-	if price.Cmp(gpo.maxPrice) > 0 {
-		price = new(big.Int).Set(gpo.maxPrice)
+	if maxTip := tipBelow(gpo.maxPrice, head.BaseFee()); price.Cmp(maxTip) > 0 {
+		price = maxTip
 	}
 	gpo.cacheLock.Lock()
 	gpo.lastHead = headHash
@@ -205,22 +224,40 @@ func (gpo *Oracle) SuggestPrice(ctx context.Context) (*big.Int, error) {
 	return price, nil
 }
 
+// tipBelow returns the tip that keeps the total gas price at the given price
+// on top of the base fee, which is nil before the London fork.
+func tipBelow(price, baseFee *big.Int) *big.Int {
+	tip := new(big.Int).Set(price)
+	if baseFee != nil {
+		tip.Sub(tip, baseFee)
+		if tip.Sign() < 0 {
+			tip.SetInt64(0)
+		}
+	}
+	return tip
+}
+
 type getBlockPricesResult struct {
 	prices []*big.Int
 	usage  float64
 	err    error
 }
 
-type transactionsByGasPrice []*types.Transaction
+type transactionsByGasTip struct {
+	txs     []*types.Transaction
+	baseFee *big.Int
+}
 
-func (t transactionsByGasPrice) Len() int      { return len(t) }
-func (t transactionsByGasPrice) Swap(i, j int) { t[i], t[j] = t[j], t[i] }
-func (t transactionsByGasPrice) Less(i, j int) bool {
-	return t[i].GasPrice().Cmp(t[j].GasPrice()) < 0
+func (t transactionsByGasTip) Len() int      { return len(t.txs) }
+func (t transactionsByGasTip) Swap(i, j int) { t.txs[i], t.txs[j] = t.txs[j], t.txs[i] }
+func (t transactionsByGasTip) Less(i, j int) bool {
+	tip1, _ := t.txs[i].EffectiveGasTip(t.baseFee)
+	tip2, _ := t.txs[j].EffectiveGasTip(t.baseFee)
+	return tip1.Cmp(tip2) < 0
 }
 
-// getBlockPrices calculates the lowest transaction gas price in a given block
-// and sends it to the result channel. If the block is empty or all transactions
+// getBlockPrices calculates the lowest transaction gas tips in a given block
+// and sends them to the result channel. If the block is empty or all transactions
 // are sent by the miner itself(it doesn't make any sense to include this kind of
 // transaction prices for sampling), nil gasprice is returned.
 func (gpo *Oracle) getBlockPrices(ctx context.Context, signer types.Signer, blockNum uint64, limit int, result chan getBlockPricesResult, quit chan struct{}) {
@@ -237,13 +274,18 @@ func (gpo *Oracle) getBlockPrices(ctx context.Context, signer types.Signer, bloc
 	blockTxs := block.Transactions()
 	txs := make([]*types.Transaction, len(blockTxs))
 	copy(txs, blockTxs)
-	sort.Sort(transactionsByGasPrice(txs))
+	baseFee := block.BaseFee()
+	sort.Sort(transactionsByGasTip{txs, baseFee})
 
 	var prices []*big.Int
 	for _, tx := range txs {
+		tip, _ := tx.EffectiveGasTip(baseFee)
+		if tip.Sign() < 0 {
+			continue
+		}
 		sender, err := types.Sender(signer, tx)
 		if err == nil && sender != block.Coinbase() {
-			prices = append(prices, tx.GasPrice())
+			prices = append(prices, tip)
 			if len(prices) >= limit {
 				break
 			}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/json"
 	"math/big"
+	"time"
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/math"
@@ -17,6 +18,8 @@ import (
 	"github.com/harmony-one/harmony/core/state"
 	"github.com/harmony-one/harmony/core/types"
 	"github.com/harmony-one/harmony/core/vm"
+	"github.com/harmony-one/harmony/hmy/tokens"
+	"github.com/harmony-one/harmony/hmy/tracers"
 	nodeconfig "github.com/harmony-one/harmony/internal/configs/node"
 	commonRPC "github.com/harmony-one/harmony/rpc/harmony/common"
 	"github.com/harmony-one/harmony/shard"
@@ -34,6 +37,7 @@ const (
 	leaderCacheSize                        = 250  // Approx number of BLS keys in committee
 	undelegationPayoutsCacheSize           = 500  // max number of epochs to store in cache
 	preStakingBlockRewardsCacheSize        = 1024 // max number of block rewards to store in cache
+	delegationChangesCacheSize             = 1024 // max number of block delegation changes to store in cache
 	totalStakeCacheDuration                = 20   // number of blocks where the returned total stake will remain the same
 	// max number of blocks for which the map "validator address -> total delegation to validator" is stored
 	stakeByBlockNumberCacheSize = 250
@@ -78,6 +82,8 @@ type Harmony struct {
 	undelegationPayoutsCache *lru.Cache
 	// preStakingBlockRewardsCache to save on recomputation for commonly checked blocks in epoch < staking epoch
 	preStakingBlockRewardsCache *lru.Cache
+	// delegationChangesCache to save on recomputation for commonly checked blocks in epoch >= staking epoch
+	delegationChangesCache *lru.Cache
 	// totalStakeCache to save on recomputation for `totalStakeCacheDuration` blocks.
 	totalStakeCache *totalStakeCache
 	// stakeByBlockNumberCache to save on recomputation for `totalStakeCacheDuration` blocks
@@ -88,17 +94,23 @@ type Harmony struct {
 type NodeAPI interface {
 	AddPendingStakingTransaction(*staking.StakingTransaction) error
 	AddPendingTransaction(newTx *types.Transaction) error
+	AddPendingPrivateTransaction(newTx *types.Transaction, expiryBlock uint64) error
+	GetPrivateTransactionStatus(hash common.Hash) (*commonRPC.PrivateTxStatus, error)
 	Blockchain() core.BlockChain
 	Beaconchain() core.BlockChain
 	GetTransactionsHistory(address, txType, order string) ([]common.Hash, error)
 	GetStakingTransactionsHistory(address, txType, order string) ([]common.Hash, error)
 	GetTransactionsCount(address, txType string) (uint64, error)
 	GetStakingTransactionsCount(address, txType string) (uint64, error)
+	GetTokenTransfersByAddress(address string, pageIndex, pageSize uint32, order string) ([]*tokens.Transfer, error)
+	GetTokenTransfersByContract(address string, pageIndex, pageSize uint32, order string) ([]*tokens.Transfer, error)
+	GetInternalTransactionsHistory(address string, pageIndex, pageSize uint32, order string) ([]*tracers.InternalTransaction, error)
 	GetTraceResultByHash(hash common.Hash) (json.RawMessage, error)
 	IsCurrentlyLeader() bool
 	IsOutOfSync(shardID uint32) bool
 	SyncStatus(shardID uint32) (bool, uint64, uint64)
 	SyncPeers() map[string]int
+	SyncPeerScores() []commonRPC.SyncPeerScore
 	ReportStakingErrorSink() types.TransactionErrorReports
 	ReportPlainErrorSink() types.TransactionErrorReports
 	PendingCXReceipts() []*types.CXReceiptsProof
@@ -107,6 +119,13 @@ type NodeAPI interface {
 	ListPeer(topic string) []peer.ID
 	ListTopic() []string
 	ListBlockedPeer() []peer.ID
+	AddPeerByAddress(addr string) error
+	RemovePeer(id peer.ID) error
+	BlockPeer(id peer.ID, duration time.Duration) error
+	ReloadTxPoolFilters() (int, error)
+	StartService(name string) error
+	StopService(name string) error
+	ServicesStatus() map[string]bool
 
 	GetConsensusInternal() commonRPC.ConsensusInternal
 	IsBackup() bool
@@ -131,6 +150,7 @@ func New(
 	undelegationPayoutsCache, _ := lru.New(undelegationPayoutsCacheSize)
 	stakeByBlockNumberCache, _ := lru.New(stakeByBlockNumberCacheSize)
 	preStakingBlockRewardsCache, _ := lru.New(preStakingBlockRewardsCacheSize)
+	delegationChangesCache, _ := lru.New(delegationChangesCacheSize)
 	totalStakeCache := newTotalStakeCache(totalStakeCacheDuration)
 	bloomIndexer := NewBloomIndexer(nodeAPI.Blockchain(), params.BloomBitsBlocks, params.BloomConfirms)
 	bloomIndexer.Start(nodeAPI.Blockchain())
@@ -153,6 +173,7 @@ func New(
 		totalStakeCache:             totalStakeCache,
 		undelegationPayoutsCache:    undelegationPayoutsCache,
 		preStakingBlockRewardsCache: preStakingBlockRewardsCache,
+		delegationChangesCache:      delegationChangesCache,
 		stakeByBlockNumberCache:     stakeByBlockNumberCache,
 	}
 
@@ -238,9 +259,14 @@ func (hmy *Harmony) GetNodeMetadata() commonRPC.NodeMetadata {
 }
 
 // GetEVM returns a new EVM entity
-func (hmy *Harmony) GetEVM(ctx context.Context, msg core.Message, state *state.DB, header *block.Header) (*vm.EVM, error) {
+func (hmy *Harmony) GetEVM(ctx context.Context, msg core.Message, state *state.DB, header *block.Header, blockOverrides *BlockOverrides) (*vm.EVM, error) {
 	state.SetBalance(msg.From(), math.MaxBig256)
 	vmCtx := core.NewEVMContext(msg, header, hmy.BlockChain, nil)
+	blockOverrides.Apply(&vmCtx)
+	// Calls without a gas price are not subject to the base fee.
+	if msg.GasPrice().Sign() == 0 {
+		vmCtx.BaseFee = nil
+	}
 	return vm.NewEVM(vmCtx, state, hmy.BlockChain.Config(), *hmy.BlockChain.GetVMConfig()), nil
 }
 
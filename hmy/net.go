@@ -29,5 +29,6 @@ func (hmy *Harmony) GetPeerInfo() commonRPC.NodePeerInfo {
 		PeerID:       nodeconfig.GetPeerID(),
 		BlockedPeers: hmy.NodeAPI.ListBlockedPeer(),
 		P:            p,
+		SyncPeers:    hmy.NodeAPI.SyncPeerScores(),
 	}
 }
@@ -0,0 +1,92 @@
+package hmy
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+
+	"github.com/harmony-one/harmony/core/state"
+	"github.com/harmony-one/harmony/core/vm"
+)
+
+// OverrideAccount indicates the overriding fields of account during the execution
+// of a message call.
+// Note, state and stateDiff can't be specified at the same time. If state is
+// set, message execution will only use the data in the given state. Otherwise
+// if stateDiff is set, all diff will be applied first and then execute the call
+// message.
+type OverrideAccount struct {
+	Nonce     *hexutil.Uint64              `json:"nonce"`
+	Code      *hexutil.Bytes               `json:"code"`
+	Balance   **hexutil.Big                `json:"balance"`
+	State     *map[common.Hash]common.Hash `json:"state"`
+	StateDiff *map[common.Hash]common.Hash `json:"stateDiff"`
+}
+
+// StateOverride is the collection of overridden accounts.
+type StateOverride map[common.Address]OverrideAccount
+
+// Apply overrides the fields of specified accounts into the given state.
+func (diff *StateOverride) Apply(state *state.DB) error {
+	if diff == nil {
+		return nil
+	}
+	for addr, account := range *diff {
+		// Override account nonce.
+		if account.Nonce != nil {
+			state.SetNonce(addr, uint64(*account.Nonce))
+		}
+		// Override account(contract) code.
+		if account.Code != nil {
+			state.SetCode(addr, *account.Code, false)
+		}
+		// Override account balance.
+		if account.Balance != nil {
+			state.SetBalance(addr, (*big.Int)(*account.Balance))
+		}
+		if account.State != nil && account.StateDiff != nil {
+			return fmt.Errorf("account %s has both 'state' and 'stateDiff'", addr.Hex())
+		}
+		// Replace entire state if caller requires.
+		if account.State != nil {
+			state.SetStorage(addr, *account.State)
+		}
+		// Apply state diff into specified accounts.
+		if account.StateDiff != nil {
+			for key, value := range *account.StateDiff {
+				state.SetState(addr, key, value)
+			}
+		}
+	}
+	return state.Error()
+}
+
+// BlockOverrides is a set of header fields to override during the execution
+// of a message call.
+type BlockOverrides struct {
+	Number   *hexutil.Big    `json:"number"`
+	Time     *hexutil.Uint64 `json:"time"`
+	GasLimit *hexutil.Uint64 `json:"gasLimit"`
+	Coinbase *common.Address `json:"coinbase"`
+}
+
+// Apply overrides the given EVM block context with the block overrides.
+func (diff *BlockOverrides) Apply(vmCtx *vm.Context) {
+	if diff == nil {
+		return
+	}
+	if diff.Number != nil {
+		vmCtx.BlockNumber = diff.Number.ToInt()
+	}
+	if diff.Time != nil {
+		vmCtx.Time = new(big.Int).SetUint64(uint64(*diff.Time))
+	}
+	if diff.GasLimit != nil {
+		vmCtx.GasLimit = uint64(*diff.GasLimit)
+	}
+	if diff.Coinbase != nil {
+		vmCtx.Coinbase = *diff.Coinbase
+	}
+}
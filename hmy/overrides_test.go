@@ -0,0 +1,97 @@
+package hmy
+
+import (
+	"encoding/json"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/harmony-one/harmony/core/rawdb"
+	"github.com/harmony-one/harmony/core/state"
+	"github.com/harmony-one/harmony/core/vm"
+)
+
+func TestStateOverrideApply(t *testing.T) {
+	var (
+		addr  = common.HexToAddress("0x01")
+		slot1 = common.HexToHash("0x01")
+		slot2 = common.HexToHash("0x02")
+	)
+	sdb := state.NewDatabase(rawdb.NewMemoryDatabase())
+	db, _ := state.New(common.Hash{}, sdb, nil)
+	db.SetState(addr, slot1, common.HexToHash("0xaa"))
+	db.SetState(addr, slot2, common.HexToHash("0xbb"))
+	root, err := db.Commit(true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	db, _ = state.New(root, sdb, nil)
+
+	var overrides StateOverride
+	if err := json.Unmarshal([]byte(`{
+		"0x0000000000000000000000000000000000000001": {
+			"nonce": "0x5",
+			"balance": "0x64",
+			"code": "0x6000",
+			"stateDiff": {"0x0000000000000000000000000000000000000000000000000000000000000001": "0x00000000000000000000000000000000000000000000000000000000000000cc"}
+		}
+	}`), &overrides); err != nil {
+		t.Fatal(err)
+	}
+	if err := overrides.Apply(db); err != nil {
+		t.Fatal(err)
+	}
+	if db.GetNonce(addr) != 5 || db.GetBalance(addr).Cmp(big.NewInt(100)) != 0 {
+		t.Fatalf("unexpected nonce %d or balance %v", db.GetNonce(addr), db.GetBalance(addr))
+	}
+	if code := db.GetCode(addr); len(code) != 2 {
+		t.Fatalf("unexpected code %x", code)
+	}
+	if v := db.GetState(addr, slot1); v != common.HexToHash("0xcc") {
+		t.Fatalf("have slot value %s, want 0xcc", v.Hex())
+	}
+	if v := db.GetState(addr, slot2); v != common.HexToHash("0xbb") {
+		t.Fatalf("state diff must keep other slots, have %s", v.Hex())
+	}
+
+	// the whole storage is replaced on a fresh state
+	db, _ = state.New(root, sdb, nil)
+	storage := map[common.Hash]common.Hash{slot1: common.HexToHash("0xdd")}
+	overrides = StateOverride{addr: {State: &storage}}
+	if err := overrides.Apply(db); err != nil {
+		t.Fatal(err)
+	}
+	if v := db.GetState(addr, slot1); v != common.HexToHash("0xdd") {
+		t.Fatalf("have slot value %s, want 0xdd", v.Hex())
+	}
+	if v := db.GetState(addr, slot2); v != (common.Hash{}) {
+		t.Fatalf("state must replace the storage, have %s", v.Hex())
+	}
+
+	overrides = StateOverride{addr: {State: &storage, StateDiff: &storage}}
+	if err := overrides.Apply(db); err == nil {
+		t.Fatal("state and stateDiff must not be accepted together")
+	}
+}
+
+func TestBlockOverridesApply(t *testing.T) {
+	vmCtx := vm.Context{
+		BlockNumber: big.NewInt(1),
+		Time:        big.NewInt(1),
+		GasLimit:    1,
+	}
+	var overrides *BlockOverrides
+	overrides.Apply(&vmCtx)
+
+	if err := json.Unmarshal([]byte(`{"number": "0x10", "time": "0x20", "gasLimit": "0x30", "coinbase": "0x0000000000000000000000000000000000000040"}`), &overrides); err != nil {
+		t.Fatal(err)
+	}
+	overrides.Apply(&vmCtx)
+	if vmCtx.BlockNumber.Uint64() != 0x10 || vmCtx.Time.Uint64() != 0x20 || vmCtx.GasLimit != 0x30 {
+		t.Fatalf("unexpected block context %v %v %v", vmCtx.BlockNumber, vmCtx.Time, vmCtx.GasLimit)
+	}
+	if vmCtx.Coinbase != common.HexToAddress("0x40") {
+		t.Fatalf("unexpected coinbase %s", vmCtx.Coinbase.Hex())
+	}
+}
@@ -6,6 +6,7 @@ import (
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/harmony-one/harmony/core/types"
+	"github.com/harmony-one/harmony/eth/rpc"
 )
 
 // GetPoolStats returns the number of pending and queued transactions
@@ -23,6 +24,33 @@ func (hmy *Harmony) GetPoolTransaction(hash common.Hash) types.PoolTransaction {
 	return hmy.TxPool.Get(hash)
 }
 
+// GetPoolContent returns the pending & queued transactions of the pool, grouped by sender
+func (hmy *Harmony) GetPoolContent() (
+	pending map[common.Address]types.PoolTransactions, queued map[common.Address]types.PoolTransactions,
+) {
+	return hmy.TxPool.Content()
+}
+
+// GetPoolContentFrom returns the pending & queued transactions of the sender
+func (hmy *Harmony) GetPoolContentFrom(addr common.Address) (pending, queued types.PoolTransactions) {
+	return hmy.TxPool.ContentFrom(addr)
+}
+
+// EvictPoolTransactions removes the transactions with the given hashes from the pool.
+// Cancelled transactions are refused if they are submitted again.
+func (hmy *Harmony) EvictPoolTransactions(
+	hashes []common.Hash, reason string, cancel bool,
+) types.PoolTransactions {
+	return hmy.TxPool.EvictTransactions(hashes, reason, cancel)
+}
+
+// EvictPoolTransactionsFrom removes all transactions of the sender from the pool.
+func (hmy *Harmony) EvictPoolTransactionsFrom(
+	addr common.Address, reason string, cancel bool,
+) types.PoolTransactions {
+	return hmy.TxPool.EvictTransactionsFrom(addr, reason, cancel)
+}
+
 // GetPendingCXReceipts ..
 func (hmy *Harmony) GetPendingCXReceipts() []*types.CXReceiptsProof {
 	return hmy.NodeAPI.PendingCXReceipts()
@@ -51,3 +79,15 @@ func (hmy *Harmony) GetPoolTransactions() (types.PoolTransactions, error) {
 func (hmy *Harmony) SuggestPrice(ctx context.Context) (*big.Int, error) {
 	return hmy.gpo.SuggestPrice(ctx)
 }
+
+// SuggestTipCap returns a suggestion for the tip of dynamic fee transactions.
+func (hmy *Harmony) SuggestTipCap(ctx context.Context) (*big.Int, error) {
+	return hmy.gpo.SuggestTipCap(ctx)
+}
+
+// FeeHistory returns the fee market history of the given range of blocks.
+func (hmy *Harmony) FeeHistory(
+	ctx context.Context, blocks int, lastBlock rpc.BlockNumber, rewardPercentiles []float64,
+) (*big.Int, [][]*big.Int, []*big.Int, []float64, error) {
+	return hmy.gpo.FeeHistory(ctx, blocks, lastBlock, rewardPercentiles)
+}
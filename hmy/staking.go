@@ -1,9 +1,11 @@
 package hmy
 
 import (
+	"bytes"
 	"context"
 	"fmt"
 	"math/big"
+	"sort"
 	"sync"
 
 	"github.com/ethereum/go-ethereum/common"
@@ -617,6 +619,115 @@ func (hmy *Harmony) GetUndelegationPayouts(
 	return undelegationPayouts, nil
 }
 
+// DelegationChange is the change of a delegation made by a block
+type DelegationChange struct {
+	Validator    common.Address
+	Delegator    common.Address
+	Amount       *big.Int
+	Undelegation *big.Int
+	Reward       *big.Int
+}
+
+// GetDelegationChanges returns the change of the delegated amount, the pending undelegations and
+// the unclaimed rewards of every delegation that was changed by the given block, i.e. the
+// difference between the delegations at the parent block state and at the block state.
+// Changes are sorted by validator & delegator address.
+func (hmy *Harmony) GetDelegationChanges(
+	ctx context.Context, blk *types.Block,
+) ([]DelegationChange, error) {
+	if !hmy.IsStakingEpoch(blk.Epoch()) || blk.NumberU64() == 0 {
+		return nil, nil
+	}
+	if changes, ok := hmy.delegationChangesCache.Get(blk.Hash()); ok {
+		return changes.([]DelegationChange), nil
+	}
+	parent := hmy.BlockChain.GetHeaderByHash(blk.ParentHash())
+	if parent == nil {
+		return nil, fmt.Errorf("parent of block %v not found", blk.NumberU64())
+	}
+
+	type delegationTotals struct {
+		amount, undelegation, reward *big.Int
+	}
+	parentState, err := hmy.BlockChain.StateAt(parent.Root())
+	if err != nil {
+		return nil, errors.Wrapf(err, "state of block %v", parent.Number())
+	}
+	blkState, err := hmy.BlockChain.StateAt(blk.Root())
+	if err != nil {
+		return nil, errors.Wrapf(err, "state of block %v", blk.NumberU64())
+	}
+	readTotals := func(validator common.Address, st *state.DB) map[common.Address]delegationTotals {
+		totals := map[common.Address]delegationTotals{}
+		wrapper, err := hmy.BlockChain.ReadValidatorInformationAtState(validator, st)
+		if err != nil || wrapper == nil {
+			return totals // Not a validator at this block
+		}
+		for _, delegation := range wrapper.Delegations {
+			undelegation := big.NewInt(0)
+			for _, entry := range delegation.Undelegations {
+				undelegation.Add(undelegation, entry.Amount)
+			}
+			totals[delegation.DelegatorAddress] = delegationTotals{
+				amount:       new(big.Int).Set(delegation.Amount),
+				undelegation: undelegation,
+				reward:       new(big.Int).Set(delegation.Reward),
+			}
+		}
+		return totals
+	}
+	orZero := func(value *big.Int) *big.Int {
+		if value == nil {
+			return bigZero
+		}
+		return value
+	}
+
+	changes := []DelegationChange{}
+	for _, validator := range hmy.GetAllValidatorAddresses() {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		// the wrapper is stored as the code of the validator account, only the
+		// validators touched by the block have their wrapper decoded
+		if parentState.GetCodeHash(validator) == blkState.GetCodeHash(validator) {
+			continue
+		}
+		before, after := readTotals(validator, parentState), readTotals(validator, blkState)
+		delegators := make([]common.Address, 0, len(after))
+		for delegator := range after {
+			delegators = append(delegators, delegator)
+		}
+		for delegator := range before {
+			if _, ok := after[delegator]; !ok {
+				delegators = append(delegators, delegator)
+			}
+		}
+		sort.Slice(delegators, func(i, j int) bool {
+			return bytes.Compare(delegators[i].Bytes(), delegators[j].Bytes()) < 0
+		})
+		for _, delegator := range delegators {
+			prev, curr := before[delegator], after[delegator]
+			change := DelegationChange{
+				Validator:    validator,
+				Delegator:    delegator,
+				Amount:       new(big.Int).Sub(orZero(curr.amount), orZero(prev.amount)),
+				Undelegation: new(big.Int).Sub(orZero(curr.undelegation), orZero(prev.undelegation)),
+				Reward:       new(big.Int).Sub(orZero(curr.reward), orZero(prev.reward)),
+			}
+			if change.Amount.Sign() != 0 || change.Undelegation.Sign() != 0 || change.Reward.Sign() != 0 {
+				changes = append(changes, change)
+			}
+		}
+	}
+	sort.SliceStable(changes, func(i, j int) bool {
+		return bytes.Compare(changes[i].Validator.Bytes(), changes[j].Validator.Bytes()) < 0
+	})
+
+	hmy.delegationChangesCache.Add(blk.Hash(), changes)
+	return changes, nil
+}
+
 // GetTotalStakingSnapshot ..
 func (hmy *Harmony) GetTotalStakingSnapshot() *big.Int {
 	if stake := hmy.totalStakeCache.pop(hmy.CurrentBlock().NumberU64()); stake != nil {
@@ -0,0 +1,99 @@
+package hmy
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/harmony-one/harmony/core"
+	"github.com/harmony-one/harmony/core/state"
+	"github.com/harmony-one/harmony/core/types"
+	"github.com/harmony-one/harmony/core/vm"
+	"github.com/harmony-one/harmony/hmy/tracers"
+)
+
+// MaxStateAccessBlockRange is the maximum number of blocks aggregated by
+// ProfileStateAccessRange
+const MaxStateAccessBlockRange = 128
+
+// StateAccessProfile is the state accessed by the execution of a message
+type StateAccessProfile struct {
+	Gas         uint64                                    `json:"gas"`
+	Failed      bool                                      `json:"failed"`
+	ReturnValue string                                    `json:"returnValue"`
+	Accounts    map[common.Address]*tracers.AccountAccess `json:"accounts"`
+}
+
+// ProfileStateAccess executes the message on the given state and returns
+// every account and storage slot it read or wrote, with their values before
+// and after the execution.
+func (hmy *Harmony) ProfileStateAccess(ctx context.Context, message core.Message, vmctx vm.Context, statedb *state.DB) (*StateAccessProfile, error) {
+	pre := statedb.Copy()
+	tracer := tracers.NewStateAccessTracer()
+	vmenv := vm.NewEVM(vmctx, statedb, hmy.BlockChain.Config(), vm.Config{Debug: true, Tracer: tracer})
+
+	deadlineCtx, cancel := context.WithTimeout(ctx, defaultTraceTimeout)
+	defer cancel()
+	go func() {
+		<-deadlineCtx.Done()
+		vmenv.Cancel()
+	}()
+
+	result, err := core.ApplyMessage(vmenv, message, new(core.GasPool).AddGas(message.Gas()))
+	if err != nil {
+		return nil, fmt.Errorf("profiling failed: %v", err)
+	}
+	if vmenv.Cancelled() {
+		return nil, errors.New("execution timeout")
+	}
+	return &StateAccessProfile{
+		Gas:         result.UsedGas,
+		Failed:      result.VMErr != nil,
+		ReturnValue: fmt.Sprintf("%x", result.ReturnData),
+		Accounts:    tracer.GetResult(pre, statedb),
+	}, nil
+}
+
+// ProfileStateAccessRange re-executes the transactions of the blocks from
+// start to end, both included, and aggregates their state accesses per
+// account.
+func (hmy *Harmony) ProfileStateAccessRange(ctx context.Context, start, end uint64, reexec uint64) (tracers.StateAccessStats, error) {
+	if start > end {
+		return nil, fmt.Errorf("start block %d is above end block %d", start, end)
+	}
+	if end-start >= MaxStateAccessBlockRange {
+		return nil, fmt.Errorf("block range exceeds %d blocks", MaxStateAccessBlockRange)
+	}
+	stats := make(tracers.StateAccessStats)
+	for number := start; number <= end; number++ {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		block := hmy.BlockChain.GetBlockByNumber(number)
+		if block == nil {
+			return nil, fmt.Errorf("block #%d not found", number)
+		}
+		var execErr error
+		err := hmy.ComputeTxEnvEachBlockWithoutApply(block, reexec, func(idx int, tx *types.Transaction, msg core.Message, vmctx vm.Context, statedb *state.DB) bool {
+			statedb.Prepare(tx.Hash(), block.Hash(), idx)
+			statedb.SetTxHashETH(tx.ConvertToEth().Hash())
+
+			tracer := tracers.NewStateAccessTracer()
+			vmenv := vm.NewEVM(vmctx, statedb, hmy.BlockChain.Config(), vm.Config{Debug: true, Tracer: tracer})
+			if _, err := core.ApplyMessage(vmenv, msg, new(core.GasPool).AddGas(msg.Gas())); err != nil {
+				execErr = fmt.Errorf("transaction %#x failed: %v", tx.Hash(), err)
+				return false
+			}
+			stats.Add(tracer.Accesses())
+			return true
+		})
+		if err != nil {
+			return nil, err
+		}
+		if execErr != nil {
+			return nil, execErr
+		}
+	}
+	return stats, nil
+}
@@ -0,0 +1,203 @@
+// Package tokens decodes the transfer events of the ERC-20, ERC-721 and
+// ERC-1155 token standards.
+package tokens
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/harmony-one/harmony/core/types"
+)
+
+// Standard is the token standard of a transfer
+type Standard uint8
+
+// Token standards
+const (
+	StandardUnknown Standard = iota
+	StandardERC20
+	StandardERC721
+	StandardERC1155
+)
+
+func (s Standard) String() string {
+	switch s {
+	case StandardERC20:
+		return "ERC20"
+	case StandardERC721:
+		return "ERC721"
+	case StandardERC1155:
+		return "ERC1155"
+	}
+	return "UNKNOWN"
+}
+
+// MarshalText implements encoding.TextMarshaler
+func (s Standard) MarshalText() ([]byte, error) {
+	return []byte(s.String()), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler
+func (s *Standard) UnmarshalText(text []byte) error {
+	for _, std := range []Standard{StandardERC20, StandardERC721, StandardERC1155} {
+		if string(text) == std.String() {
+			*s = std
+			return nil
+		}
+	}
+	return fmt.Errorf("unknown token standard %q", text)
+}
+
+var (
+	// TransferTopic is the topic of Transfer(address,address,uint256), shared
+	// by ERC-20 and ERC-721
+	TransferTopic = crypto.Keccak256Hash([]byte("Transfer(address,address,uint256)"))
+	// TransferSingleTopic is the topic of the ERC-1155 TransferSingle event
+	TransferSingleTopic = crypto.Keccak256Hash([]byte("TransferSingle(address,address,address,uint256,uint256)"))
+	// TransferBatchTopic is the topic of the ERC-1155 TransferBatch event
+	TransferBatchTopic = crypto.Keccak256Hash([]byte("TransferBatch(address,address,address,uint256[],uint256[])"))
+)
+
+// Transfer is a single token movement decoded from a transfer event.
+// TokenID is nil for fungible ERC-20 transfers.
+type Transfer struct {
+	Standard    Standard       `json:"standard"`
+	Contract    common.Address `json:"contract"`
+	Operator    common.Address `json:"operator"`
+	From        common.Address `json:"from"`
+	To          common.Address `json:"to"`
+	TokenID     *big.Int       `json:"tokenId,omitempty"`
+	Value       *big.Int       `json:"value"`
+	TxHash      common.Hash    `json:"transactionHash"`
+	BlockNumber uint64         `json:"blockNumber"`
+	TxIndex     uint64         `json:"transactionIndex"`
+	LogIndex    uint64         `json:"logIndex"`
+	// BatchIndex is the position of the transfer in an ERC-1155 batch
+	BatchIndex uint64 `json:"batchIndex"`
+	Timestamp  uint64 `json:"timestamp"`
+}
+
+// FromLog decodes the token transfers carried by the log. It returns nil if
+// the log is not a well formed transfer event. Only the token fields are set,
+// the position of the transfer in the chain is left to the caller.
+func FromLog(log *types.Log) []*Transfer {
+	if len(log.Topics) == 0 {
+		return nil
+	}
+	switch log.Topics[0] {
+	case TransferTopic:
+		return decodeTransfer(log)
+	case TransferSingleTopic:
+		return decodeTransferSingle(log)
+	case TransferBatchTopic:
+		return decodeTransferBatch(log)
+	}
+	return nil
+}
+
+// decodeTransfer tells ERC-20 from ERC-721 transfers by the indexed token ID
+func decodeTransfer(log *types.Log) []*Transfer {
+	switch {
+	case len(log.Topics) == 3 && len(log.Data) == 32:
+		return []*Transfer{{
+			Standard: StandardERC20,
+			Contract: log.Address,
+			From:     topicAddress(log.Topics[1]),
+			To:       topicAddress(log.Topics[2]),
+			Value:    new(big.Int).SetBytes(log.Data),
+		}}
+	case len(log.Topics) == 4 && len(log.Data) == 0:
+		return []*Transfer{{
+			Standard: StandardERC721,
+			Contract: log.Address,
+			From:     topicAddress(log.Topics[1]),
+			To:       topicAddress(log.Topics[2]),
+			TokenID:  log.Topics[3].Big(),
+			Value:    big.NewInt(1),
+		}}
+	}
+	return nil
+}
+
+func decodeTransferSingle(log *types.Log) []*Transfer {
+	if len(log.Topics) != 4 || len(log.Data) != 64 {
+		return nil
+	}
+	return []*Transfer{{
+		Standard: StandardERC1155,
+		Contract: log.Address,
+		Operator: topicAddress(log.Topics[1]),
+		From:     topicAddress(log.Topics[2]),
+		To:       topicAddress(log.Topics[3]),
+		TokenID:  new(big.Int).SetBytes(log.Data[:32]),
+		Value:    new(big.Int).SetBytes(log.Data[32:]),
+	}}
+}
+
+func decodeTransferBatch(log *types.Log) []*Transfer {
+	if len(log.Topics) != 4 || len(log.Data) < 64 {
+		return nil
+	}
+	ids, ok := decodeUintArray(log.Data, 0)
+	if !ok {
+		return nil
+	}
+	values, ok := decodeUintArray(log.Data, 32)
+	if !ok || len(ids) != len(values) {
+		return nil
+	}
+	transfers := make([]*Transfer, 0, len(ids))
+	for i := range ids {
+		transfers = append(transfers, &Transfer{
+			Standard:   StandardERC1155,
+			Contract:   log.Address,
+			Operator:   topicAddress(log.Topics[1]),
+			From:       topicAddress(log.Topics[2]),
+			To:         topicAddress(log.Topics[3]),
+			TokenID:    ids[i],
+			Value:      values[i],
+			BatchIndex: uint64(i),
+		})
+	}
+	return transfers
+}
+
+// decodeUintArray decodes the ABI encoded uint256[] whose offset is stored
+// in the head word at the given position
+func decodeUintArray(data []byte, head int) ([]*big.Int, bool) {
+	offset, ok := readLength(data, head)
+	if !ok {
+		return nil, false
+	}
+	length, ok := readLength(data, offset)
+	if !ok {
+		return nil, false
+	}
+	start := offset + 32
+	if uint64(length) > uint64(len(data)-start)/32 {
+		return nil, false
+	}
+	arr := make([]*big.Int, length)
+	for i := range arr {
+		arr[i] = new(big.Int).SetBytes(data[start+32*i : start+32*(i+1)])
+	}
+	return arr, true
+}
+
+// readLength reads the 32 byte word at pos as an offset or length within data
+func readLength(data []byte, pos int) (int, bool) {
+	if pos < 0 || pos+32 > len(data) {
+		return 0, false
+	}
+	word := new(big.Int).SetBytes(data[pos : pos+32])
+	if !word.IsInt64() || word.Int64() > int64(len(data)) {
+		return 0, false
+	}
+	return int(word.Int64()), true
+}
+
+func topicAddress(topic common.Hash) common.Address {
+	return common.BytesToAddress(topic[common.HashLength-common.AddressLength:])
+}
@@ -0,0 +1,168 @@
+package tokens
+
+import (
+	"fmt"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/math"
+	"github.com/harmony-one/harmony/core/types"
+)
+
+var (
+	testContract = common.HexToAddress("0x00000000000000000000000000000000000000c0")
+	testOperator = common.HexToAddress("0x0000000000000000000000000000000000000001")
+	testFrom     = common.HexToAddress("0x0000000000000000000000000000000000000002")
+	testTo       = common.HexToAddress("0x0000000000000000000000000000000000000003")
+)
+
+func word(v int64) []byte {
+	return math.U256Bytes(big.NewInt(v))
+}
+
+func concat(words ...[]byte) []byte {
+	var data []byte
+	for _, w := range words {
+		data = append(data, w...)
+	}
+	return data
+}
+
+func addrTopic(addr common.Address) common.Hash {
+	return common.BytesToHash(addr.Bytes())
+}
+
+func TestFromLog(t *testing.T) {
+	tests := []struct {
+		name string
+		log  *types.Log
+		want []*Transfer
+	}{
+		{
+			name: "erc20",
+			log: &types.Log{
+				Address: testContract,
+				Topics:  []common.Hash{TransferTopic, addrTopic(testFrom), addrTopic(testTo)},
+				Data:    word(1000),
+			},
+			want: []*Transfer{{Standard: StandardERC20, From: testFrom, To: testTo, Value: big.NewInt(1000)}},
+		},
+		{
+			name: "erc721",
+			log: &types.Log{
+				Address: testContract,
+				Topics:  []common.Hash{TransferTopic, addrTopic(testFrom), addrTopic(testTo), common.BigToHash(big.NewInt(42))},
+			},
+			want: []*Transfer{{Standard: StandardERC721, From: testFrom, To: testTo, TokenID: big.NewInt(42), Value: big.NewInt(1)}},
+		},
+		{
+			name: "erc1155 single",
+			log: &types.Log{
+				Address: testContract,
+				Topics:  []common.Hash{TransferSingleTopic, addrTopic(testOperator), addrTopic(testFrom), addrTopic(testTo)},
+				Data:    concat(word(7), word(5)),
+			},
+			want: []*Transfer{{Standard: StandardERC1155, Operator: testOperator, From: testFrom, To: testTo, TokenID: big.NewInt(7), Value: big.NewInt(5)}},
+		},
+		{
+			name: "erc1155 batch",
+			log: &types.Log{
+				Address: testContract,
+				Topics:  []common.Hash{TransferBatchTopic, addrTopic(testOperator), addrTopic(testFrom), addrTopic(testTo)},
+				Data:    concat(word(64), word(160), word(2), word(7), word(8), word(2), word(5), word(6)),
+			},
+			want: []*Transfer{
+				{Standard: StandardERC1155, Operator: testOperator, From: testFrom, To: testTo, TokenID: big.NewInt(7), Value: big.NewInt(5)},
+				{Standard: StandardERC1155, Operator: testOperator, From: testFrom, To: testTo, TokenID: big.NewInt(8), Value: big.NewInt(6), BatchIndex: 1},
+			},
+		},
+		{
+			name: "erc1155 batch length mismatch",
+			log: &types.Log{
+				Topics: []common.Hash{TransferBatchTopic, addrTopic(testOperator), addrTopic(testFrom), addrTopic(testTo)},
+				Data:   concat(word(64), word(160), word(2), word(7), word(8), word(1), word(5)),
+			},
+		},
+		{
+			name: "erc1155 batch out of bounds",
+			log: &types.Log{
+				Topics: []common.Hash{TransferBatchTopic, addrTopic(testOperator), addrTopic(testFrom), addrTopic(testTo)},
+				Data:   concat(word(64), word(96), word(1000), word(7)),
+			},
+		},
+		{
+			name: "transfer without indexed addresses",
+			log: &types.Log{
+				Topics: []common.Hash{TransferTopic},
+				Data:   concat(word(1), word(2), word(3)),
+			},
+		},
+		{
+			name: "unrelated event",
+			log: &types.Log{
+				Topics: []common.Hash{common.HexToHash("0x01"), addrTopic(testFrom), addrTopic(testTo)},
+				Data:   word(1),
+			},
+		},
+	}
+	for _, test := range tests {
+		have := FromLog(test.log)
+		if len(have) != len(test.want) {
+			t.Fatalf("%s: have %d transfers, want %d", test.name, len(have), len(test.want))
+		}
+		for i, want := range test.want {
+			want.Contract = testContract
+			if err := checkTransfer(have[i], want); err != nil {
+				t.Errorf("%s: transfer %d: %v", test.name, i, err)
+			}
+		}
+	}
+}
+
+func checkTransfer(have, want *Transfer) error {
+	switch {
+	case have.Standard != want.Standard:
+		return errorf("standard", have.Standard, want.Standard)
+	case have.Contract != want.Contract:
+		return errorf("contract", have.Contract, want.Contract)
+	case have.Operator != want.Operator:
+		return errorf("operator", have.Operator, want.Operator)
+	case have.From != want.From:
+		return errorf("from", have.From, want.From)
+	case have.To != want.To:
+		return errorf("to", have.To, want.To)
+	case (have.TokenID == nil) != (want.TokenID == nil) ||
+		(have.TokenID != nil && have.TokenID.Cmp(want.TokenID) != 0):
+		return errorf("token id", have.TokenID, want.TokenID)
+	case have.Value.Cmp(want.Value) != 0:
+		return errorf("value", have.Value, want.Value)
+	case have.BatchIndex != want.BatchIndex:
+		return errorf("batch index", have.BatchIndex, want.BatchIndex)
+	}
+	return nil
+}
+
+func errorf(field string, have, want interface{}) error {
+	return fmt.Errorf("wrong %s: have %v, want %v", field, have, want)
+}
+
+func TestStandardText(t *testing.T) {
+	for _, std := range []Standard{StandardERC20, StandardERC721, StandardERC1155} {
+		text, err := std.MarshalText()
+		if err != nil {
+			t.Fatal(err)
+		}
+		var decoded Standard
+		if err := decoded.UnmarshalText(text); err != nil {
+			t.Fatal(err)
+		}
+		if decoded != std {
+			t.Errorf("have %v, want %v", decoded, std)
+		}
+	}
+	var s Standard
+	if err := s.UnmarshalText([]byte("ERC777")); err == nil {
+		t.Error("expected error for unknown standard")
+	}
+}
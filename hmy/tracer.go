@@ -62,6 +62,14 @@ type TraceConfig struct {
 	Reexec  *uint64
 }
 
+// TraceCallConfig is the config for traceCall API. It holds the state and
+// block overrides applied before tracing the call.
+type TraceCallConfig struct {
+	TraceConfig
+	StateOverrides *StateOverride
+	BlockOverrides *BlockOverrides
+}
+
 // StdTraceConfig holds extra parameters to standard-json trace functions.
 type StdTraceConfig struct {
 	*vm.LogConfig
@@ -164,7 +172,7 @@ func (hmy *Harmony) TraceChain(ctx context.Context, start, end *types.Block, con
 			// Fetch and execute the next block trace tasks
 			for task := range tasks {
 				hmySigner := types.MakeSigner(hmy.BlockChain.Config(), task.block.Number())
-				ethSigner := types.NewEIP155Signer(hmy.BlockChain.Config().EthCompatibleChainID)
+				ethSigner := types.NewLondonSigner(hmy.BlockChain.Config().EthCompatibleChainID)
 
 				// Trace all the transactions contained within
 				for i, tx := range task.block.Transactions() {
@@ -364,7 +372,7 @@ func (hmy *Harmony) traceBlockNoThread(ctx context.Context, block *types.Block,
 	// Execute all the transaction contained within the block concurrently
 	var (
 		hmySigner = types.MakeSigner(hmy.BlockChain.Config(), block.Number())
-		ethSigner = types.NewEIP155Signer(hmy.BlockChain.Config().EthCompatibleChainID)
+		ethSigner = types.NewLondonSigner(hmy.BlockChain.Config().EthCompatibleChainID)
 		txs       = block.Transactions()
 		results   = make([]*TxTraceResult, len(txs))
 	)
@@ -439,7 +447,7 @@ func (hmy *Harmony) TraceBlock(ctx context.Context, block *types.Block, config *
 	// Execute all the transaction contained within the block concurrently
 	var (
 		hmySigner = types.MakeSigner(hmy.BlockChain.Config(), block.Number())
-		ethSigner = types.NewEIP155Signer(hmy.BlockChain.Config().EthCompatibleChainID)
+		ethSigner = types.NewLondonSigner(hmy.BlockChain.Config().EthCompatibleChainID)
 		txs       = block.Transactions()
 		results   = make([]*TxTraceResult, len(txs))
 
@@ -553,7 +561,7 @@ func (hmy *Harmony) standardTraceBlockToFile(ctx context.Context, block *types.B
 	// Execute transaction, either tracing all or just the requested one
 	var (
 		hmySigner = types.MakeSigner(hmy.BlockChain.Config(), block.Number())
-		ethSigner = types.NewEIP155Signer(hmy.BlockChain.Config().EthCompatibleChainID)
+		ethSigner = types.NewLondonSigner(hmy.BlockChain.Config().EthCompatibleChainID)
 		dumps     []string
 	)
 	for i, tx := range block.Transactions() {
@@ -795,7 +803,7 @@ func (hmy *Harmony) ComputeTxEnv(block *types.Block, txIndex int, reexec uint64)
 
 	// Recompute transactions up to the target index.
 	hmySigner := types.MakeSigner(hmy.BlockChain.Config(), block.Number())
-	ethSigner := types.NewEIP155Signer(hmy.BlockChain.Config().EthCompatibleChainID)
+	ethSigner := types.NewLondonSigner(hmy.BlockChain.Config().EthCompatibleChainID)
 
 	for idx, tx := range block.Transactions() {
 		signer := hmySigner
@@ -834,7 +842,7 @@ func (hmy *Harmony) ComputeTxEnvEachBlockWithoutApply(block *types.Block, reexec
 
 	// Recompute transactions up to the target index.
 	hmySigner := types.MakeSigner(hmy.BlockChain.Config(), block.Number())
-	ethSigner := types.NewEIP155Signer(hmy.BlockChain.Config().EthCompatibleChainID)
+	ethSigner := types.NewLondonSigner(hmy.BlockChain.Config().EthCompatibleChainID)
 
 	for idx, tx := range block.Transactions() {
 		signer := hmySigner
@@ -0,0 +1,84 @@
+package tracers
+
+import (
+	"math/big"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/rlp"
+	"github.com/harmony-one/harmony/core/vm"
+)
+
+// InternalTransaction is a call made inside a transaction that moves value or
+// creates a contract
+type InternalTransaction struct {
+	// Type is the lower case opcode of the call, e.g. call, create2 or selfdestruct
+	Type        string         `json:"type"`
+	From        common.Address `json:"from"`
+	To          common.Address `json:"to"`
+	Value       *big.Int       `json:"value"`
+	TxHash      common.Hash    `json:"parentTransactionHash"`
+	BlockNumber uint64         `json:"blockNumber"`
+	TxIndex     uint64         `json:"transactionIndex"`
+	// TraceIndex is the position of the call in the pre-order of the call tree
+	TraceIndex   uint64 `json:"traceIndex"`
+	TraceAddress []uint `json:"traceAddress"`
+	// Depth is the call depth, 1 for the calls made by the transaction itself
+	Depth uint64 `json:"depth"`
+}
+
+// InternalTransactions returns the internal transactions of the block. The
+// top level calls are left out, as well as the calls that were reverted along
+// with any of their ancestors.
+func (ts *TraceBlockStorage) InternalTransactions() ([]*InternalTransaction, error) {
+	var itxs []*InternalTransaction
+	for index, b := range ts.TraceStorages {
+		var txStorage TxStorage
+		if err := rlp.DecodeBytes(b, &txStorage); err != nil {
+			return nil, err
+		}
+		// depth of the outermost reverted call seen so far, -1 if none
+		reverted := -1
+		for traceIndex, acStorage := range txStorage.Storages {
+			depth := len(acStorage.TraceAddress)
+			if reverted >= 0 && depth > reverted {
+				continue
+			}
+			reverted = -1
+
+			ac := &action{}
+			ac.fromStorage(ts, acStorage)
+			if ac.err != nil {
+				reverted = depth
+				continue
+			}
+			if depth == 0 || !ac.movesValue() {
+				continue
+			}
+			itxs = append(itxs, &InternalTransaction{
+				Type:         strings.ToLower(ac.op.String()),
+				From:         ac.from,
+				To:           ac.to,
+				Value:        ac.value,
+				TxHash:       txStorage.Hash,
+				BlockNumber:  ts.Number,
+				TxIndex:      uint64(index),
+				TraceIndex:   uint64(traceIndex),
+				TraceAddress: acStorage.TraceAddress,
+				Depth:        uint64(depth),
+			})
+		}
+	}
+	return itxs, nil
+}
+
+// movesValue returns whether the action transfers value or creates a contract
+func (c *action) movesValue() bool {
+	switch c.op {
+	case vm.CREATE, vm.CREATE2:
+		return true
+	case vm.CALL, vm.CALLCODE, vm.SELFDESTRUCT:
+		return c.value != nil && c.value.Sign() > 0
+	}
+	return false
+}
@@ -0,0 +1,71 @@
+package tracers
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/harmony-one/harmony/core/vm"
+)
+
+func TestInternalTransactions(t *testing.T) {
+	var (
+		sender   = common.HexToAddress("0x01")
+		contract = common.HexToAddress("0x02")
+		created  = common.HexToAddress("0x03")
+		receiver = common.HexToAddress("0x04")
+		txHash   = common.HexToHash("0xaa")
+	)
+	call := func(op vm.OpCode, from, to common.Address, value int64, subCalls ...*action) *action {
+		return &action{op: op, from: from, to: to, value: big.NewInt(value), subCalls: subCalls}
+	}
+	reverted := call(vm.CALL, contract, receiver, 7, call(vm.CALL, receiver, sender, 8))
+	reverted.err = errors.New("Reverted")
+
+	root := call(vm.CALL, sender, contract, 100,
+		call(vm.CALL, contract, receiver, 5),
+		call(vm.STATICCALL, contract, receiver, 0),
+		call(vm.CALL, contract, receiver, 0),
+		call(vm.CREATE2, contract, created, 0,
+			call(vm.SELFDESTRUCT, created, receiver, 3),
+		),
+		reverted,
+		call(vm.DELEGATECALL, contract, receiver, 100),
+	)
+	tracer := &ParityBlockTracer{
+		Number: 10,
+		tracers: []*ParityTxTracer{
+			{transactionHash: common.HexToHash("0xbb"), action: *call(vm.CALL, sender, receiver, 1)},
+			{transactionHash: txHash, action: *root},
+		},
+	}
+	itxs, err := tracer.GetStorage().InternalTransactions()
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []InternalTransaction{
+		{Type: "call", From: contract, To: receiver, Value: big.NewInt(5), TraceIndex: 1, TraceAddress: []uint{0}, Depth: 1},
+		{Type: "create2", From: contract, To: created, Value: big.NewInt(0), TraceIndex: 4, TraceAddress: []uint{3}, Depth: 1},
+		{Type: "selfdestruct", From: created, To: receiver, Value: big.NewInt(3), TraceIndex: 5, TraceAddress: []uint{3, 0}, Depth: 2},
+	}
+	if len(itxs) != len(want) {
+		t.Fatalf("have %d internal transactions, want %d", len(itxs), len(want))
+	}
+	for i, w := range want {
+		have := itxs[i]
+		if have.Type != w.Type || have.From != w.From || have.To != w.To ||
+			have.Value.Cmp(w.Value) != 0 || have.TraceIndex != w.TraceIndex ||
+			have.Depth != w.Depth || len(have.TraceAddress) != len(w.TraceAddress) {
+			t.Fatalf("internal transaction %d: have %+v, want %+v", i, have, w)
+		}
+		for j := range w.TraceAddress {
+			if have.TraceAddress[j] != w.TraceAddress[j] {
+				t.Fatalf("internal transaction %d: have trace address %v, want %v", i, have.TraceAddress, w.TraceAddress)
+			}
+		}
+		if have.TxHash != txHash || have.TxIndex != 1 || have.BlockNumber != 10 {
+			t.Fatalf("internal transaction %d: wrong position %+v", i, have)
+		}
+	}
+}
@@ -0,0 +1,226 @@
+package tracers
+
+import (
+	"math/big"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/harmony-one/harmony/core/vm"
+)
+
+// AccessCounter counts the reads and writes of an account or a storage slot,
+// and the gas charged by the opcodes accessing it.
+type AccessCounter struct {
+	Reads  uint64 `json:"reads"`
+	Writes uint64 `json:"writes"`
+	Gas    uint64 `json:"gas"`
+}
+
+func (c *AccessCounter) add(o AccessCounter) {
+	c.Reads += o.Reads
+	c.Writes += o.Writes
+	c.Gas += o.Gas
+}
+
+// AccountState is the state of an account outside of its storage
+type AccountState struct {
+	Balance  *hexutil.Big   `json:"balance"`
+	Nonce    hexutil.Uint64 `json:"nonce"`
+	CodeHash common.Hash    `json:"codeHash"`
+}
+
+// SlotAccess is the access record of a storage slot
+type SlotAccess struct {
+	AccessCounter
+	Before common.Hash `json:"before"`
+	After  common.Hash `json:"after"`
+}
+
+// AccountAccess is the access record of an account and of its storage slots
+type AccountAccess struct {
+	AccessCounter
+	Before  *AccountState               `json:"before,omitempty"`
+	After   *AccountState               `json:"after,omitempty"`
+	Storage map[common.Hash]*SlotAccess `json:"storage"`
+}
+
+// StateAccessTracer is a tracer recording every account and storage slot read
+// or written during the execution of a message. The gas of an access is the
+// cost of the opcode, except for the calls where the forwarded gas is not
+// counted.
+type StateAccessTracer struct {
+	accounts map[common.Address]*AccountAccess
+}
+
+// NewStateAccessTracer returns a new state access tracer
+func NewStateAccessTracer() *StateAccessTracer {
+	return &StateAccessTracer{
+		accounts: make(map[common.Address]*AccountAccess),
+	}
+}
+
+func (t *StateAccessTracer) account(addr common.Address) *AccountAccess {
+	acc, ok := t.accounts[addr]
+	if !ok {
+		acc = &AccountAccess{Storage: make(map[common.Hash]*SlotAccess)}
+		t.accounts[addr] = acc
+	}
+	return acc
+}
+
+func (t *StateAccessTracer) readAccount(addr common.Address, gas uint64) {
+	acc := t.account(addr)
+	acc.Reads++
+	acc.Gas += gas
+}
+
+func (t *StateAccessTracer) writeAccount(addr common.Address, gas uint64) {
+	acc := t.account(addr)
+	acc.Writes++
+	acc.Gas += gas
+}
+
+func (t *StateAccessTracer) slot(addr common.Address, key common.Hash) *SlotAccess {
+	acc := t.account(addr)
+	slot, ok := acc.Storage[key]
+	if !ok {
+		slot = &SlotAccess{}
+		acc.Storage[key] = slot
+	}
+	return slot
+}
+
+// CaptureStart implements the Tracer interface to record the sender and the
+// recipient of the message.
+func (t *StateAccessTracer) CaptureStart(env *vm.EVM, from common.Address, to common.Address, create bool, input []byte, gas uint64, value *big.Int) error {
+	t.writeAccount(from, 0)
+	if create || value.Sign() > 0 {
+		t.writeAccount(to, 0)
+	} else {
+		t.readAccount(to, 0)
+	}
+	return nil
+}
+
+// CaptureState implements the Tracer interface to record the state accesses
+// of a single step of VM execution.
+func (t *StateAccessTracer) CaptureState(env *vm.EVM, pc uint64, op vm.OpCode, gas, cost uint64, memory *vm.Memory, stack *vm.Stack, contract *vm.Contract, depth int, err error) (vm.HookAfter, error) {
+	if err != nil {
+		return nil, nil
+	}
+	stackPeek := func(n int) *big.Int {
+		if n >= len(stack.Data()) {
+			return new(big.Int)
+		}
+		return stack.Back(n)
+	}
+	switch op {
+	case vm.SLOAD:
+		slot := t.slot(contract.Address(), common.BigToHash(stackPeek(0)))
+		slot.Reads++
+		slot.Gas += cost
+	case vm.SSTORE:
+		slot := t.slot(contract.Address(), common.BigToHash(stackPeek(0)))
+		slot.Writes++
+		slot.Gas += cost
+	case vm.BALANCE, vm.EXTCODESIZE, vm.EXTCODECOPY, vm.EXTCODEHASH:
+		t.readAccount(common.BigToAddress(stackPeek(0)), cost)
+	case vm.SELFBALANCE:
+		t.readAccount(contract.Address(), cost)
+	case vm.CALL, vm.CALLCODE:
+		to := common.BigToAddress(stackPeek(1))
+		if stackPeek(2).Sign() > 0 {
+			t.writeAccount(contract.Address(), 0)
+			t.writeAccount(to, 0)
+		} else {
+			t.readAccount(to, 0)
+		}
+	case vm.DELEGATECALL, vm.STATICCALL:
+		t.readAccount(common.BigToAddress(stackPeek(1)), 0)
+	case vm.SELFDESTRUCT:
+		t.writeAccount(contract.Address(), cost)
+		t.writeAccount(common.BigToAddress(stackPeek(0)), 0)
+	case vm.CREATE, vm.CREATE2:
+		t.writeAccount(contract.Address(), 0)
+		// the address of the new contract is only known after the execution
+		return func(memory *vm.Memory, stack *vm.Stack) {
+			if addr := common.BigToAddress(stackPeek(0)); addr != (common.Address{}) {
+				t.writeAccount(addr, 0)
+			}
+		}, nil
+	}
+	return nil, nil
+}
+
+// CaptureFault implements the Tracer interface, faults are not recorded.
+func (t *StateAccessTracer) CaptureFault(env *vm.EVM, pc uint64, op vm.OpCode, gas, cost uint64, memory *vm.Memory, stack *vm.Stack, contract *vm.Contract, depth int, err error) error {
+	return nil
+}
+
+// CaptureEnd implements the Tracer interface.
+func (t *StateAccessTracer) CaptureEnd(output []byte, gasUsed uint64, d time.Duration, err error) error {
+	return nil
+}
+
+// Accesses returns the accessed accounts and slots, without their values
+func (t *StateAccessTracer) Accesses() map[common.Address]*AccountAccess {
+	return t.accounts
+}
+
+// GetResult returns the accessed accounts and slots with their values in the
+// state before and after the execution.
+func (t *StateAccessTracer) GetResult(pre, post vm.StateDB) map[common.Address]*AccountAccess {
+	for addr, acc := range t.accounts {
+		acc.Before = accountState(pre, addr)
+		acc.After = accountState(post, addr)
+		for key, slot := range acc.Storage {
+			slot.Before = pre.GetState(addr, key)
+			slot.After = post.GetState(addr, key)
+		}
+	}
+	return t.accounts
+}
+
+func accountState(db vm.StateDB, addr common.Address) *AccountState {
+	if !db.Exist(addr) {
+		return nil
+	}
+	return &AccountState{
+		Balance:  (*hexutil.Big)(db.GetBalance(addr)),
+		Nonce:    hexutil.Uint64(db.GetNonce(addr)),
+		CodeHash: db.GetCodeHash(addr),
+	}
+}
+
+// ContractAccessStats aggregates the state accesses of a contract over
+// several transactions.
+type ContractAccessStats struct {
+	AccessCounter
+	Transactions uint64                         `json:"transactions"`
+	Storage      map[common.Hash]*AccessCounter `json:"storage"`
+}
+
+// StateAccessStats aggregates the state accesses per account
+type StateAccessStats map[common.Address]*ContractAccessStats
+
+// Add adds the accesses of a transaction to the stats
+func (s StateAccessStats) Add(accounts map[common.Address]*AccountAccess) {
+	for addr, acc := range accounts {
+		stats, ok := s[addr]
+		if !ok {
+			stats = &ContractAccessStats{Storage: make(map[common.Hash]*AccessCounter)}
+			s[addr] = stats
+		}
+		stats.Transactions++
+		stats.add(acc.AccessCounter)
+		for key, slot := range acc.Storage {
+			counter, ok := stats.Storage[key]
+			if !ok {
+				counter = &AccessCounter{}
+				stats.Storage[key] = counter
+			}
+			counter.add(slot.AccessCounter)
+		}
+	}
+}
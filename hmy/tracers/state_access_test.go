@@ -0,0 +1,58 @@
+package tracers_test
+
+import (
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/harmony-one/harmony/core/rawdb"
+	"github.com/harmony-one/harmony/core/state"
+	"github.com/harmony-one/harmony/core/vm"
+	"github.com/harmony-one/harmony/core/vm/runtime"
+	"github.com/harmony-one/harmony/hmy/tracers"
+)
+
+func TestStateAccessTracer(t *testing.T) {
+	var (
+		contract = common.BytesToAddress([]byte("contract"))
+		slot1    = common.HexToHash("0x01")
+		slot2    = common.HexToHash("0x02")
+		// SLOAD(1); SSTORE(2, 5)
+		code = common.Hex2Bytes("600154506005600255")
+	)
+	statedb, _ := state.New(common.Hash{}, state.NewDatabase(rawdb.NewMemoryDatabase()), nil)
+	pre := statedb.Copy()
+	tracer := tracers.NewStateAccessTracer()
+	_, _, err := runtime.Execute(code, nil, &runtime.Config{
+		State:     statedb,
+		EVMConfig: vm.Config{Debug: true, Tracer: tracer},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	accounts := tracer.GetResult(pre, statedb)
+	acc, ok := accounts[contract]
+	if !ok {
+		t.Fatal("contract access not recorded")
+	}
+	if acc.Before != nil || acc.After == nil {
+		t.Fatalf("unexpected account states before %v after %v", acc.Before, acc.After)
+	}
+	read, written := acc.Storage[slot1], acc.Storage[slot2]
+	if read == nil || read.Reads != 1 || read.Writes != 0 || read.Gas == 0 {
+		t.Fatalf("unexpected read slot access %+v", read)
+	}
+	if written == nil || written.Reads != 0 || written.Writes != 1 || written.Gas == 0 {
+		t.Fatalf("unexpected written slot access %+v", written)
+	}
+	if written.Before != (common.Hash{}) || written.After != common.HexToHash("0x05") {
+		t.Fatalf("have slot values %s -> %s, want 0x0 -> 0x5", written.Before.Hex(), written.After.Hex())
+	}
+
+	stats := make(tracers.StateAccessStats)
+	stats.Add(accounts)
+	stats.Add(accounts)
+	if s := stats[contract]; s.Transactions != 2 || s.Storage[slot1].Reads != 2 || s.Storage[slot2].Writes != 2 {
+		t.Fatalf("unexpected aggregated stats %+v", s)
+	}
+}
@@ -8,6 +8,9 @@ import (
 	"github.com/harmony-one/harmony/core/rawdb"
 	"github.com/harmony-one/harmony/core/types"
 	"github.com/harmony-one/harmony/eth/rpc"
+	"github.com/harmony-one/harmony/hmy/tokens"
+	"github.com/harmony-one/harmony/hmy/tracers"
+	commonRPC "github.com/harmony-one/harmony/rpc/harmony/common"
 )
 
 // SendTx ...
@@ -19,6 +22,21 @@ func (hmy *Harmony) SendTx(ctx context.Context, signedTx *types.Transaction) err
 	return ErrFinalizedTransaction
 }
 
+// SendPrivateTx sends the transaction to the current and next leaders only, see
+// NodeAPI.AddPendingPrivateTransaction
+func (hmy *Harmony) SendPrivateTx(ctx context.Context, signedTx *types.Transaction, expiryBlock uint64) error {
+	tx, _, _, _ := rawdb.ReadTransaction(hmy.chainDb, signedTx.Hash())
+	if tx == nil {
+		return hmy.NodeAPI.AddPendingPrivateTransaction(signedTx, expiryBlock)
+	}
+	return ErrFinalizedTransaction
+}
+
+// GetPrivateTxStatus returns the status of a private transaction submitted through this node
+func (hmy *Harmony) GetPrivateTxStatus(hash common.Hash) (*commonRPC.PrivateTxStatus, error) {
+	return hmy.NodeAPI.GetPrivateTransactionStatus(hash)
+}
+
 // ResendCx retrieve blockHash from txID and add blockHash to CxPool for resending
 // Note that cross shard txn is only for regular txns, not for staking txns, so the input txn hash
 // is expected to be regular txn hash
@@ -59,6 +77,21 @@ func (hmy *Harmony) GetTransactionsHistory(address, txType, order string) ([]com
 	return hmy.NodeAPI.GetTransactionsHistory(address, txType, order)
 }
 
+// GetTokenTransfersByAddress returns a page of the token transfers of address.
+func (hmy *Harmony) GetTokenTransfersByAddress(address string, pageIndex, pageSize uint32, order string) ([]*tokens.Transfer, error) {
+	return hmy.NodeAPI.GetTokenTransfersByAddress(address, pageIndex, pageSize, order)
+}
+
+// GetTokenTransfersByContract returns a page of the token transfers of the token contract.
+func (hmy *Harmony) GetTokenTransfersByContract(address string, pageIndex, pageSize uint32, order string) ([]*tokens.Transfer, error) {
+	return hmy.NodeAPI.GetTokenTransfersByContract(address, pageIndex, pageSize, order)
+}
+
+// GetInternalTransactionsHistory returns a page of the internal transactions of address.
+func (hmy *Harmony) GetInternalTransactionsHistory(address string, pageIndex, pageSize uint32, order string) ([]*tracers.InternalTransaction, error) {
+	return hmy.NodeAPI.GetInternalTransactionsHistory(address, pageIndex, pageSize, order)
+}
+
 // GetAccountNonce returns the nonce value of the given address for the given block number
 func (hmy *Harmony) GetAccountNonce(
 	ctx context.Context, address common.Address, blockNum rpc.BlockNumber) (uint64, error) {
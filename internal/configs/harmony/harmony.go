@@ -2,6 +2,7 @@ package harmony
 
 import (
 	"fmt"
+	"path/filepath"
 	"reflect"
 	"strings"
 	"time"
@@ -76,11 +77,17 @@ func (hc HarmonyConfig) ToRPCServerConfig() nodeconfig.RPCServerConfig {
 			Dur("updated", evmCallTimeout).
 			Msg("Sanitizing invalid evm_call timeout")
 	}
+	// a relative IPC path is placed in the data directory
+	ipcPath := hc.RPCOpt.IPCPath
+	if ipcPath != "" && !filepath.IsAbs(ipcPath) && !strings.HasPrefix(ipcPath, `\\.\pipe\`) {
+		ipcPath = filepath.Join(hc.General.DataDir, ipcPath)
+	}
 	return nodeconfig.RPCServerConfig{
 		HTTPEnabled:        hc.HTTP.Enabled,
 		HTTPIp:             hc.HTTP.IP,
 		HTTPPort:           hc.HTTP.Port,
 		HTTPAuthPort:       hc.HTTP.AuthPort,
+		HTTPAuthTokenFile:  hc.HTTP.AuthTokenFile,
 		HTTPTimeoutRead:    readTimeout,
 		HTTPTimeoutWrite:   writeTimeout,
 		HTTPTimeoutIdle:    idleTimeout,
@@ -97,6 +104,7 @@ func (hc HarmonyConfig) ToRPCServerConfig() nodeconfig.RPCServerConfig {
 		RateLimiterEnabled: hc.RPCOpt.RateLimterEnabled,
 		RequestsPerSecond:  hc.RPCOpt.RequestsPerSecond,
 		EvmCallTimeout:     evmCallTimeout,
+		IPCPath:            ipcPath,
 	}
 }
 
@@ -159,6 +167,7 @@ type GeneralConfig struct {
 	DataDir                string
 	TraceEnable            bool
 	EnablePruneBeaconChain bool
+	EnablePruneState       bool
 	RunElasticMode         bool
 }
 
@@ -203,6 +212,10 @@ type GasPriceOracleConfig struct {
 type ConsensusConfig struct {
 	MinPeers     int
 	AggregateSig bool
+	EnableWAL    bool `toml:",omitempty"`
+	// EnableSlashingProtection refuses to sign messages conflicting with the
+	// signing history of the local keys
+	EnableSlashingProtection bool `toml:",omitempty"`
 }
 
 type LocalnetConfig struct {
@@ -298,6 +311,9 @@ type HttpConfig struct {
 	ReadTimeout    string
 	WriteTimeout   string
 	IdleTimeout    string
+	// AuthTokenFile holds the bearer token required on the auth port, the admin
+	// namespace is only served on the auth port with a token
+	AuthTokenFile string `toml:",omitempty"`
 }
 
 type WsConfig struct {
@@ -317,6 +333,7 @@ type RpcOptConfig struct {
 	RequestsPerSecond  int    // for RPC rate limiter
 	EvmCallTimeout     string // Timeout for eth_call
 	PreimagesEnabled   bool   // Expose preimage API
+	IPCPath            string `toml:",omitempty"` // IPC socket serving the auth & admin APIs
 }
 
 type DevnetConfig struct {
@@ -379,6 +396,7 @@ type SyncConfig struct {
 	DiscHardLowCap       int              // when removing stream, num is below this value, spin discovery immediately
 	DiscHighCap          int              // upper limit of streams in one sync protocol
 	DiscBatch            int              // size of each discovery
+	Checkpoint           string           `toml:",omitempty"` // trusted beacon chain checkpoint to start the sync from, in epoch:hash format
 }
 
 type StagedSyncConfig struct {
@@ -68,6 +68,39 @@ func TestToRPCServerConfig(t *testing.T) {
 				EvmCallTimeout:     5 * time.Second,
 			},
 		},
+		{
+			input: HarmonyConfig{
+				General: GeneralConfig{
+					DataDir: "./data",
+				},
+				HTTP: HttpConfig{
+					Enabled:       true,
+					IP:            "127.0.0.1",
+					Port:          nodeconfig.DefaultRPCPort,
+					AuthPort:      nodeconfig.DefaultAuthRPCPort,
+					ReadTimeout:   "10s",
+					WriteTimeout:  "20s",
+					IdleTimeout:   "30s",
+					AuthTokenFile: "./.hmy/auth_token",
+				},
+				RPCOpt: RpcOptConfig{
+					EvmCallTimeout: "1s",
+					IPCPath:        "harmony.ipc",
+				},
+			},
+			output: nodeconfig.RPCServerConfig{
+				HTTPEnabled:       true,
+				HTTPIp:            "127.0.0.1",
+				HTTPPort:          nodeconfig.DefaultRPCPort,
+				HTTPAuthPort:      nodeconfig.DefaultAuthRPCPort,
+				HTTPAuthTokenFile: "./.hmy/auth_token",
+				HTTPTimeoutRead:   10 * time.Second,
+				HTTPTimeoutWrite:  20 * time.Second,
+				HTTPTimeoutIdle:   30 * time.Second,
+				EvmCallTimeout:    1 * time.Second,
+				IPCPath:           "data/harmony.ipc",
+			},
+		},
 	}
 	for i, tt := range tests {
 		assertObject := assert.New(t)
@@ -142,6 +142,8 @@ type RPCServerConfig struct {
 	HTTPPort     int
 	HTTPAuthPort int
 
+	HTTPAuthTokenFile string
+
 	HTTPTimeoutRead  time.Duration
 	HTTPTimeoutWrite time.Duration
 	HTTPTimeoutIdle  time.Duration
@@ -164,6 +166,8 @@ type RPCServerConfig struct {
 	RequestsPerSecond  int
 
 	EvmCallTimeout time.Duration
+
+	IPCPath string
 }
 
 // RosettaServerConfig is the config for the rosetta server
@@ -0,0 +1,61 @@
+package shardingconfig
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	ethCommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/pkg/errors"
+)
+
+// Checkpoint is a trusted last beacon chain block of an epoch. The stream sync
+// of the beacon chain starts from the checkpoint and only accepts the epoch
+// blocks descending from it.
+type Checkpoint struct {
+	Epoch uint64
+	Hash  ethCommon.Hash
+}
+
+// String returns the checkpoint in the `epoch:hash` format
+func (cp Checkpoint) String() string {
+	return fmt.Sprintf("%d:%s", cp.Epoch, cp.Hash.Hex())
+}
+
+// ParseCheckpoint parses a checkpoint in the `epoch:hash` format
+func ParseCheckpoint(s string) (*Checkpoint, error) {
+	parts := strings.Split(strings.TrimSpace(s), ":")
+	if len(parts) != 2 {
+		return nil, errors.Errorf("invalid checkpoint %q, expect epoch:hash", s)
+	}
+	epoch, err := strconv.ParseUint(parts[0], 10, 64)
+	if err != nil {
+		return nil, errors.Wrapf(err, "invalid checkpoint epoch %q", parts[0])
+	}
+	b, err := hexutil.Decode(parts[1])
+	if err != nil || len(b) != ethCommon.HashLength {
+		return nil, errors.Errorf("invalid checkpoint hash %q", parts[1])
+	}
+	return &Checkpoint{Epoch: epoch, Hash: ethCommon.BytesToHash(b)}, nil
+}
+
+// trustedCheckpoints are the built-in checkpoints of each network, in the
+// ascending order of epochs. New checkpoints are appended at each release with
+// the output of `harmony checkpoint --network <network> <datadir>` run against
+// the synced beacon chain db of a trusted node.
+var trustedCheckpoints = map[NetworkID][]Checkpoint{
+	MainNet: {},
+	TestNet: {},
+}
+
+// LatestCheckpoint returns the latest built-in checkpoint of the network,
+// nil if the network has none.
+func LatestCheckpoint(id NetworkID) *Checkpoint {
+	cps := trustedCheckpoints[id]
+	if len(cps) == 0 {
+		return nil
+	}
+	cp := cps[len(cps)-1]
+	return &cp
+}
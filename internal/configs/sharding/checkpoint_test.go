@@ -0,0 +1,55 @@
+package shardingconfig
+
+import (
+	"testing"
+
+	ethCommon "github.com/ethereum/go-ethereum/common"
+)
+
+func TestParseCheckpoint(t *testing.T) {
+	hash := ethCommon.HexToHash("0x5e1b0d5c4e1dbc6a6a3a0cc1cb6a4ca8d2bfa2e11d2c9f1c5e4b3a2d1c0b9a87")
+	tests := []struct {
+		input string
+		exp   *Checkpoint
+		isErr bool
+	}{
+		{"1200:" + hash.Hex(), &Checkpoint{Epoch: 1200, Hash: hash}, false},
+		{" 0:" + hash.Hex() + " ", &Checkpoint{Epoch: 0, Hash: hash}, false},
+		{hash.Hex(), nil, true},
+		{"abc:" + hash.Hex(), nil, true},
+		{"1200:" + hash.Hex()[:20], nil, true},
+		{"1200:" + hash.Hex()[2:], nil, true},
+		{"1200:" + hash.Hex() + ":1", nil, true},
+	}
+	for i, test := range tests {
+		cp, err := ParseCheckpoint(test.input)
+		if (err != nil) != test.isErr {
+			t.Fatalf("Test %v: unexpected error %v", i, err)
+		}
+		if err != nil {
+			continue
+		}
+		if *cp != *test.exp {
+			t.Errorf("Test %v: unexpected checkpoint %v / %v", i, cp, test.exp)
+		}
+		if cp.String() != test.exp.String() {
+			t.Errorf("Test %v: unexpected string %v", i, cp.String())
+		}
+	}
+}
+
+func TestLatestCheckpoint(t *testing.T) {
+	if cp := LatestCheckpoint(LocalNet); cp != nil {
+		t.Errorf("unexpected localnet checkpoint %v", cp)
+	}
+	for id, cps := range trustedCheckpoints {
+		for i := 1; i < len(cps); i++ {
+			if cps[i].Epoch <= cps[i-1].Epoch {
+				t.Errorf("checkpoints of network %v are not in order of epochs", id)
+			}
+		}
+		if len(cps) > 0 && *LatestCheckpoint(id) != cps[len(cps)-1] {
+			t.Errorf("unexpected latest checkpoint of network %v", id)
+		}
+	}
+}
@@ -81,6 +81,12 @@ var (
 		DevnetExternalEpoch:                   EpochTBD,
 		TestnetExternalEpoch:                  EpochTBD,
 		HIP32Epoch:                            big.NewInt(2152), // 2024-10-31 13:02 UTC
+		BerlinEpoch:                           EpochTBD,
+		LondonEpoch:                           EpochTBD,
+		ShanghaiEpoch:                         EpochTBD,
+		CancunEpoch:                           EpochTBD,
+		EIP3860Epoch:                          EpochTBD,
+		EIP6780Epoch:                          EpochTBD,
 	}
 
 	// TestnetChainConfig contains the chain parameters to run a node on the harmony test network.
@@ -129,6 +135,12 @@ var (
 		MaxRateEpoch:                          big.NewInt(2520), // 2023-12-16 12:17:14+00:00
 		DevnetExternalEpoch:                   EpochTBD,
 		TestnetExternalEpoch:                  big.NewInt(3044),
+		BerlinEpoch:                           EpochTBD,
+		LondonEpoch:                           EpochTBD,
+		ShanghaiEpoch:                         EpochTBD,
+		CancunEpoch:                           EpochTBD,
+		EIP3860Epoch:                          EpochTBD,
+		EIP6780Epoch:                          EpochTBD,
 	}
 	// PangaeaChainConfig contains the chain parameters for the Pangaea network.
 	// All features except for CrossLink are enabled at launch.
@@ -177,6 +189,12 @@ var (
 		MaxRateEpoch:                          EpochTBD,
 		DevnetExternalEpoch:                   EpochTBD,
 		TestnetExternalEpoch:                  EpochTBD,
+		BerlinEpoch:                           EpochTBD,
+		LondonEpoch:                           EpochTBD,
+		ShanghaiEpoch:                         EpochTBD,
+		CancunEpoch:                           EpochTBD,
+		EIP3860Epoch:                          EpochTBD,
+		EIP6780Epoch:                          EpochTBD,
 	}
 
 	// PartnerChainConfig contains the chain parameters for the Partner network.
@@ -226,6 +244,12 @@ var (
 		MaxRateEpoch:                          EpochTBD,
 		TestnetExternalEpoch:                  EpochTBD,
 		DevnetExternalEpoch:                   big.NewInt(144),
+		BerlinEpoch:                           EpochTBD,
+		LondonEpoch:                           EpochTBD,
+		ShanghaiEpoch:                         EpochTBD,
+		CancunEpoch:                           EpochTBD,
+		EIP3860Epoch:                          EpochTBD,
+		EIP6780Epoch:                          EpochTBD,
 	}
 
 	// StressnetChainConfig contains the chain parameters for the Stress test network.
@@ -275,6 +299,12 @@ var (
 		MaxRateEpoch:                          EpochTBD,
 		DevnetExternalEpoch:                   EpochTBD,
 		TestnetExternalEpoch:                  EpochTBD,
+		BerlinEpoch:                           EpochTBD,
+		LondonEpoch:                           EpochTBD,
+		ShanghaiEpoch:                         EpochTBD,
+		CancunEpoch:                           EpochTBD,
+		EIP3860Epoch:                          EpochTBD,
+		EIP6780Epoch:                          EpochTBD,
 	}
 
 	// LocalnetChainConfig contains the chain parameters to run for local development.
@@ -323,6 +353,12 @@ var (
 		MaxRateEpoch:                          EpochTBD,
 		DevnetExternalEpoch:                   EpochTBD,
 		TestnetExternalEpoch:                  EpochTBD,
+		BerlinEpoch:                           big.NewInt(0),
+		LondonEpoch:                           big.NewInt(3),
+		ShanghaiEpoch:                         EpochTBD,
+		CancunEpoch:                           EpochTBD,
+		EIP3860Epoch:                          EpochTBD,
+		EIP6780Epoch:                          EpochTBD,
 	}
 
 	// AllProtocolChanges ...
@@ -374,6 +410,12 @@ var (
 		big.NewInt(0),
 		big.NewInt(0),
 		big.NewInt(0),
+		big.NewInt(0), // BerlinEpoch
+		EpochTBD,      // LondonEpoch
+		EpochTBD,      // ShanghaiEpoch
+		EpochTBD,      // CancunEpoch
+		EpochTBD,      // EIP3860Epoch
+		EpochTBD,      // EIP6780Epoch
 	}
 
 	// TestChainConfig ...
@@ -425,6 +467,12 @@ var (
 		big.NewInt(0),        // MaxRateEpoch
 		big.NewInt(0),
 		big.NewInt(0),
+		big.NewInt(0), // BerlinEpoch
+		EpochTBD,      // LondonEpoch
+		EpochTBD,      // ShanghaiEpoch
+		EpochTBD,      // CancunEpoch
+		EpochTBD,      // EIP3860Epoch
+		EpochTBD,      // EIP6780Epoch
 	}
 
 	// TestRules ...
@@ -606,6 +654,28 @@ type ChainConfig struct {
 	// vote power feature  https://github.com/harmony-one/harmony/pull/4683
 	// if crosslink are not sent for an entire epoch signed and toSign will be 0 and 0. when that happen, next epoch there will no shard 1 validator elected in the committee.
 	HIP32Epoch *big.Int `json:"hip32-epoch,omitempty"`
+
+	// BerlinEpoch is the first epoch to support EIP-2930 access list transactions
+	// and the EIP-2929 gas cost increases for state access opcodes
+	BerlinEpoch *big.Int `json:"berlin-epoch,omitempty"`
+
+	// LondonEpoch is the first epoch to carry the EIP-1559 base fee in the block
+	// header and to accept dynamic fee transactions
+	LondonEpoch *big.Int `json:"london-epoch,omitempty"`
+
+	// ShanghaiEpoch is the first epoch to support the EIP-3855 PUSH0 opcode
+	ShanghaiEpoch *big.Int `json:"shanghai-epoch,omitempty"`
+
+	// CancunEpoch is the first epoch to support the EIP-5656 MCOPY opcode and
+	// the EIP-1153 TLOAD and TSTORE transient storage opcodes
+	CancunEpoch *big.Int `json:"cancun-epoch,omitempty"`
+
+	// EIP3860Epoch is the first epoch to limit and meter contract initcode
+	EIP3860Epoch *big.Int `json:"eip3860-epoch,omitempty"`
+
+	// EIP6780Epoch is the first epoch to restrict SELFDESTRUCT to contracts
+	// created in the same transaction
+	EIP6780Epoch *big.Int `json:"eip6780-epoch,omitempty"`
 }
 
 // String implements the fmt.Stringer interface.
@@ -674,6 +744,14 @@ func (c *ChainConfig) mustValid() {
 	// max rate (7%) fix is applied on or after hip30
 	require(c.MaxRateEpoch.Cmp(c.HIP30Epoch) >= 0,
 		"must satisfy: MaxRateEpoch >= HIP30Epoch")
+	// dynamic fee transactions extend the typed transaction envelope
+	require(c.LondonEpoch.Cmp(c.BerlinEpoch) >= 0,
+		"must satisfy: LondonEpoch >= BerlinEpoch")
+	// each instruction set extends the previous one
+	require(c.ShanghaiEpoch.Cmp(c.LondonEpoch) >= 0,
+		"must satisfy: ShanghaiEpoch >= LondonEpoch")
+	require(c.CancunEpoch.Cmp(c.ShanghaiEpoch) >= 0,
+		"must satisfy: CancunEpoch >= ShanghaiEpoch")
 }
 
 // IsEIP155 returns whether epoch is either equal to the EIP155 fork epoch or greater.
@@ -869,6 +947,36 @@ func (c *ChainConfig) IsHIP32(epoch *big.Int) bool {
 	return isForked(c.HIP32Epoch, epoch)
 }
 
+// IsBerlin returns whether epoch is either equal to the Berlin fork epoch or greater.
+func (c *ChainConfig) IsBerlin(epoch *big.Int) bool {
+	return isForked(c.BerlinEpoch, epoch)
+}
+
+// IsLondon returns whether epoch is either equal to the London fork epoch or greater.
+func (c *ChainConfig) IsLondon(epoch *big.Int) bool {
+	return isForked(c.LondonEpoch, epoch)
+}
+
+// IsShanghai returns whether epoch is either equal to the Shanghai fork epoch or greater.
+func (c *ChainConfig) IsShanghai(epoch *big.Int) bool {
+	return isForked(c.ShanghaiEpoch, epoch)
+}
+
+// IsCancun returns whether epoch is either equal to the Cancun fork epoch or greater.
+func (c *ChainConfig) IsCancun(epoch *big.Int) bool {
+	return isForked(c.CancunEpoch, epoch)
+}
+
+// IsEIP3860 returns whether epoch is either equal to the EIP-3860 fork epoch or greater.
+func (c *ChainConfig) IsEIP3860(epoch *big.Int) bool {
+	return isForked(c.EIP3860Epoch, epoch)
+}
+
+// IsEIP6780 returns whether epoch is either equal to the EIP-6780 fork epoch or greater.
+func (c *ChainConfig) IsEIP6780(epoch *big.Int) bool {
+	return isForked(c.EIP6780Epoch, epoch)
+}
+
 func (c *ChainConfig) IsHIP30(epoch *big.Int) bool {
 	return isForked(c.HIP30Epoch, epoch)
 }
@@ -953,6 +1061,18 @@ type Rules struct {
 	// eip-155 chain id fix
 	IsChainIdFix bool
 	IsValidatorCodeFix bool
+	// eip-2929 and eip-2930
+	IsBerlin bool
+	// eip-1559 and eip-3198
+	IsLondon bool
+	// eip-3855
+	IsShanghai bool
+	// eip-1153 and eip-5656
+	IsCancun bool
+	// initcode limit and metering
+	IsEIP3860 bool
+	// selfdestruct only in the creating transaction
+	IsEIP6780 bool
 }
 
 // Rules ensures c's ChainID is not nil.
@@ -978,5 +1098,11 @@ func (c *ChainConfig) Rules(epoch *big.Int) Rules {
 		IsCrossShardXferPrecompile: c.IsCrossShardXferPrecompile(epoch),
 		IsChainIdFix:               c.IsChainIdFix(epoch),
 		IsValidatorCodeFix:         c.IsValidatorCodeFix(epoch),
+		IsBerlin:                   c.IsBerlin(epoch),
+		IsLondon:                   c.IsLondon(epoch),
+		IsShanghai:                 c.IsShanghai(epoch),
+		IsCancun:                   c.IsCancun(epoch),
+		IsEIP3860:                  c.IsEIP3860(epoch),
+		IsEIP6780:                  c.IsEIP6780(epoch),
 	}
 }
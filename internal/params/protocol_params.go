@@ -86,6 +86,27 @@ const (
 	// SstoreClearRefundEIP2200 ...
 	SstoreClearRefundEIP2200 uint64 = 15000 // Once per SSTORE operation for clearing an originally existing storage slot
 
+	// ColdAccountAccessCostEIP2929 ...
+	ColdAccountAccessCostEIP2929 uint64 = 2600 // COLD_ACCOUNT_ACCESS_COST
+	// ColdSloadCostEIP2929 ...
+	ColdSloadCostEIP2929 uint64 = 2100 // COLD_SLOAD_COST
+	// WarmStorageReadCostEIP2929 ...
+	WarmStorageReadCostEIP2929 uint64 = 100 // WARM_STORAGE_READ_COST
+
+	// TxAccessListAddressGas ...
+	TxAccessListAddressGas uint64 = 2400 // Per address specified in EIP 2930 access list
+	// TxAccessListStorageKeyGas ...
+	TxAccessListStorageKeyGas uint64 = 1900 // Per storage key specified in EIP 2930 access list
+
+	// BaseFeeChangeDenominator ...
+	BaseFeeChangeDenominator uint64 = 8 // Bounds the amount the base fee can change between blocks.
+	// ElasticityMultiplier ...
+	ElasticityMultiplier uint64 = 2 // Bounds the maximum gas limit an EIP-1559 block may have.
+	// InitialBaseFee ...
+	InitialBaseFee uint64 = 100e9 // Base fee of the first London block, equal to the minimum gas price of 100 gwei.
+	// MinimumBaseFee ...
+	MinimumBaseFee uint64 = 100e9 // The base fee never drops below the minimum gas price accepted by the network.
+
 	// JumpdestGas ...
 	JumpdestGas uint64 = 1 // Refunded gas, once per SSTORE operation if the zeroness changes to zero.
 	// EpochDuration ...
@@ -152,6 +173,10 @@ const (
 
 	// MaxCodeSize ...
 	MaxCodeSize = 24576 // Maximum bytecode to permit for a contract
+	// MaxInitCodeSize ...
+	MaxInitCodeSize = 2 * MaxCodeSize // Maximum initcode to permit in a creation transaction and create instructions (EIP-3860)
+	// InitCodeWordGas ...
+	InitCodeWordGas uint64 = 2 // Once per word of the init code when creating a contract (EIP-3860)
 
 	// Precompiled contract gas prices
 
@@ -37,6 +37,12 @@ func (f *LDBFactory) NewChainDB(shardID uint32) (ethdb.Database, error) {
 	return rawdb.NewLevelDBDatabase(dir, 256, 1024, "", false)
 }
 
+// StateBloomDir returns the directory in which the state pruning of the given
+// shard keeps its bloom filter.
+func (f *LDBFactory) StateBloomDir(shardID uint32) string {
+	return path.Join(f.RootDir, fmt.Sprintf("%s_%d_statebloom", LDBDirPrefix, shardID))
+}
+
 // MemDBFactory is a memory-backed blockchain database factory.
 type MemDBFactory struct{}
 
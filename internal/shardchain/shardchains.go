@@ -2,9 +2,11 @@ package shardchain
 
 import (
 	"math/big"
+	"os"
 	"sync"
 
 	"github.com/harmony-one/harmony/core/state"
+	"github.com/harmony-one/harmony/core/state/pruner"
 	harmonyconfig "github.com/harmony-one/harmony/internal/configs/harmony"
 	"github.com/harmony-one/harmony/internal/shardchain/tikv_manage"
 
@@ -98,6 +100,16 @@ func (sc *CollectionImpl) ShardChain(shardID uint32, options ...core.Options) (c
 			return nil, errors.Wrapf(err, "cannot initialize a new chain database")
 		}
 	}
+	opts := core.Options{}
+	if len(options) == 1 {
+		opts = options[0]
+	}
+	// the epoch chain keeps no state to prune
+	if !opts.EpochChain {
+		if err := sc.pruneState(db, shardID); err != nil {
+			return nil, err
+		}
+	}
 	var cacheConfig *core.CacheConfig
 	// archival node
 	if sc.disableCache[shardID] {
@@ -131,10 +143,6 @@ func (sc *CollectionImpl) ShardChain(shardID uint32, options ...core.Options) (c
 		// For beacon chain inside a shard chain, need to reset the eth chainID to shard 0's eth chainID in the config
 		chainConfig.EthCompatibleChainID = big.NewInt(chainConfig.EthCompatibleShard0ChainID.Int64())
 	}
-	opts := core.Options{}
-	if len(options) == 1 {
-		opts = options[0]
-	}
 	var bc core.BlockChain
 	if opts.EpochChain {
 		bc, err = core.NewEpochChain(db, &chainConfig, sc.engine, vm.Config{})
@@ -173,6 +181,54 @@ func (sc *CollectionImpl) ShardChain(shardID uint32, options ...core.Options) (c
 	return bc, nil
 }
 
+// pruneState resumes an interrupted state pruning of the shard, then prunes
+// the stale state if enabled. The pruning is done once at startup, before the
+// chain is loaded, as the pruner must be the only user of the database. The
+// state is not pruned while the node is running, so a long running node has to
+// be restarted to prune again. Only the LDBFactory chain database keeps the
+// bloom filter next to it, other databases fail when the pruning is enabled.
+func (sc *CollectionImpl) pruneState(db ethdb.Database, shardID uint32) error {
+	enabled := sc.harmonyconfig != nil && sc.harmonyconfig.General.EnablePruneState
+	factory, ok := sc.dbFactory.(*LDBFactory)
+	if !ok {
+		if enabled {
+			return errors.Errorf("state pruning is not supported by the %T chain database", sc.dbFactory)
+		}
+		return nil
+	}
+	bloomDir := factory.StateBloomDir(shardID)
+	// the bloom filter directory only exists once a pruning was started
+	if _, err := os.Stat(bloomDir); err == nil {
+		if err := pruner.RecoverPruning(bloomDir, db); err != nil {
+			return errors.Wrap(err, "cannot recover state pruning")
+		}
+	}
+	if !enabled {
+		return nil
+	}
+	if sc.disableCache[shardID] {
+		utils.Logger().Warn().
+			Uint32("shardID", shardID).
+			Msg("state pruning is not available in archival mode")
+		return nil
+	}
+	p, err := pruner.NewPruner(db, pruner.Config{
+		Datadir:   bloomDir,
+		BloomSize: pruner.DefaultBloomSize,
+	})
+	if err == nil {
+		err = p.Prune(common.Hash{})
+	}
+	if err != nil {
+		// nothing is deleted before the bloom filter is committed, after
+		// that the pruning is resumed at the next start
+		utils.Logger().Warn().Err(err).
+			Uint32("shardID", shardID).
+			Msg("state pruning skipped")
+	}
+	return nil
+}
+
 func initStateCache(db ethdb.Database, sc *CollectionImpl, shardID uint32) (state.Database, error) {
 	if sc.harmonyconfig != nil && sc.harmonyconfig.General.RunElasticMode {
 		// used for tikv mode, init state db using tikv storage
@@ -1,6 +1,9 @@
 package node
 
 import (
+	"time"
+
+	"github.com/harmony-one/harmony/core"
 	"github.com/harmony-one/harmony/core/types"
 	"github.com/harmony-one/harmony/eth/rpc"
 	"github.com/harmony-one/harmony/hmy"
@@ -10,6 +13,7 @@ import (
 	rpc_common "github.com/harmony-one/harmony/rpc/harmony/common"
 	"github.com/harmony-one/harmony/rpc/harmony/filters"
 	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/pkg/errors"
 )
 
 // IsCurrentlyLeader exposes if node is currently the leader node
@@ -37,6 +41,35 @@ func (node *Node) ListBlockedPeer() []peer.ID {
 	return node.host.ListBlockedPeer()
 }
 
+// AddPeerByAddress connects to the peer of the given multiaddress
+func (node *Node) AddPeerByAddress(addr string) error {
+	return node.host.AddPeerByAddress(addr)
+}
+
+// RemovePeer disconnects the peer and forgets its addresses
+func (node *Node) RemovePeer(id peer.ID) error {
+	return node.host.RemovePeer(id)
+}
+
+// BlockPeer disconnects the peer and refuses its connections for the given duration
+func (node *Node) BlockPeer(id peer.ID, duration time.Duration) error {
+	return node.host.BlockPeer(id, time.Now().Add(duration))
+}
+
+// ReloadTxPoolFilters reads the tx pool blacklist and allowed transactions files
+// again and applies them to the tx pool, returns the number of evicted transactions
+func (node *Node) ReloadTxPoolFilters() (int, error) {
+	blacklist, err := core.ReadBlacklistFile(node.HarmonyConfig.TxPool.BlacklistFile)
+	if err != nil {
+		return 0, errors.Wrap(err, "cannot read blacklist file")
+	}
+	allowedTxs, err := core.ReadAllowedTxsFile(node.HarmonyConfig.TxPool.AllowedTxsFile)
+	if err != nil {
+		return 0, errors.Wrap(err, "cannot read allowed txs file")
+	}
+	return len(node.TxPool.SetTxFilters(blacklist, allowedTxs)), nil
+}
+
 // PendingCXReceipts returns node.pendingCXReceiptsProof
 func (node *Node) PendingCXReceipts() []*types.CXReceiptsProof {
 	return node.Consensus.PendingCXReceipts()
@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"math/big"
 	"os"
+	"path/filepath"
 	"runtime/pprof"
 	"sync"
 	"time"
@@ -129,6 +130,9 @@ type Node struct {
 	deciderCache   *lru.Cache
 	committeeCache *lru.Cache
 
+	// private transactions sent to or received from the leaders
+	privateTxs *privateTxService
+
 	Metrics metrics.Registry
 
 	// context control for pub-sub handling
@@ -622,6 +626,10 @@ var (
 func (node *Node) StartPubSub() error {
 	node.psCtx, node.psCancel = context.WithCancel(context.Background())
 
+	if !node.NodeConfig.IsOffline {
+		node.startPrivateTxService()
+	}
+
 	// groupID and whether this topic is used for consensus
 	type t struct {
 		tp    nodeconfig.GroupID
@@ -756,6 +764,7 @@ func (node *Node) StartPubSub() error {
 					if ignore {
 						return libp2p_pubsub.ValidationAccept
 					}
+					node.recordCommitteePeer(*senderPubKey, msg.GetFrom())
 
 					msg.ValidatorData = validated{
 						peerID:         peer,
@@ -1047,6 +1056,7 @@ func New(
 		txPoolConfig.Blacklist = blacklist
 		txPoolConfig.AllowedTxs = allowedTxs
 		txPoolConfig.Journal = fmt.Sprintf("%v/%v", node.NodeConfig.DBDir, txPoolConfig.Journal)
+		txPoolConfig.Snapshot = filepath.Join(node.NodeConfig.DBDir, core.TxPoolSnapshotFile)
 		txPoolConfig.AddEvent = func(tx types.PoolTransaction, local bool) {
 			// in tikv mode, writer will publish tx pool update to all reader
 			if node.Blockchain().IsTikvWriterMaster() {
@@ -1077,6 +1087,7 @@ func New(
 		Msgf("Genesis block hash %s", h.Hash())
 	// Setup initial state of syncing.
 	node.peerRegistrationRecord = map[string]*syncConfig{}
+	node.privateTxs = newPrivateTxService()
 	// Broadcast double-signers reported by consensus
 	if node.Consensus != nil {
 		go func() {
@@ -1090,12 +1101,8 @@ func New(
 				) {
 					return
 				}
-				if hooks := node.NodeConfig.WebHooks.Hooks; hooks != nil {
-					if s := hooks.Slashing; s != nil {
-						url := s.OnNoticeDoubleSign
-						go func() { webhooks.DoPost(url, &doubleSign) }()
-					}
-				}
+				record := doubleSign
+				webhooks.Publish(webhooks.EventDoubleSign, &record)
 				if !node.IsRunningBeaconChain() {
 					go node.BroadcastSlash(&doubleSign)
 				} else {
@@ -1226,9 +1233,18 @@ func (node *Node) ShutDown() {
 		utils.Logger().Error().Err(err).Msg("failed to stop p2p host")
 	}
 
+	// The pool snapshot has to be written before the chain is closed
+	if node.TxPool != nil {
+		utils.Logger().Info().Msg("stopping tx pool")
+		node.TxPool.Stop()
+	}
+
 	node.Blockchain().Stop()
 	node.Beaconchain().Stop()
 
+	utils.Logger().Info().Msg("stopping webhooks")
+	webhooks.DefaultDispatcher().Stop()
+
 	if node.HarmonyConfig.General.RunElasticMode {
 		_, _ = node.Blockchain().RedisPreempt().Unlock()
 		_, _ = node.Beaconchain().RedisPreempt().Unlock()
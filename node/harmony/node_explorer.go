@@ -16,6 +16,8 @@ import (
 	"github.com/harmony-one/harmony/consensus/signature"
 	"github.com/harmony-one/harmony/core"
 	"github.com/harmony-one/harmony/core/types"
+	"github.com/harmony-one/harmony/hmy/tokens"
+	"github.com/harmony-one/harmony/hmy/tracers"
 	"github.com/harmony-one/harmony/internal/utils"
 	"github.com/pkg/errors"
 )
@@ -295,7 +297,34 @@ func (node *Node) GetStakingTransactionsCount(address, txType string) (uint64, e
 	return count, nil
 }
 
-// GetStakingTransactionsCount returns the number of staking transactions hashes of address for input type.
+// GetTokenTransfersByAddress returns a page of the token transfers sent or received by address.
+func (node *Node) GetTokenTransfersByAddress(address string, pageIndex, pageSize uint32, order string) ([]*tokens.Transfer, error) {
+	exp, err := node.getExplorerService()
+	if err != nil {
+		return nil, err
+	}
+	return exp.GetTokenTransfersByAddress(address, int(pageIndex)*int(pageSize), int(pageSize), order == "DESC")
+}
+
+// GetTokenTransfersByContract returns a page of the token transfers of the token contract.
+func (node *Node) GetTokenTransfersByContract(address string, pageIndex, pageSize uint32, order string) ([]*tokens.Transfer, error) {
+	exp, err := node.getExplorerService()
+	if err != nil {
+		return nil, err
+	}
+	return exp.GetTokenTransfersByContract(address, int(pageIndex)*int(pageSize), int(pageSize), order == "DESC")
+}
+
+// GetInternalTransactionsHistory returns a page of the internal transactions sent or received by address.
+func (node *Node) GetInternalTransactionsHistory(address string, pageIndex, pageSize uint32, order string) ([]*tracers.InternalTransaction, error) {
+	exp, err := node.getExplorerService()
+	if err != nil {
+		return nil, err
+	}
+	return exp.GetInternalTxsByAddress(address, int(pageIndex)*int(pageSize), int(pageSize), order == "DESC")
+}
+
+// GetTraceResultByHash returns the trace result of the block stored in the explorer DB.
 func (node *Node) GetTraceResultByHash(hash common.Hash) (json.RawMessage, error) {
 	exp, err := node.getExplorerService()
 	if err != nil {
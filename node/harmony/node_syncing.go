@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"math/rand"
 	"net"
+	"sort"
 	"strconv"
 	"time"
 
@@ -24,6 +25,9 @@ import (
 	"github.com/harmony-one/harmony/internal/tikv"
 	"github.com/harmony-one/harmony/internal/utils"
 	"github.com/harmony-one/harmony/p2p"
+	"github.com/harmony-one/harmony/p2p/stream/common/streammanager"
+	sttypes "github.com/harmony-one/harmony/p2p/stream/types"
+	rpc_common "github.com/harmony-one/harmony/rpc/harmony/common"
 	"github.com/harmony-one/harmony/shard"
 	lru "github.com/hashicorp/golang-lru"
 	"github.com/multiformats/go-multiaddr"
@@ -878,12 +882,44 @@ func (node *Node) SyncPeers() map[string]int {
 	return res
 }
 
+// SyncPeerScores returns the scores of the stream sync peers, sorted by shard
+// and then by score from the highest.
+func (node *Node) SyncPeerScores() []rpc_common.SyncPeerScore {
+	ds := node.getDownloaders()
+	if ds == nil {
+		return nil
+	}
+	var res []rpc_common.SyncPeerScore
+	for sid, scores := range ds.PeerScores() {
+		for stid, score := range scores {
+			res = append(res, rpc_common.SyncPeerScore{
+				ShardID:          sid,
+				PeerID:           string(stid),
+				Score:            score.Score,
+				LatencyMs:        score.Latency.Milliseconds(),
+				Throughput:       score.Throughput,
+				Responses:        score.Responses,
+				Timeouts:         score.Timeouts,
+				InvalidResponses: score.Invalids,
+			})
+		}
+	}
+	sort.Slice(res, func(i, j int) bool {
+		if res[i].ShardID != res[j].ShardID {
+			return res[i].ShardID < res[j].ShardID
+		}
+		return res[i].Score > res[j].Score
+	})
+	return res
+}
+
 type Downloaders interface {
 	Start()
 	Close()
 	DownloadAsync(shardID uint32)
 	// GetShardDownloader(shardID uint32) *Downloader
 	NumPeers() map[uint32]int
+	PeerScores() map[uint32]map[sttypes.StreamID]streammanager.StreamScore
 	SyncStatus(shardID uint32) (bool, uint64, uint64)
 	IsActive() bool
 }
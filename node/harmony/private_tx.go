@@ -0,0 +1,442 @@
+package node
+
+import (
+	"context"
+	"crypto/rand"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/rlp"
+	bls_core "github.com/harmony-one/bls/ffi/go/bls"
+	libp2p_network "github.com/libp2p/go-libp2p/core/network"
+	libp2p_peer "github.com/libp2p/go-libp2p/core/peer"
+	"github.com/libp2p/go-libp2p/core/protocol"
+	"github.com/pkg/errors"
+
+	"github.com/harmony-one/harmony/core"
+	"github.com/harmony-one/harmony/core/types"
+	"github.com/harmony-one/harmony/crypto/bls"
+	"github.com/harmony-one/harmony/internal/utils"
+	rpc_common "github.com/harmony-one/harmony/rpc/harmony/common"
+)
+
+const (
+	// privateTxProtocolID is the protocol delivering private transactions directly to the leaders
+	privateTxProtocolID = protocol.ID("/harmony/privatetx/1.0.0")
+	// privateTxDefaultLifetime is the number of blocks a private transaction is kept by the leaders
+	privateTxDefaultLifetime = 100
+	// privateTxMaxLifetime is the max number of blocks a private transaction is kept by the leaders
+	privateTxMaxLifetime = 1000
+	// privateTxFallbackTimeout is the time after which a private transaction not included
+	// yet is broadcast to the public pool
+	privateTxFallbackTimeout = 2 * time.Minute
+	// privateTxStatusRetention is the number of blocks the final status of a private transaction is kept
+	privateTxStatusRetention = 1000
+	// privateTxStreamTimeout bounds the exchange with a single leader
+	privateTxStreamTimeout = 5 * time.Second
+	// privateTxCheckInterval is the interval of the inclusion, expiry & fallback checks
+	privateTxCheckInterval = 2 * time.Second
+	// privateTxMaxMsgSize bounds the size of the messages of the private transaction protocol
+	privateTxMaxMsgSize = 2 * types.MaxEncodedPoolTransactionSize
+)
+
+// status of the private transactions submitted through this node
+const (
+	privateTxPending   = "pending"
+	privateTxIncluded  = "included"
+	privateTxExpired   = "expired"
+	privateTxBroadcast = "broadcast"
+	privateTxFailed    = "failed"
+)
+
+var (
+	errPrivateTxExpired      = errors.New("private transaction expiry block already passed")
+	errPrivateTxNoLeader     = errors.New("no leader found for private transaction")
+	errPrivateTxRejected     = errors.New("leader rejected private transaction")
+	errPrivateTxBadSignature = errors.New("invalid leader signature on private transaction challenge")
+	errPrivateTxUnknown      = errors.New("unknown private transaction")
+
+	privateTxChallengePrefix = []byte("harmony private transaction leader challenge")
+)
+
+// privateTxChallenge asks the receiving node to prove it holds the leader key
+type privateTxChallenge struct {
+	LeaderKey bls.SerializedPublicKey
+	Challenge common.Hash
+}
+
+// privateTxChallengeResponse is the leader key signature over the challenge & responder peer ID
+type privateTxChallengeResponse struct {
+	Signature []byte
+}
+
+// privateTxMessage carries the private transaction once the leader is authenticated
+type privateTxMessage struct {
+	ExpiryBlock uint64
+	Tx          *types.Transaction
+}
+
+// privateTxResult is the answer of the leader, with an empty error if the transaction was accepted
+type privateTxResult struct {
+	Error string
+}
+
+// privateTxEntry tracks a private transaction submitted through this node
+type privateTxEntry struct {
+	tx        *types.Transaction
+	expiry    uint64
+	submitted time.Time
+	leaders   []string
+	status    string
+	err       string
+	finished  uint64 // block number at which the final status was reached
+}
+
+// privateTxService keeps the state of the private transaction submission
+type privateTxService struct {
+	mu sync.Mutex
+	// committee peer map, the peer publishing the consensus messages of a key
+	peers map[bls.SerializedPublicKey]libp2p_peer.ID
+	// private transactions submitted through this node
+	sent map[common.Hash]*privateTxEntry
+	// private transactions received as a leader, with their expiry block
+	received map[common.Hash]uint64
+}
+
+func newPrivateTxService() *privateTxService {
+	return &privateTxService{
+		peers:    map[bls.SerializedPublicKey]libp2p_peer.ID{},
+		sent:     map[common.Hash]*privateTxEntry{},
+		received: map[common.Hash]uint64{},
+	}
+}
+
+// privateTxChallengeHash is the hash signed by the leader key, bound to the responding peer
+// so the challenge cannot be relayed to the leader by another peer.
+func privateTxChallengeHash(challenge common.Hash, responder libp2p_peer.ID) []byte {
+	return crypto.Keccak256(privateTxChallengePrefix, challenge[:], []byte(responder))
+}
+
+// startPrivateTxService registers the private transaction protocol & starts the status checks
+func (node *Node) startPrivateTxService() {
+	node.host.GetP2PHost().SetStreamHandler(privateTxProtocolID, node.handlePrivateTxStream)
+	go node.privateTxLoop(node.psCtx)
+}
+
+// recordCommitteePeer updates the committee peer map with the origin of a consensus message
+func (node *Node) recordCommitteePeer(key bls.SerializedPublicKey, peer libp2p_peer.ID) {
+	if key == (bls.SerializedPublicKey{}) || peer == "" {
+		return
+	}
+	node.privateTxs.mu.Lock()
+	defer node.privateTxs.mu.Unlock()
+	node.privateTxs.peers[key] = peer
+}
+
+// AddPendingPrivateTransaction sends the transaction to the current and next leaders of the
+// shard only, it is never broadcast to the public pool unless it is not included before the
+// fallback timeout. The leaders drop the transaction after the expiry block, 0 for the default.
+func (node *Node) AddPendingPrivateTransaction(newTx *types.Transaction, expiryBlock uint64) error {
+	if newTx.ShardID() != node.NodeConfig.ShardID {
+		return errors.Errorf("shard do not match, txShard: %d, nodeShard: %d", newTx.ShardID(), node.NodeConfig.ShardID)
+	}
+	current := node.Blockchain().CurrentBlock().NumberU64()
+	if expiryBlock == 0 {
+		expiryBlock = current + privateTxDefaultLifetime
+	}
+	if expiryBlock <= current {
+		return errPrivateTxExpired
+	}
+	expiryBlock = capPrivateTxExpiry(current, expiryBlock)
+	leaders, err := node.privateTxLeaders()
+	if err != nil {
+		return err
+	}
+
+	delivered := []string{}
+	for _, leader := range leaders {
+		if err = node.sendPrivateTx(leader, newTx, expiryBlock); err != nil {
+			utils.Logger().Warn().Err(err).
+				Str("hash", newTx.Hash().Hex()).
+				Str("leader", leader.Bytes.Hex()).
+				Msg("[AddPendingPrivateTransaction] Failed sending private transaction to leader")
+			continue
+		}
+		delivered = append(delivered, leader.Bytes.Hex())
+	}
+	// an invalid transaction is not tracked, it would be rejected by the public pool as well
+	if len(delivered) == 0 && errors.Is(err, errPrivateTxRejected) {
+		return err
+	}
+
+	node.privateTxs.mu.Lock()
+	defer node.privateTxs.mu.Unlock()
+	node.privateTxs.sent[newTx.Hash()] = &privateTxEntry{
+		tx:        newTx,
+		expiry:    expiryBlock,
+		submitted: time.Now(),
+		leaders:   delivered,
+		status:    privateTxPending,
+	}
+	utils.Logger().Info().
+		Str("hash", newTx.Hash().Hex()).
+		Uint64("expiry", expiryBlock).
+		Strs("leaders", delivered).
+		Msg("Submitted private transaction")
+	return nil
+}
+
+// GetPrivateTransactionStatus returns the status of a private transaction submitted through this node
+func (node *Node) GetPrivateTransactionStatus(hash common.Hash) (*rpc_common.PrivateTxStatus, error) {
+	node.privateTxs.mu.Lock()
+	defer node.privateTxs.mu.Unlock()
+
+	entry, ok := node.privateTxs.sent[hash]
+	if !ok {
+		// eth compatible transactions may be queried by their eth hash
+		for _, e := range node.privateTxs.sent {
+			if e.tx.HashByType() == hash {
+				entry, ok = e, true
+				break
+			}
+		}
+	}
+	if !ok {
+		return nil, errPrivateTxUnknown
+	}
+	return &rpc_common.PrivateTxStatus{
+		Hash:        entry.tx.Hash().Hex(),
+		Status:      entry.status,
+		ExpiryBlock: entry.expiry,
+		SubmittedAt: entry.submitted.Unix(),
+		Leaders:     append([]string{}, entry.leaders...),
+		Error:       entry.err,
+	}, nil
+}
+
+// privateTxLeaders returns the current leader & the leader of the next view change
+func (node *Node) privateTxLeaders() ([]*bls.PublicKeyWrapper, error) {
+	leaders := []*bls.PublicKeyWrapper{}
+	if current := node.Consensus.GetLeaderPubKey(); current != nil {
+		leaders = append(leaders, current)
+	}
+	next, err := node.Consensus.GetNextLeaderKey()
+	if err != nil {
+		utils.Logger().Warn().Err(err).Msg("[privateTxLeaders] Unable to get next leader")
+	} else if next != nil && (len(leaders) == 0 || next.Bytes != leaders[0].Bytes) {
+		leaders = append(leaders, next)
+	}
+	if len(leaders) == 0 {
+		return nil, errPrivateTxNoLeader
+	}
+	return leaders, nil
+}
+
+// sendPrivateTx delivers the transaction to the leader, once the peer proved it holds the leader key
+func (node *Node) sendPrivateTx(leader *bls.PublicKeyWrapper, tx *types.Transaction, expiryBlock uint64) error {
+	if node.Consensus.GetPublicKeys().Contains(leader.Object) {
+		return node.receivePrivateTx(tx, expiryBlock)
+	}
+	node.privateTxs.mu.Lock()
+	peer, ok := node.privateTxs.peers[leader.Bytes]
+	node.privateTxs.mu.Unlock()
+	if !ok {
+		return errors.Errorf("no known peer for leader %s", leader.Bytes.Hex())
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), privateTxStreamTimeout)
+	defer cancel()
+	stream, err := node.host.GetP2PHost().NewStream(ctx, peer, privateTxProtocolID)
+	if err != nil {
+		return err
+	}
+	defer stream.Close()
+	if err := stream.SetDeadline(time.Now().Add(privateTxStreamTimeout)); err != nil {
+		return err
+	}
+	input := rlp.NewStream(stream, privateTxMaxMsgSize)
+
+	challenge := privateTxChallenge{LeaderKey: leader.Bytes}
+	if _, err := rand.Read(challenge.Challenge[:]); err != nil {
+		return err
+	}
+	if err := rlp.Encode(stream, challenge); err != nil {
+		return err
+	}
+	var response privateTxChallengeResponse
+	if err := input.Decode(&response); err != nil {
+		return err
+	}
+	var sig bls_core.Sign
+	if err := sig.Deserialize(response.Signature); err != nil {
+		return errors.WithMessage(errPrivateTxBadSignature, err.Error())
+	}
+	if !sig.VerifyHash(leader.Object, privateTxChallengeHash(challenge.Challenge, peer)) {
+		return errPrivateTxBadSignature
+	}
+
+	if err := rlp.Encode(stream, privateTxMessage{ExpiryBlock: expiryBlock, Tx: tx}); err != nil {
+		return err
+	}
+	var result privateTxResult
+	if err := input.Decode(&result); err != nil {
+		return err
+	}
+	if result.Error != "" {
+		return errors.WithMessage(errPrivateTxRejected, result.Error)
+	}
+	return nil
+}
+
+// handlePrivateTxStream answers the leader key challenge and accepts the private transaction
+func (node *Node) handlePrivateTxStream(stream libp2p_network.Stream) {
+	defer stream.Close()
+	if err := stream.SetDeadline(time.Now().Add(privateTxStreamTimeout)); err != nil {
+		return
+	}
+	input := rlp.NewStream(stream, privateTxMaxMsgSize)
+
+	var challenge privateTxChallenge
+	if err := input.Decode(&challenge); err != nil {
+		stream.Reset()
+		return
+	}
+	var leaderKey *bls.PrivateKeyWrapper
+	keys := node.Consensus.GetPrivateKeys()
+	for i := range keys {
+		if keys[i].Pub.Bytes == challenge.LeaderKey {
+			leaderKey = &keys[i]
+			break
+		}
+	}
+	if leaderKey == nil {
+		stream.Reset()
+		return
+	}
+	sig := leaderKey.Pri.SignHash(privateTxChallengeHash(challenge.Challenge, node.host.GetID()))
+	if err := rlp.Encode(stream, privateTxChallengeResponse{Signature: sig.Serialize()}); err != nil {
+		stream.Reset()
+		return
+	}
+
+	var msg privateTxMessage
+	if err := input.Decode(&msg); err != nil {
+		stream.Reset()
+		return
+	}
+	result := privateTxResult{}
+	if err := node.receivePrivateTx(msg.Tx, msg.ExpiryBlock); err != nil {
+		result.Error = err.Error()
+	}
+	if err := rlp.Encode(stream, result); err != nil {
+		stream.Reset()
+	}
+}
+
+// receivePrivateTx adds a private transaction to the pool of this leader, without broadcasting it
+func (node *Node) receivePrivateTx(tx *types.Transaction, expiryBlock uint64) error {
+	if tx == nil {
+		return errors.New("nil private transaction")
+	}
+	if tx.ShardID() != node.NodeConfig.ShardID {
+		return errors.Errorf("shard do not match, txShard: %d, nodeShard: %d", tx.ShardID(), node.NodeConfig.ShardID)
+	}
+	current := node.Blockchain().CurrentBlock().NumberU64()
+	if expiryBlock <= current {
+		return errPrivateTxExpired
+	}
+	// the expiry is chosen by the sender, so it is capped to keep the transaction private
+	// for a bounded number of blocks only
+	expiryBlock = capPrivateTxExpiry(current, expiryBlock)
+	for _, err := range addPendingTransactions(node.registry, types.Transactions{tx}) {
+		if err != nil && errors.Cause(err) != core.ErrKnownTransaction {
+			return err
+		}
+	}
+
+	node.privateTxs.mu.Lock()
+	defer node.privateTxs.mu.Unlock()
+	if expiryBlock > node.privateTxs.received[tx.Hash()] {
+		node.privateTxs.received[tx.Hash()] = expiryBlock
+	}
+	utils.Logger().Info().
+		Str("hash", tx.Hash().Hex()).
+		Uint64("expiry", expiryBlock).
+		Msg("Received private transaction")
+	return nil
+}
+
+// capPrivateTxExpiry caps the expiry block to the max lifetime of a private transaction
+func capPrivateTxExpiry(current, expiryBlock uint64) uint64 {
+	if limit := current + privateTxMaxLifetime; expiryBlock > limit {
+		return limit
+	}
+	return expiryBlock
+}
+
+func (node *Node) privateTxLoop(ctx context.Context) {
+	ticker := time.NewTicker(privateTxCheckInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			node.checkPrivateTxs()
+		}
+	}
+}
+
+// checkPrivateTxs updates the status of the submitted private transactions, falling back
+// to the public broadcast on timeout, and forgets the private transactions of this leader
+// once included, evicting the expired ones.
+func (node *Node) checkPrivateTxs() {
+	current := node.Blockchain().CurrentBlock().NumberU64()
+	fallbacks := []*privateTxEntry{}
+	expired := []common.Hash{}
+
+	node.privateTxs.mu.Lock()
+	for hash, entry := range node.privateTxs.sent {
+		if entry.status != privateTxPending {
+			if current > entry.finished+privateTxStatusRetention {
+				delete(node.privateTxs.sent, hash)
+			}
+			continue
+		}
+		if blockHash, _, _ := node.Blockchain().ReadTxLookupEntry(hash); blockHash != (common.Hash{}) {
+			entry.status, entry.finished = privateTxIncluded, current
+		} else if current > entry.expiry {
+			entry.status, entry.finished = privateTxExpired, current
+		} else if time.Since(entry.submitted) > privateTxFallbackTimeout {
+			fallbacks = append(fallbacks, entry)
+		}
+	}
+	for hash, expiry := range node.privateTxs.received {
+		if blockHash, _, _ := node.Blockchain().ReadTxLookupEntry(hash); blockHash != (common.Hash{}) {
+			delete(node.privateTxs.received, hash)
+		} else if current > expiry {
+			expired = append(expired, hash)
+			delete(node.privateTxs.received, hash)
+		}
+	}
+	node.privateTxs.mu.Unlock()
+
+	for _, entry := range fallbacks {
+		utils.Logger().Info().
+			Str("hash", entry.tx.Hash().Hex()).
+			Msg("Private transaction not included in time, broadcasting it")
+		err := node.AddPendingTransaction(entry.tx)
+
+		node.privateTxs.mu.Lock()
+		entry.status, entry.finished = privateTxBroadcast, current
+		if err != nil {
+			entry.status, entry.err = privateTxFailed, err.Error()
+		}
+		node.privateTxs.mu.Unlock()
+	}
+	if len(expired) > 0 {
+		node.TxPool.EvictTransactions(expired, "private transaction expired", false)
+	}
+}
@@ -0,0 +1,65 @@
+package node
+
+import (
+	"bytes"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/rlp"
+	libp2p_peer "github.com/libp2p/go-libp2p/core/peer"
+
+	"github.com/harmony-one/harmony/core/types"
+	"github.com/harmony-one/harmony/crypto/bls"
+)
+
+func TestPrivateTxChallengeHash(t *testing.T) {
+	key := bls.RandPrivateKey()
+	challenge := common.BytesToHash([]byte("challenge"))
+	leaderPeer, relayPeer := libp2p_peer.ID("leader"), libp2p_peer.ID("relay")
+
+	sig := key.SignHash(privateTxChallengeHash(challenge, leaderPeer))
+	if !sig.VerifyHash(key.GetPublicKey(), privateTxChallengeHash(challenge, leaderPeer)) {
+		t.Error("expected challenge signature of the responding leader to verify")
+	}
+	// a signature relayed by another peer must not be accepted
+	if sig.VerifyHash(key.GetPublicKey(), privateTxChallengeHash(challenge, relayPeer)) {
+		t.Error("expected challenge signature to be bound to the responding peer")
+	}
+}
+
+func TestPrivateTxMessageEncoding(t *testing.T) {
+	tx := types.NewTransaction(3, common.Address{1}, 0, big.NewInt(10), 21000, big.NewInt(1e9), nil)
+	msg := privateTxMessage{ExpiryBlock: 120, Tx: tx}
+
+	var buf bytes.Buffer
+	if err := rlp.Encode(&buf, msg); err != nil {
+		t.Fatalf("failed to encode private tx message: %v", err)
+	}
+	var decoded privateTxMessage
+	if err := rlp.NewStream(&buf, privateTxMaxMsgSize).Decode(&decoded); err != nil {
+		t.Fatalf("failed to decode private tx message: %v", err)
+	}
+	if decoded.ExpiryBlock != msg.ExpiryBlock {
+		t.Errorf("expiry block mismatch: have %d, want %d", decoded.ExpiryBlock, msg.ExpiryBlock)
+	}
+	if decoded.Tx == nil || decoded.Tx.Hash() != tx.Hash() {
+		t.Errorf("transaction mismatch after decoding")
+	}
+}
+
+func TestCapPrivateTxExpiry(t *testing.T) {
+	tests := []struct {
+		current, expiry, exp uint64
+	}{
+		{100, 150, 150},
+		{100, 100 + privateTxMaxLifetime, 100 + privateTxMaxLifetime},
+		{100, 101 + privateTxMaxLifetime, 100 + privateTxMaxLifetime},
+		{100, ^uint64(0), 100 + privateTxMaxLifetime},
+	}
+	for i, test := range tests {
+		if got := capPrivateTxExpiry(test.current, test.expiry); got != test.exp {
+			t.Errorf("Test %v: unexpected expiry: %v / %v", i, got, test.exp)
+		}
+	}
+}
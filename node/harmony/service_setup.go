@@ -54,3 +54,30 @@ func (node *Node) networkInfoDHTPath() string {
 		node.chainConfig.ChainID,
 	)
 }
+
+// StartService starts the registered service of the given name
+func (node *Node) StartService(name string) error {
+	t, err := service.ParseType(name)
+	if err != nil {
+		return err
+	}
+	return node.serviceManager.StartService(t)
+}
+
+// StopService stops the registered service of the given name
+func (node *Node) StopService(name string) error {
+	t, err := service.ParseType(name)
+	if err != nil {
+		return err
+	}
+	return node.serviceManager.StopService(t)
+}
+
+// ServicesStatus returns whether each of the registered services is running
+func (node *Node) ServicesStatus() map[string]bool {
+	status := map[string]bool{}
+	for t, running := range node.serviceManager.ServicesStatus() {
+		status[t.String()] = running
+	}
+	return status
+}
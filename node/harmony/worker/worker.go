@@ -79,6 +79,11 @@ func New(
 		Time(big.NewInt(timestamp)).
 		ShardID(chain.ShardID()).
 		Header()
+	if worker.config.IsLondon(epoch) {
+		if err := header.SetBaseFee(core.CalcBaseFee(worker.config, parent)); err != nil {
+			utils.Logger().Error().Err(err).Msg("[Worker] failed to set the base fee")
+		}
+	}
 	worker.makeCurrent(parent, header)
 
 	return worker
@@ -208,7 +213,7 @@ func (w *Worker) CommitTransactions(
 	}
 
 	// HARMONY TXNS
-	normalTxns := types.NewTransactionsByPriceAndNonce(w.current.signer, w.current.ethSigner, pendingNormal)
+	normalTxns := types.NewTransactionsByPriceAndNonce(w.current.signer, w.current.ethSigner, pendingNormal, w.current.header.BaseFee())
 
 	w.CommitSortedTransactions(normalTxns, coinbase)
 
@@ -368,6 +373,11 @@ func (w *Worker) UpdateCurrent() (Environment, error) {
 		Time(big.NewInt(timestamp)).
 		ShardID(w.chain.ShardID()).
 		Header()
+	if w.config.IsLondon(epoch) {
+		if err := header.SetBaseFee(core.CalcBaseFee(w.config, parent)); err != nil {
+			return nil, err
+		}
+	}
 	return w.makeCurrent(parent, header)
 }
 
@@ -393,8 +403,8 @@ func makeEnvironment(chain core.BlockChain, parent *block.Header, header *block.
 		return nil, err
 	}
 	env := &environment{
-		signer:    types.NewEIP155Signer(chain.Config().ChainID),
-		ethSigner: types.NewEIP155Signer(chain.Config().EthCompatibleChainID),
+		signer:    types.NewLondonSigner(chain.Config().ChainID),
+		ethSigner: types.NewLondonSigner(chain.Config().EthCompatibleChainID),
 		state:     state,
 		header:    header,
 	}
@@ -69,6 +69,9 @@ type Host interface {
 	ListPeer(topic string) []libp2p_peer.ID
 	ListTopic() []string
 	ListBlockedPeer() []libp2p_peer.ID
+	AddPeerByAddress(addrStr string) error
+	RemovePeer(id libp2p_peer.ID) error
+	BlockPeer(id libp2p_peer.ID, until time.Time) error
 }
 
 // Peer is the object for a p2p peer (node)
@@ -641,6 +644,33 @@ func (host *HostV2) ListBlockedPeer() []libp2p_peer.ID {
 	return host.banned.Keys()
 }
 
+// RemovePeer disconnects the peer and forgets its addresses
+func (host *HostV2) RemovePeer(id libp2p_peer.ID) error {
+	if id == host.GetID() {
+		return errors.New("cannot remove self")
+	}
+	host.Peerstore().ClearAddrs(id)
+	host.Peerstore().RemovePeer(id)
+	if err := host.h.Network().ClosePeer(id); err != nil {
+		return errors.Wrapf(err, "cannot disconnect peer %v", id)
+	}
+	host.logger.Info().Str("peer", id.String()).Msg("removed peer")
+	return nil
+}
+
+// BlockPeer disconnects the peer and refuses its connections until the given time
+func (host *HostV2) BlockPeer(id libp2p_peer.ID, until time.Time) error {
+	if id == host.GetID() {
+		return errors.New("cannot block self")
+	}
+	host.banned.Ban(id, until)
+	if err := host.h.Network().ClosePeer(id); err != nil {
+		return errors.Wrapf(err, "cannot disconnect peer %v", id)
+	}
+	host.logger.Info().Str("peer", id.String()).Time("until", until).Msg("blocked peer")
+	return nil
+}
+
 // GetPeerCount ...
 func (host *HostV2) GetPeerCount() int {
 	return host.h.Peerstore().Peers().Len()
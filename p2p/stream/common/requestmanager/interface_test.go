@@ -17,6 +17,7 @@ var testProtoID = sttypes.ProtoID("harmony/sync/unitest/0/1.0.0")
 
 type testStreamManager struct {
 	streams map[sttypes.StreamID]sttypes.Stream
+	scores  map[sttypes.StreamID]float64
 
 	newStreamFeed event.Feed
 	rmStreamFeed  event.Feed
@@ -27,6 +28,7 @@ type testStreamManager struct {
 func newTestStreamManager() *testStreamManager {
 	return &testStreamManager{
 		streams: make(map[sttypes.StreamID]sttypes.Stream),
+		scores:  make(map[sttypes.StreamID]float64),
 	}
 }
 
@@ -66,6 +68,31 @@ func (sm *testStreamManager) GetStreams() []sttypes.Stream {
 	return sts
 }
 
+func (sm *testStreamManager) RecordResponse(id sttypes.StreamID, latency time.Duration, size int) {}
+
+func (sm *testStreamManager) RecordTimeout(id sttypes.StreamID) {}
+
+func (sm *testStreamManager) RecordInvalidResponse(id sttypes.StreamID, reason string) {}
+
+func (sm *testStreamManager) GetStreamScore(id sttypes.StreamID) (streammanager.StreamScore, bool) {
+	sm.lock.Lock()
+	defer sm.lock.Unlock()
+
+	score, ok := sm.scores[id]
+	return streammanager.StreamScore{Score: score}, ok
+}
+
+func (sm *testStreamManager) GetStreamScores() map[sttypes.StreamID]streammanager.StreamScore {
+	sm.lock.Lock()
+	defer sm.lock.Unlock()
+
+	scores := make(map[sttypes.StreamID]streammanager.StreamScore)
+	for id, score := range sm.scores {
+		scores[id] = streammanager.StreamScore{Score: score}
+	}
+	return scores
+}
+
 func (sm *testStreamManager) GetStreamByID(id sttypes.StreamID) (sttypes.Stream, bool) {
 	sm.lock.Lock()
 	defer sm.lock.Unlock()
@@ -237,6 +264,10 @@ func (tr *testResponse) ReqID() uint64 {
 	return tr.reqID
 }
 
+func (tr *testResponse) Size() int {
+	return 0
+}
+
 func (tr *testResponse) String() string {
 	return fmt.Sprintf("test response %v", tr.index)
 }
@@ -17,8 +17,9 @@ import (
 
 // requestManager implements RequestManager. It is responsible for matching response
 // with requests.
+// Requests are routed to the available stream with the highest score, and the
+// latency and timeouts of the requests are recorded to the stream scores.
 // TODO: each peer is able to have a queue of requests instead of one request at a time.
-// TODO: add QoS evaluation for each stream
 type requestManager struct {
 	streams   *sttypes.SafeMap[sttypes.StreamID, *stream]  // All streams
 	available *sttypes.SafeMap[sttypes.StreamID, struct{}] // Streams that are available for request
@@ -26,7 +27,7 @@ type requestManager struct {
 	waitings  requestQueues                                // double linked list of requests that are on the waiting list
 
 	// Stream events
-	sm         streammanager.Reader
+	sm         streammanager.ReaderSubscriber
 	newStreamC <-chan streammanager.EvtStreamAdded
 	rmStreamC  <-chan streammanager.EvtStreamRemoved
 	// Request events
@@ -167,6 +168,7 @@ func (rm *requestManager) loop() {
 					if err := st.WriteBytes(b); err != nil {
 						rm.logger.Warn().Str("streamID", string(st.ID())).Err(err).
 							Msg("write bytes")
+						rm.sm.RecordTimeout(st.ID())
 						req.doneWithResponse(responseData{
 							stID: st.ID(),
 							err:  errors.Wrap(err, "write bytes"),
@@ -224,6 +226,7 @@ func (rm *requestManager) handleDeliverData(data responseData) {
 	}
 	// req and st is ensured not to be empty in validateDelivery
 	req, _ := rm.pendings.Get(data.resp.ReqID())
+	rm.sm.RecordResponse(data.stID, time.Since(req.sentAt), data.resp.Size())
 	req.doneWithResponse(data)
 	rm.removePendingRequest(req)
 }
@@ -260,6 +263,9 @@ func (rm *requestManager) handleCancelRequest(data cancelReqData) {
 	var stid sttypes.StreamID
 	if req.owner != nil {
 		stid = req.owner.ID()
+		if errors.Is(err, context.DeadlineExceeded) {
+			rm.sm.RecordTimeout(stid)
+		}
 	}
 	req.doneWithResponse(responseData{
 		resp: nil,
@@ -303,6 +309,7 @@ func (rm *requestManager) addPendingRequest(req *request, st *stream) {
 	req.SetReqID(reqID)
 
 	req.owner = st
+	req.sentAt = time.Now()
 	st.req = req
 
 	rm.available.Delete(st.ID())
@@ -321,7 +328,12 @@ func (rm *requestManager) removePendingRequest(req *request) {
 	}
 }
 
+// pickAvailableStream picks the allowed available stream with the highest score
 func (rm *requestManager) pickAvailableStream(req *request) (*stream, error) {
+	var (
+		picked    *stream
+		bestScore float64
+	)
 	availableStreamIDs := rm.available.Keys()
 	for _, id := range availableStreamIDs {
 		if !req.isStreamAllowed(id) {
@@ -335,11 +347,25 @@ func (rm *requestManager) pickAvailableStream(req *request) (*stream, error) {
 			continue
 		}
 		spec, _ := st.ProtoSpec()
-		if req.Request.IsSupportedByProto(spec) {
-			return st, nil
+		if !req.Request.IsSupportedByProto(spec) {
+			continue
+		}
+		if score := rm.streamScore(id); picked == nil || score > bestScore {
+			picked, bestScore = st, score
 		}
 	}
-	return nil, errors.New("no more available streams")
+	if picked == nil {
+		return nil, errors.New("no more available streams")
+	}
+	return picked, nil
+}
+
+// streamScore returns the score of the stream, new streams have the full score
+func (rm *requestManager) streamScore(id sttypes.StreamID) float64 {
+	if score, ok := rm.sm.GetStreamScore(id); ok {
+		return score.Score
+	}
+	return streammanager.MaxScore
 }
 
 func (rm *requestManager) refreshStreams() {
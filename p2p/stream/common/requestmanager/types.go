@@ -4,6 +4,7 @@ import (
 	"container/list"
 	"sync"
 	"sync/atomic"
+	"time"
 
 	sttypes "github.com/harmony-one/harmony/p2p/stream/types"
 	"github.com/pkg/errors"
@@ -33,7 +34,8 @@ type request struct {
 	atmDone uint32
 	doneC   chan struct{}
 	// stream info
-	owner *stream // Current owner
+	owner  *stream   // Current owner
+	sentAt time.Time // time when the request is assigned to the owner
 	// utils
 	lock sync.RWMutex
 	raw  *interface{}
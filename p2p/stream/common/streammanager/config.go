@@ -10,6 +10,8 @@ const (
 	discTimeout = 10 * time.Second
 	// connectTimeout is the timeout for setting up a stream with a discovered peer
 	connectTimeout = 60 * time.Second
+	// banPeriod is the period a peer is banned after its stream is evicted for a low score
+	banPeriod = 10 * time.Minute
 )
 
 // Config is the config for stream manager
@@ -19,8 +19,8 @@ type coolDownCache struct {
 	timeCache *timecache.TimeCache
 }
 
-func newCoolDownCache() *coolDownCache {
-	tl := timecache.NewTimeCache(coolDownPeriod)
+func newCoolDownCache(period time.Duration) *coolDownCache {
+	tl := timecache.NewTimeCache(period)
 	return &coolDownCache{
 		timeCache: tl,
 	}
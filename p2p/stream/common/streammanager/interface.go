@@ -2,6 +2,7 @@ package streammanager
 
 import (
 	"context"
+	"time"
 
 	"github.com/ethereum/go-ethereum/event"
 	sttypes "github.com/harmony-one/harmony/p2p/stream/types"
@@ -17,12 +18,14 @@ type StreamManager interface {
 	Operator
 	Subscriber
 	Reader
+	Scorer
 }
 
-// ReaderSubscriber reads stream and subscribe stream events
+// ReaderSubscriber reads and scores stream and subscribe stream events
 type ReaderSubscriber interface {
 	Reader
 	Subscriber
+	Scorer
 }
 
 // Operator handles new stream or remove stream
@@ -43,6 +46,16 @@ type Reader interface {
 	GetStreamByID(id sttypes.StreamID) (sttypes.Stream, bool)
 }
 
+// Scorer records the behaviors of the streams and reads the stream scores.
+// Streams with a low score are evicted and banned for a while.
+type Scorer interface {
+	RecordResponse(id sttypes.StreamID, latency time.Duration, size int)
+	RecordTimeout(id sttypes.StreamID)
+	RecordInvalidResponse(id sttypes.StreamID, reason string)
+	GetStreamScore(id sttypes.StreamID) (StreamScore, bool)
+	GetStreamScores() map[sttypes.StreamID]StreamScore
+}
+
 // host is the adapter interface of the libp2p host implementation.
 // TODO: further adapt the host
 type host interface {
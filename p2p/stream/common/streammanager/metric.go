@@ -13,6 +13,8 @@ func init() {
 		removedStreamsCounterVec,
 		setupStreamDuration,
 		numStreamsGaugeVec,
+		streamScoreGaugeVec,
+		evictedStreamsCounterVec,
 	)
 }
 
@@ -78,4 +80,24 @@ var (
 		},
 		[]string{"topic"},
 	)
+
+	streamScoreGaugeVec = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: "hmy",
+			Subsystem: "stream",
+			Name:      "score",
+			Help:      "reputation score of the connected streams",
+		},
+		[]string{"topic", "stream"},
+	)
+
+	evictedStreamsCounterVec = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: "hmy",
+			Subsystem: "stream",
+			Name:      "evicted_streams",
+			Help:      "number of streams evicted and banned for a low score",
+		},
+		[]string{"topic"},
+	)
 )
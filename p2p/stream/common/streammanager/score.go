@@ -0,0 +1,193 @@
+package streammanager
+
+import (
+	"math"
+	"sync"
+	"time"
+
+	sttypes "github.com/harmony-one/harmony/p2p/stream/types"
+)
+
+const (
+	// MaxScore is the score of a stream without any bad record
+	MaxScore = 100.0
+	// scoreAlpha is the smoothing factor of the moving averages of a stream
+	scoreAlpha = 0.2
+
+	// weights of each factor of the stream score
+	timeoutWeight    = 40.0
+	latencyWeight    = 20.0
+	throughputWeight = 10.0
+	// invalidPenalty is the penalty of each unforgiven invalid response
+	invalidPenalty = 25.0
+	// invalidForgiveResponses is the number of valid responses to forgive one
+	// invalid response
+	invalidForgiveResponses = 20
+
+	// latencyCap is the latency which gets the full latency penalty, also used
+	// as the latency of a request timeout
+	latencyCap = 10 * time.Second
+	// throughputCap is the throughput in bytes per second which gets no
+	// throughput penalty
+	throughputCap = 1024 * 1024
+	// minThroughputSampleSize is the minimum response size to sample the
+	// throughput, small responses are dominated by the latency
+	minThroughputSampleSize = 16 * 1024
+
+	// evictScore is the score below which a stream is evicted and banned
+	evictScore = 50.0
+	// minScoreSamples is the minimum number of requests to evict a stream for
+	// timeouts and latency. Invalid responses evict a stream regardless.
+	minScoreSamples = 5
+)
+
+// StreamScore is the reputation of a stream, which is used to route the
+// requests to the best streams and to evict the bad ones.
+type StreamScore struct {
+	Score      float64       // from 0 to 100, the higher the better
+	Latency    time.Duration // moving average of the response latency
+	Throughput float64       // moving average of the response throughput in bytes per second
+	Responses  int           // number of valid responses
+	Timeouts   int           // number of requests without a response
+	Invalids   int           // number of invalid responses
+}
+
+// streamScorer tracks the behaviors of a stream and computes its score
+type streamScorer struct {
+	latency     float64 // seconds
+	throughput  float64 // bytes per second, zero if not sampled
+	timeoutRate float64
+	invalidDebt float64 // invalid responses not yet forgiven
+
+	responses int
+	timeouts  int
+	invalids  int
+	evicted   bool
+}
+
+func movingAverage(avg, sample float64, samples int) float64 {
+	if samples <= 1 {
+		return sample
+	}
+	return avg + scoreAlpha*(sample-avg)
+}
+
+func (s *streamScorer) addResponse(latency time.Duration, size int) {
+	s.responses++
+	s.latency = movingAverage(s.latency, latency.Seconds(), s.requests())
+	s.timeoutRate = movingAverage(s.timeoutRate, 0, s.requests())
+	if size >= minThroughputSampleSize && latency > 0 {
+		sample := float64(size) / latency.Seconds()
+		if s.throughput == 0 {
+			s.throughput = sample
+		} else {
+			s.throughput = movingAverage(s.throughput, sample, s.responses)
+		}
+	}
+	s.invalidDebt = math.Max(0, s.invalidDebt-1.0/invalidForgiveResponses)
+}
+
+func (s *streamScorer) addTimeout() {
+	s.timeouts++
+	s.latency = movingAverage(s.latency, latencyCap.Seconds(), s.requests())
+	s.timeoutRate = movingAverage(s.timeoutRate, 1, s.requests())
+}
+
+func (s *streamScorer) addInvalid() {
+	s.invalids++
+	s.invalidDebt++
+}
+
+func (s *streamScorer) requests() int {
+	return s.responses + s.timeouts
+}
+
+func (s *streamScorer) score() float64 {
+	score := MaxScore
+	score -= timeoutWeight * s.timeoutRate
+	score -= latencyWeight * math.Min(s.latency/latencyCap.Seconds(), 1)
+	if s.throughput > 0 {
+		score -= throughputWeight * (1 - math.Min(s.throughput/throughputCap, 1))
+	}
+	score -= invalidPenalty * s.invalidDebt
+	return math.Max(score, 0)
+}
+
+// evictable returns whether the stream shall be evicted for a low score
+func (s *streamScorer) evictable() bool {
+	if s.score() >= evictScore {
+		return false
+	}
+	return s.requests() >= minScoreSamples || s.invalidDebt > 0
+}
+
+func (s *streamScorer) export() StreamScore {
+	return StreamScore{
+		Score:      s.score(),
+		Latency:    time.Duration(s.latency * float64(time.Second)),
+		Throughput: s.throughput,
+		Responses:  s.responses,
+		Timeouts:   s.timeouts,
+		Invalids:   s.invalids,
+	}
+}
+
+// scoreBook is the thread safe scores of the streams
+type scoreBook struct {
+	scorers map[sttypes.StreamID]*streamScorer
+	lock    sync.RWMutex
+}
+
+func newScoreBook() *scoreBook {
+	return &scoreBook{
+		scorers: make(map[sttypes.StreamID]*streamScorer),
+	}
+}
+
+// update applies the update to the scorer of the stream, returns the new score
+// and whether the stream shall be evicted. A stream is evicted only once.
+func (sb *scoreBook) update(id sttypes.StreamID, update func(s *streamScorer)) (StreamScore, bool) {
+	sb.lock.Lock()
+	defer sb.lock.Unlock()
+
+	s, ok := sb.scorers[id]
+	if !ok {
+		s = &streamScorer{}
+		sb.scorers[id] = s
+	}
+	update(s)
+	evict := !s.evicted && s.evictable()
+	if evict {
+		s.evicted = true
+	}
+	return s.export(), evict
+}
+
+func (sb *scoreBook) get(id sttypes.StreamID) (StreamScore, bool) {
+	sb.lock.RLock()
+	defer sb.lock.RUnlock()
+
+	s, ok := sb.scorers[id]
+	if !ok {
+		return StreamScore{}, false
+	}
+	return s.export(), true
+}
+
+func (sb *scoreBook) getAll() map[sttypes.StreamID]StreamScore {
+	sb.lock.RLock()
+	defer sb.lock.RUnlock()
+
+	res := make(map[sttypes.StreamID]StreamScore, len(sb.scorers))
+	for id, s := range sb.scorers {
+		res[id] = s.export()
+	}
+	return res
+}
+
+func (sb *scoreBook) delete(id sttypes.StreamID) {
+	sb.lock.Lock()
+	defer sb.lock.Unlock()
+
+	delete(sb.scorers, id)
+}
@@ -0,0 +1,130 @@
+package streammanager
+
+import (
+	"errors"
+	"math"
+	"testing"
+	"time"
+
+	libp2p_peer "github.com/libp2p/go-libp2p/core/peer"
+)
+
+func TestStreamScorer(t *testing.T) {
+	tests := []struct {
+		name     string
+		update   func(s *streamScorer)
+		expScore float64
+		expEvict bool
+	}{
+		{
+			name:     "no record",
+			update:   func(s *streamScorer) {},
+			expScore: MaxScore,
+			expEvict: false,
+		},
+		{
+			name: "fast responses",
+			update: func(s *streamScorer) {
+				for i := 0; i != 10; i++ {
+					s.addResponse(100*time.Millisecond, 0)
+				}
+			},
+			expScore: 99.8,
+			expEvict: false,
+		},
+		{
+			name: "slow throughput",
+			update: func(s *streamScorer) {
+				s.addResponse(time.Second, throughputCap/2)
+			},
+			expScore: 93,
+			expEvict: false,
+		},
+		{
+			name: "timeouts below min samples",
+			update: func(s *streamScorer) {
+				for i := 0; i != minScoreSamples-1; i++ {
+					s.addTimeout()
+				}
+			},
+			expScore: 40,
+			expEvict: false,
+		},
+		{
+			name: "timeouts",
+			update: func(s *streamScorer) {
+				for i := 0; i != minScoreSamples; i++ {
+					s.addTimeout()
+				}
+			},
+			expScore: 40,
+			expEvict: true,
+		},
+		{
+			name: "one invalid response",
+			update: func(s *streamScorer) {
+				s.addInvalid()
+			},
+			expScore: 75,
+			expEvict: false,
+		},
+		{
+			name: "invalid responses",
+			update: func(s *streamScorer) {
+				for i := 0; i != 3; i++ {
+					s.addInvalid()
+				}
+			},
+			expScore: 25,
+			expEvict: true,
+		},
+		{
+			name: "invalid response forgiven",
+			update: func(s *streamScorer) {
+				s.addInvalid()
+				for i := 0; i != invalidForgiveResponses; i++ {
+					s.addResponse(0, 0)
+				}
+			},
+			expScore: MaxScore,
+			expEvict: false,
+		},
+	}
+	for _, test := range tests {
+		s := &streamScorer{}
+		test.update(s)
+
+		if score := s.score(); math.Abs(score-test.expScore) > 0.01 {
+			t.Errorf("Test %v: unexpected score: %v / %v", test.name, score, test.expScore)
+		}
+		if evict := s.evictable(); evict != test.expEvict {
+			t.Errorf("Test %v: unexpected evictable: %v / %v", test.name, evict, test.expEvict)
+		}
+	}
+}
+
+func TestStreamManager_EvictLowScore(t *testing.T) {
+	sm := newTestStreamManager()
+	sm.Start()
+	time.Sleep(defTestWait)
+
+	id := makeStreamID(1)
+	for i := 0; i != 3; i++ {
+		sm.RecordInvalidResponse(id, "invalid response")
+	}
+	time.Sleep(defTestWait)
+
+	if _, ok := sm.streams.get(id); ok {
+		t.Errorf("stream with low score not evicted")
+	}
+	if _, ok := sm.GetStreamScore(id); ok {
+		t.Errorf("score of evicted stream not removed")
+	}
+	if !sm.banCache.Has(libp2p_peer.ID(id)) {
+		t.Errorf("peer of evicted stream not banned")
+	}
+	err := sm.NewStream(newTestStream(id, testProtoID))
+	if !errors.Is(err, ErrStreamBanned) {
+		t.Errorf("unexpected error adding banned stream: %v / %v", err, ErrStreamBanned)
+	}
+}
@@ -26,6 +26,8 @@ var (
 	ErrStreamAlreadyExist = errors.New("stream already exist")
 	// ErrTooManyStreams is the error that the number of streams is exceeded the capacity
 	ErrTooManyStreams = errors.New("too many streams")
+	// ErrStreamBanned is the error that the peer of the stream is banned for a low score
+	ErrStreamBanned = errors.New("stream peer is banned")
 )
 
 // streamManager is the implementation of StreamManager. It manages streams on
@@ -34,7 +36,8 @@ var (
 // 2. closes a stream.
 // 3. discover and connect new streams when the number of streams is below threshold.
 // 4. emit stream events to inform other modules.
-// 5. reset all streams on close.
+// 5. score streams, evict and ban the streams with a low score.
+// 6. reset all streams on close.
 type streamManager struct {
 	// streamManager only manages streams on one protocol.
 	myProtoID   sttypes.ProtoID
@@ -57,6 +60,8 @@ type streamManager struct {
 	coolDown    *abool.AtomicBool
 	// utils
 	coolDownCache    *coolDownCache
+	banCache         *coolDownCache
+	scores           *scoreBook
 	addStreamFeed    event.Feed
 	removeStreamFeed event.Feed
 	logger           zerolog.Logger
@@ -97,7 +102,9 @@ func newStreamManager(pid sttypes.ProtoID, host host, pf peerFinder, handleStrea
 		stopCh:        make(chan stopTask),
 		discCh:        make(chan discTask, 1), // discCh is a buffered channel to avoid overuse of goroutine
 		coolDown:      abool.New(),
-		coolDownCache: newCoolDownCache(),
+		coolDownCache: newCoolDownCache(coolDownPeriod),
+		banCache:      newCoolDownCache(banPeriod),
+		scores:        newScoreBook(),
 		logger:        logger,
 		ctx:           ctx,
 		cancel:        cancel,
@@ -260,6 +267,9 @@ func (sm *streamManager) handleAddStream(st sttypes.Stream) error {
 	if _, ok := sm.streams.get(id); ok {
 		return ErrStreamAlreadyExist
 	}
+	if sm.banCache.Has(libp2p_peer.ID(id)) {
+		return ErrStreamBanned
+	}
 
 	sm.streams.addStream(st)
 
@@ -276,6 +286,8 @@ func (sm *streamManager) handleRemoveStream(id sttypes.StreamID) error {
 	}
 
 	sm.streams.deleteStream(st)
+	sm.scores.delete(id)
+	streamScoreGaugeVec.Delete(prometheus.Labels{"topic": string(sm.myProtoID), "stream": string(id)})
 	// if stream number is smaller than HardLoCap, spin up the discover
 	if !sm.hardHaveEnoughStream() {
 		select {
@@ -327,6 +339,9 @@ func (sm *streamManager) discoverAndSetupStream(discCtx context.Context) (int, e
 			// If the peer has the same ID and was just connected, skip.
 			continue
 		}
+		if sm.banCache.Has(peer.ID) {
+			continue
+		}
 		if _, ok := sm.streams.get(sttypes.StreamID(peer.ID)); ok {
 			continue
 		}
@@ -401,3 +416,73 @@ func (sm *streamManager) hardHaveEnoughStream() bool {
 	availStreams := sm.streams.numStreamsWithMinProtoSpec(sm.myProtoSpec)
 	return availStreams >= sm.config.HardLoCap
 }
+
+// RecordResponse records a valid response of the stream with its latency and size in bytes
+func (sm *streamManager) RecordResponse(id sttypes.StreamID, latency time.Duration, size int) {
+	sm.updateScore(id, "", func(s *streamScorer) {
+		s.addResponse(latency, size)
+	})
+}
+
+// RecordTimeout records a request to the stream without a response
+func (sm *streamManager) RecordTimeout(id sttypes.StreamID) {
+	sm.updateScore(id, "request timeout", func(s *streamScorer) {
+		s.addTimeout()
+	})
+}
+
+// RecordInvalidResponse records an invalid response of the stream
+func (sm *streamManager) RecordInvalidResponse(id sttypes.StreamID, reason string) {
+	sm.updateScore(id, reason, func(s *streamScorer) {
+		s.addInvalid()
+	})
+}
+
+// GetStreamScore returns the score of the stream, false if the stream has no record
+func (sm *streamManager) GetStreamScore(id sttypes.StreamID) (StreamScore, bool) {
+	return sm.scores.get(id)
+}
+
+// GetStreamScores returns the scores of the streams with records
+func (sm *streamManager) GetStreamScores() map[sttypes.StreamID]StreamScore {
+	return sm.scores.getAll()
+}
+
+func (sm *streamManager) updateScore(id sttypes.StreamID, reason string, update func(s *streamScorer)) {
+	st, ok := sm.streams.get(id)
+	if !ok {
+		return
+	}
+	score, evict := sm.scores.update(id, update)
+	streamScoreGaugeVec.With(prometheus.Labels{"topic": string(sm.myProtoID), "stream": string(id)}).Set(score.Score)
+	if evict {
+		sm.logger.Warn().
+			Str("stream ID", string(id)).
+			Float64("score", score.Score).
+			Str("reason", reason).
+			Msg("evict stream with low score")
+		go sm.evictStream(st)
+	}
+}
+
+// evictStream bans the peer of the stream for a while, and closes the stream
+func (sm *streamManager) evictStream(st sttypes.Stream) {
+	id := st.ID()
+	sm.banCache.Add(libp2p_peer.ID(id))
+	evictedStreamsCounterVec.With(prometheus.Labels{"topic": string(sm.myProtoID)}).Inc()
+
+	if err := st.Close(); err != nil {
+		sm.logger.Warn().Err(err).Str("stream ID", string(id)).
+			Msg("failed to close evicted stream")
+	}
+	// the stream might have been removed on close
+	task := rmStreamTask{
+		id:   id,
+		errC: make(chan error, 1),
+	}
+	select {
+	case sm.rmStreamCh <- task:
+		<-task.errC
+	case <-sm.ctx.Done():
+	}
+}
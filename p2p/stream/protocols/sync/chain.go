@@ -12,10 +12,12 @@ import (
 	"github.com/harmony-one/harmony/consensus/engine"
 	"github.com/harmony-one/harmony/core/state"
 	"github.com/harmony-one/harmony/core/types"
+	"github.com/harmony-one/harmony/crypto/bls"
 	shardingconfig "github.com/harmony-one/harmony/internal/configs/sharding"
 	"github.com/harmony-one/harmony/internal/utils"
 	"github.com/harmony-one/harmony/internal/utils/keylocker"
 	"github.com/harmony-one/harmony/p2p/stream/protocols/sync/message"
+	"github.com/harmony-one/harmony/shard"
 	"github.com/pkg/errors"
 )
 
@@ -31,6 +33,14 @@ type chainHelper interface {
 	getStorageRanges(root common.Hash, accounts []common.Hash, origin common.Hash, limit common.Hash, bytes uint64) ([]*message.StoragesData, [][]byte, error)
 	getByteCodes(hs []common.Hash, bytes uint64) ([][]byte, error)
 	getTrieNodes(root common.Hash, paths []*message.TrieNodePathSet, bytes uint64, start time.Time) ([][]byte, error)
+	getEpochBlocks(epochs []uint64) ([]*block.Header, [][]byte, error)
+	getCXReceiptsProofs(fromShardID, toShardID uint32, bns []uint64) ([]*types.CXReceiptsProof, error)
+}
+
+// cxReceiptsReader is the chain which keeps the outgoing cross shard receipts
+type cxReceiptsReader interface {
+	ReadCXReceipts(shardID uint32, blockNum uint64, blockHash common.Hash) (types.CXReceipts, error)
+	CXMerkleProof(toShardID uint32, block *block.Header) (*types.CXMerkleProof, error)
 }
 
 type chainHelperImpl struct {
@@ -151,6 +161,93 @@ func (ch *chainHelperImpl) getBlockSigFromNextBlock(header *block.Header) []byte
 	return sb
 }
 
+// getEpochBlocks returns the last block header of each given epoch along with
+// its commit signature. The header carries the shard state of the next epoch.
+// Epochs not finalized yet are returned as nil headers.
+func (ch *chainHelperImpl) getEpochBlocks(epochs []uint64) ([]*block.Header, [][]byte, error) {
+	if ch.chain.ShardID() != shard.BeaconChainShardID {
+		return nil, nil, errors.New("epoch blocks are only served by the beacon chain")
+	}
+	var (
+		curBlock = ch.chain.CurrentHeader().Number().Uint64()
+		headers  = make([]*block.Header, 0, len(epochs))
+		sigs     = make([][]byte, 0, len(epochs))
+	)
+	for _, epoch := range epochs {
+		bn := ch.schedule.EpochLastBlock(epoch)
+		var (
+			header *block.Header
+			sig    []byte
+		)
+		if bn <= curBlock {
+			header = ch.chain.GetHeaderByNumber(bn)
+		}
+		if header != nil {
+			if !header.IsLastBlockInEpoch() {
+				return nil, nil, errors.Errorf("block %d is not the last block of epoch %d", bn, epoch)
+			}
+			var err error
+			if sig, err = ch.getBlockSigAndBitmap(header); err != nil || len(sig) == 0 {
+				// the commit signature is not available yet
+				header, sig = nil, nil
+			}
+		}
+		headers = append(headers, header)
+		sigs = append(sigs, sig)
+	}
+	return headers, sigs, nil
+}
+
+// getCXReceiptsProofs returns the proofs of the cross shard receipts from the
+// given blocks to the destination shard. Blocks without receipts to the shard
+// are returned as nil proofs, and the result stops at the first block which is
+// not finalized yet.
+func (ch *chainHelperImpl) getCXReceiptsProofs(fromShardID, toShardID uint32, bns []uint64) ([]*types.CXReceiptsProof, error) {
+	if ch.chain.ShardID() != fromShardID {
+		return nil, errors.Errorf("cx receipts of shard %d are not served by shard %d", fromShardID, ch.chain.ShardID())
+	}
+	if fromShardID == toShardID {
+		return nil, errors.New("cx receipts to the same shard")
+	}
+	cr, ok := ch.chain.(cxReceiptsReader)
+	if !ok {
+		return nil, errors.New("cx receipts are not served by the chain")
+	}
+	curBlock := ch.chain.CurrentHeader().Number().Uint64()
+	proofs := make([]*types.CXReceiptsProof, 0, len(bns))
+	for _, bn := range bns {
+		if bn > curBlock {
+			break
+		}
+		header := ch.chain.GetHeaderByNumber(bn)
+		if header == nil {
+			break
+		}
+		sig, err := ch.getBlockSigAndBitmap(header)
+		if err != nil || len(sig) <= bls.BLSSignatureSizeInBytes {
+			// the commit signature is not available yet
+			break
+		}
+		receipts, err := cr.ReadCXReceipts(toShardID, bn, header.Hash())
+		if err != nil || len(receipts) == 0 {
+			proofs = append(proofs, nil)
+			continue
+		}
+		merkleProof, err := cr.CXMerkleProof(toShardID, header)
+		if err != nil {
+			return nil, errors.Wrapf(err, "cx merkle proof of block %d", bn)
+		}
+		proofs = append(proofs, &types.CXReceiptsProof{
+			Receipts:     receipts,
+			MerkleProof:  merkleProof,
+			Header:       header,
+			CommitSig:    sig[:bls.BLSSignatureSizeInBytes],
+			CommitBitmap: sig[bls.BLSSignatureSizeInBytes:],
+		})
+	}
+	return proofs, nil
+}
+
 func (ch *chainHelperImpl) getBlockSigFromDB(header *block.Header) ([]byte, error) {
 	return ch.chain.ReadCommitSig(header.Number().Uint64())
 }
@@ -83,6 +83,29 @@ func (ch *testChainHelper) getTrieNodes(root common.Hash, paths []*message.TrieN
 	return testTrieNodes, nil
 }
 
+func (ch *testChainHelper) getEpochBlocks(epochs []uint64) ([]*block.Header, [][]byte, error) {
+	headers := make([]*block.Header, 0, len(epochs))
+	sigs := make([][]byte, 0, len(epochs))
+	for _, epoch := range epochs {
+		headers = append(headers, makeTestEpochHeader(epoch))
+		sigs = append(sigs, numberToHash(epoch).Bytes())
+	}
+	return headers, sigs, nil
+}
+
+func (ch *testChainHelper) getCXReceiptsProofs(fromShardID, toShardID uint32, bns []uint64) ([]*types.CXReceiptsProof, error) {
+	proofs := make([]*types.CXReceiptsProof, 0, len(bns))
+	for _, bn := range bns {
+		if bn%2 == 1 {
+			// odd blocks have no receipts to the shard
+			proofs = append(proofs, nil)
+			continue
+		}
+		proofs = append(proofs, makeTestCXReceiptsProof(fromShardID, toShardID, bn))
+	}
+	return proofs, nil
+}
+
 func checkGetReceiptsResult(b []byte, hs []common.Hash) error {
 	var msg = &syncpb.Message{}
 	if err := protobuf.Unmarshal(b, msg); err != nil {
@@ -157,6 +180,35 @@ func makeTestBlock(bn uint64) *types.Block {
 	return types.NewBlockWithHeader(&block.Header{Header: header})
 }
 
+func makeTestEpochHeader(epoch uint64) *block.Header {
+	header := testHeader.Copy()
+	header.SetEpoch(new(big.Int).SetUint64(epoch))
+	header.SetNumber(new(big.Int).SetUint64((epoch + 1) * 16))
+	return &block.Header{Header: header}
+}
+
+func makeTestCXReceiptsProof(fromShardID, toShardID uint32, bn uint64) *types.CXReceiptsProof {
+	header := testHeader.Copy()
+	header.SetShardID(fromShardID)
+	header.SetNumber(new(big.Int).SetUint64(bn))
+	return &types.CXReceiptsProof{
+		Receipts: types.CXReceipts{
+			{ShardID: fromShardID, ToShardID: toShardID, Amount: big.NewInt(1)},
+		},
+		MerkleProof: &types.CXMerkleProof{
+			BlockNum:      new(big.Int).SetUint64(bn),
+			BlockHash:     header.Hash(),
+			ShardID:       fromShardID,
+			CXReceiptHash: numberToHash(bn),
+			ShardIDs:      []uint32{toShardID},
+			CXShardHashes: []common.Hash{numberToHash(bn)},
+		},
+		Header:       &block.Header{Header: header},
+		CommitSig:    numberToHash(bn).Bytes(),
+		CommitBitmap: []byte{0xff},
+	}
+}
+
 // makeTestReceipts creates fake node data
 func makeTestNodeData(n int) [][]byte {
 	testData := make([][]byte, n)
@@ -404,3 +456,60 @@ func checkTrieNodesResult(hs []common.Hash, bytes uint64, b []byte) error {
 	}
 	return nil
 }
+
+func checkEpochBlocksResult(epochs []uint64, b []byte) error {
+	var msg = &syncpb.Message{}
+	if err := protobuf.Unmarshal(b, msg); err != nil {
+		return err
+	}
+	geResp, err := msg.GetEpochBlocksResponse()
+	if err != nil {
+		return err
+	}
+	if len(geResp.HeadersBytes) != len(epochs) || len(geResp.CommitSig) != len(epochs) {
+		return errors.New("unexpected size")
+	}
+	for i, epoch := range epochs {
+		var header *block.Header
+		if err := rlp.DecodeBytes(geResp.HeadersBytes[i], &header); err != nil {
+			return err
+		}
+		if header.Epoch().Uint64() != epoch {
+			return fmt.Errorf("unexpected epoch %v != %v", header.Epoch(), epoch)
+		}
+		if !bytes.Equal(geResp.CommitSig[i], numberToHash(epoch).Bytes()) {
+			return errors.New("unexpected commit sig")
+		}
+	}
+	return nil
+}
+
+func checkCXReceiptsProofResult(fromShardID uint32, bns []uint64, b []byte) error {
+	var msg = &syncpb.Message{}
+	if err := protobuf.Unmarshal(b, msg); err != nil {
+		return err
+	}
+	cxResp, err := msg.GetCXReceiptsProofResponse()
+	if err != nil {
+		return err
+	}
+	if len(cxResp.ProofsBytes) != len(bns) {
+		return errors.New("unexpected size")
+	}
+	for i, bn := range bns {
+		if bn%2 == 1 {
+			if len(cxResp.ProofsBytes[i]) != 0 {
+				return fmt.Errorf("unexpected proof of block %v", bn)
+			}
+			continue
+		}
+		var proof *types.CXReceiptsProof
+		if err := rlp.DecodeBytes(cxResp.ProofsBytes[i], &proof); err != nil {
+			return err
+		}
+		if proof.Header.ShardID() != fromShardID || proof.Header.Number().Uint64() != bn {
+			return fmt.Errorf("unexpected proof header %v != %v", proof.Header.Number(), bn)
+		}
+	}
+	return nil
+}
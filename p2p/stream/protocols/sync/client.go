@@ -8,12 +8,12 @@ import (
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/rlp"
+	"github.com/harmony-one/harmony/block"
 	"github.com/harmony-one/harmony/core/types"
 	"github.com/harmony-one/harmony/p2p/stream/protocols/sync/message"
 	syncpb "github.com/harmony-one/harmony/p2p/stream/protocols/sync/message"
 	sttypes "github.com/harmony-one/harmony/p2p/stream/types"
 	"github.com/pkg/errors"
-	protobuf "google.golang.org/protobuf/proto"
 )
 
 // GetBlocksByNumber do getBlocksByNumberRequest through sync stream protocol.
@@ -74,6 +74,40 @@ func (p *Protocol) GetRawBlocksByNumber(ctx context.Context, bns []uint64, opts
 	return
 }
 
+// GetRawBlocksByNumberWithLimit do getBlocksByNumberRequest with a bytes limit through sync
+// stream protocol. The stream stops at the first block reaching the limit, so a prefix of
+// the requested blocks is returned. Only the streams with BytesLimitVersion are requested.
+func (p *Protocol) GetRawBlocksByNumberWithLimit(ctx context.Context, bns []uint64, bytes uint64, opts ...Option) (blockBytes [][]byte, sigBytes [][]byte, stid sttypes.StreamID, err error) {
+	timer := p.doMetricClientRequest("getBlocksByNumber")
+	defer p.doMetricPostClientRequest("getBlocksByNumber", err, timer)
+
+	if len(bns) == 0 {
+		err = fmt.Errorf("zero block numbers requested")
+		return
+	}
+	if len(bns) > GetBlocksByNumWithLimitAmountCap {
+		err = fmt.Errorf("number of blocks exceed cap of %v", GetBlocksByNumWithLimitAmountCap)
+		return
+	}
+	if bytes == 0 || bytes > maxBlocksResponseBytes {
+		err = fmt.Errorf("requested bytes out of range: %v", bytes)
+		return
+	}
+	req := newGetBlocksByNumberRequestWithLimit(bns, bytes)
+	resp, stid, err := p.rm.DoRequest(ctx, req, opts...)
+	if err != nil {
+		return
+	}
+
+	sResp, ok := resp.(*syncResponse)
+	if !ok || sResp == nil {
+		err = errors.New("not sync response")
+		return
+	}
+	blockBytes, sigBytes, err = req.parseBlockBytesAndSigs(sResp)
+	return
+}
+
 // GetCurrentBlockNumber get the current block number from remote node
 func (p *Protocol) GetCurrentBlockNumber(ctx context.Context, opts ...Option) (bn uint64, stid sttypes.StreamID, err error) {
 	timer := p.doMetricClientRequest("getBlockNumber")
@@ -159,6 +193,35 @@ func (p *Protocol) GetReceipts(ctx context.Context, hs []common.Hash, opts ...Op
 	return
 }
 
+// GetReceiptsWithLimit do getReceiptsRequest with a bytes limit through sync stream protocol.
+// The stream stops at the receipts of the first block reaching the limit, so the receipts of
+// a prefix of the requested blocks are returned. Only the streams with BytesLimitVersion
+// are requested.
+func (p *Protocol) GetReceiptsWithLimit(ctx context.Context, hs []common.Hash, bytes uint64, opts ...Option) (receipts []types.Receipts, stid sttypes.StreamID, err error) {
+	timer := p.doMetricClientRequest("getReceipts")
+	defer p.doMetricPostClientRequest("getReceipts", err, timer)
+
+	if len(hs) == 0 {
+		err = fmt.Errorf("zero receipt hashes requested")
+		return
+	}
+	if len(hs) > GetReceiptsCap {
+		err = fmt.Errorf("number of requested hashes exceed limit")
+		return
+	}
+	if bytes == 0 || bytes > maxBlocksResponseBytes {
+		err = fmt.Errorf("requested bytes out of range: %v", bytes)
+		return
+	}
+	req := newGetReceiptsRequestWithLimit(hs, bytes)
+	resp, stid, err := p.rm.DoRequest(ctx, req, opts...)
+	if err != nil {
+		return
+	}
+	receipts, err = req.getReceiptsFromResponse(resp)
+	return
+}
+
 // GetNodeData do getNodeData through sync stream protocol.
 // Return the state node data as result, target stream id, and error
 func (p *Protocol) GetNodeData(ctx context.Context, hs []common.Hash, opts ...Option) (data [][]byte, stid sttypes.StreamID, err error) {
@@ -294,10 +357,61 @@ func (p *Protocol) GetTrieNodes(ctx context.Context, root common.Hash, paths []*
 	return
 }
 
+// GetEpochBlocks do getEpochBlocks through sync stream protocol.
+// returns the last block header of each epoch with its commit signature, target stream id, and error.
+// The header of an epoch not finalized by the remote node is nil.
+func (p *Protocol) GetEpochBlocks(ctx context.Context, epochs []uint64, opts ...Option) (headers []*block.Header, sigs [][]byte, stid sttypes.StreamID, err error) {
+	timer := p.doMetricClientRequest("getEpochBlocks")
+	defer p.doMetricPostClientRequest("getEpochBlocks", err, timer)
+
+	if len(epochs) == 0 {
+		err = fmt.Errorf("zero epochs requested")
+		return
+	}
+	if len(epochs) > GetEpochBlocksCap {
+		err = fmt.Errorf("number of epochs exceed cap of %v", GetEpochBlocksCap)
+		return
+	}
+	req := newGetEpochBlocksRequest(epochs)
+	resp, stid, err := p.rm.DoRequest(ctx, req, opts...)
+	if err != nil {
+		return
+	}
+	headers, sigs, err = req.getEpochBlocksFromResponse(resp)
+	return
+}
+
+// GetCXReceiptsProofs do getCXReceiptsProof through sync stream protocol.
+// returns the proofs of the cross shard receipts from the given blocks of the source shard
+// to the destination shard, target stream id, and error.
+// The proof of a block without receipts to the destination shard is nil, and the proofs
+// end at the first block not finalized by the remote node.
+func (p *Protocol) GetCXReceiptsProofs(ctx context.Context, fromShardID, toShardID uint32, bns []uint64, opts ...Option) (proofs []*types.CXReceiptsProof, stid sttypes.StreamID, err error) {
+	timer := p.doMetricClientRequest("getCXReceiptsProof")
+	defer p.doMetricPostClientRequest("getCXReceiptsProof", err, timer)
+
+	if len(bns) == 0 {
+		err = fmt.Errorf("zero block numbers requested")
+		return
+	}
+	if len(bns) > GetCXReceiptsProofCap {
+		err = fmt.Errorf("number of blocks exceed cap of %v", GetCXReceiptsProofCap)
+		return
+	}
+	req := newGetCXReceiptsProofRequest(fromShardID, toShardID, bns)
+	resp, stid, err := p.rm.DoRequest(ctx, req, opts...)
+	if err != nil {
+		return
+	}
+	proofs, err = req.getCXReceiptsProofsFromResponse(resp)
+	return
+}
+
 // getBlocksByNumberRequest is the request for get block by numbers which implements
 // sttypes.Request interface
 type getBlocksByNumberRequest struct {
 	bns   []uint64
+	bytes uint64 // bytes limit of the response, 0 for no limit
 	pbReq *syncpb.Request
 }
 
@@ -309,6 +423,15 @@ func newGetBlocksByNumberRequest(bns []uint64) *getBlocksByNumberRequest {
 	}
 }
 
+func newGetBlocksByNumberRequestWithLimit(bns []uint64, bytes uint64) *getBlocksByNumberRequest {
+	pbReq := syncpb.MakeGetBlocksByNumRequestWithLimit(bns, bytes)
+	return &getBlocksByNumberRequest{
+		bns:   bns,
+		bytes: bytes,
+		pbReq: pbReq,
+	}
+}
+
 func (req *getBlocksByNumberRequest) ReqID() uint64 {
 	return req.pbReq.GetReqId()
 }
@@ -327,12 +450,14 @@ func (req *getBlocksByNumberRequest) String() string {
 }
 
 func (req *getBlocksByNumberRequest) IsSupportedByProto(target sttypes.ProtoSpec) bool {
+	if req.bytes != 0 {
+		return target.Version.GreaterThanOrEqual(BytesLimitVersion)
+	}
 	return target.Version.GreaterThanOrEqual(MinVersion)
 }
 
 func (req *getBlocksByNumberRequest) Encode() ([]byte, error) {
-	msg := syncpb.MakeMessageFromRequest(req.pbReq)
-	return protobuf.Marshal(msg)
+	return encodeRequest(req.pbReq)
 }
 
 func (req *getBlocksByNumberRequest) getBlocksFromResponse(resp sttypes.Response) ([]*types.Block, error) {
@@ -370,6 +495,10 @@ func (req *getBlocksByNumberRequest) parseBlockBytesAndSigs(resp *syncResponse)
 		return nil, nil, fmt.Errorf("commit sigs size not expected: %v / %v",
 			len(gbResp.CommitSig), len(gbResp.BlocksBytes))
 	}
+	if len(gbResp.BlocksBytes) > len(req.bns) {
+		return nil, nil, fmt.Errorf("more blocks delivered than requested: %v / %v",
+			len(gbResp.BlocksBytes), len(req.bns))
+	}
 	return gbResp.BlocksBytes, gbResp.CommitSig, nil
 }
 
@@ -401,8 +530,7 @@ func (req *getBlockNumberRequest) IsSupportedByProto(target sttypes.ProtoSpec) b
 }
 
 func (req *getBlockNumberRequest) Encode() ([]byte, error) {
-	msg := syncpb.MakeMessageFromRequest(req.pbReq)
-	return protobuf.Marshal(msg)
+	return encodeRequest(req.pbReq)
 }
 
 func (req *getBlockNumberRequest) getNumberFromResponse(resp sttypes.Response) (uint64, error) {
@@ -455,8 +583,7 @@ func (req *getBlockHashesRequest) IsSupportedByProto(target sttypes.ProtoSpec) b
 }
 
 func (req *getBlockHashesRequest) Encode() ([]byte, error) {
-	msg := syncpb.MakeMessageFromRequest(req.pbReq)
-	return protobuf.Marshal(msg)
+	return encodeRequest(req.pbReq)
 }
 
 func (req *getBlockHashesRequest) getHashesFromResponse(resp sttypes.Response) ([]common.Hash, error) {
@@ -510,8 +637,7 @@ func (req *getBlocksByHashesRequest) IsSupportedByProto(target sttypes.ProtoSpec
 }
 
 func (req *getBlocksByHashesRequest) Encode() ([]byte, error) {
-	msg := syncpb.MakeMessageFromRequest(req.pbReq)
-	return protobuf.Marshal(msg)
+	return encodeRequest(req.pbReq)
 }
 
 func (req *getBlocksByHashesRequest) getBlocksFromResponse(resp sttypes.Response) ([]*types.Block, error) {
@@ -584,8 +710,7 @@ func (req *getNodeDataRequest) IsSupportedByProto(target sttypes.ProtoSpec) bool
 }
 
 func (req *getNodeDataRequest) Encode() ([]byte, error) {
-	msg := syncpb.MakeMessageFromRequest(req.pbReq)
-	return protobuf.Marshal(msg)
+	return encodeRequest(req.pbReq)
 }
 
 func (req *getNodeDataRequest) getNodeDataFromResponse(resp sttypes.Response) ([][]byte, error) {
@@ -615,6 +740,7 @@ func (req *getNodeDataRequest) parseNodeDataBytes(resp *syncResponse) ([][]byte,
 // sttypes.Request interface
 type getReceiptsRequest struct {
 	hashes []common.Hash
+	bytes  uint64 // bytes limit of the response, 0 for no limit
 	pbReq  *syncpb.Request
 }
 
@@ -626,6 +752,15 @@ func newGetReceiptsRequest(hashes []common.Hash) *getReceiptsRequest {
 	}
 }
 
+func newGetReceiptsRequestWithLimit(hashes []common.Hash, bytes uint64) *getReceiptsRequest {
+	pbReq := syncpb.MakeGetReceiptsRequestWithLimit(hashes, bytes)
+	return &getReceiptsRequest{
+		hashes: hashes,
+		bytes:  bytes,
+		pbReq:  pbReq,
+	}
+}
+
 func (req *getReceiptsRequest) ReqID() uint64 {
 	return req.pbReq.GetReqId()
 }
@@ -644,12 +779,14 @@ func (req *getReceiptsRequest) String() string {
 }
 
 func (req *getReceiptsRequest) IsSupportedByProto(target sttypes.ProtoSpec) bool {
+	if req.bytes != 0 {
+		return target.Version.GreaterThanOrEqual(BytesLimitVersion)
+	}
 	return target.Version.GreaterThanOrEqual(MinVersion)
 }
 
 func (req *getReceiptsRequest) Encode() ([]byte, error) {
-	msg := syncpb.MakeMessageFromRequest(req.pbReq)
-	return protobuf.Marshal(msg)
+	return encodeRequest(req.pbReq)
 }
 
 func (req *getReceiptsRequest) getReceiptsFromResponse(resp sttypes.Response) ([]types.Receipts, error) {
@@ -672,8 +809,15 @@ func (req *getReceiptsRequest) parseGetReceiptsBytes(resp *syncResponse) ([]type
 	if grResp == nil {
 		return nil, errors.New("response not GetReceipts")
 	}
+	if len(grResp.Receipts) > len(req.hashes) {
+		return nil, fmt.Errorf("more receipts delivered than requested: %v / %v",
+			len(grResp.Receipts), len(req.hashes))
+	}
 	receipts := make([]types.Receipts, len(grResp.Receipts))
 	for i, blockReceipts := range grResp.Receipts {
+		if i >= uint64(len(receipts)) {
+			return nil, fmt.Errorf("unexpected receipts index %v", i)
+		}
 		for _, rcptBytes := range blockReceipts.ReceiptBytes {
 			var receipt *types.Receipt
 			if err := rlp.DecodeBytes(rcptBytes, &receipt); err != nil {
@@ -729,8 +873,7 @@ func (req *getAccountRangeRequest) IsSupportedByProto(target sttypes.ProtoSpec)
 }
 
 func (req *getAccountRangeRequest) Encode() ([]byte, error) {
-	msg := syncpb.MakeMessageFromRequest(req.pbReq)
-	return protobuf.Marshal(msg)
+	return encodeRequest(req.pbReq)
 }
 
 func (req *getAccountRangeRequest) getAccountRangeFromResponse(resp sttypes.Response) ([]*message.AccountData, [][]byte, error) {
@@ -809,8 +952,7 @@ func (req *getStorageRangesRequest) IsSupportedByProto(target sttypes.ProtoSpec)
 }
 
 func (req *getStorageRangesRequest) Encode() ([]byte, error) {
-	msg := syncpb.MakeMessageFromRequest(req.pbReq)
-	return protobuf.Marshal(msg)
+	return encodeRequest(req.pbReq)
 }
 
 // []*message.AccountData, []common.Hash
@@ -881,8 +1023,7 @@ func (req *getByteCodesRequest) IsSupportedByProto(target sttypes.ProtoSpec) boo
 }
 
 func (req *getByteCodesRequest) Encode() ([]byte, error) {
-	msg := syncpb.MakeMessageFromRequest(req.pbReq)
-	return protobuf.Marshal(msg)
+	return encodeRequest(req.pbReq)
 }
 
 func (req *getByteCodesRequest) getByteCodesFromResponse(resp sttypes.Response) ([][]byte, error) {
@@ -955,8 +1096,7 @@ func (req *getTrieNodesRequest) IsSupportedByProto(target sttypes.ProtoSpec) boo
 }
 
 func (req *getTrieNodesRequest) Encode() ([]byte, error) {
-	msg := syncpb.MakeMessageFromRequest(req.pbReq)
-	return protobuf.Marshal(msg)
+	return encodeRequest(req.pbReq)
 }
 
 func (req *getTrieNodesRequest) getTrieNodesFromResponse(resp sttypes.Response) ([][]byte, error) {
@@ -985,3 +1125,162 @@ func (req *getTrieNodesRequest) parseGetTrieNodesResponse(resp *syncResponse) ([
 	}
 	return nodes, nil
 }
+
+// getEpochBlocksRequest is the request for get epoch blocks which implements
+// sttypes.Request interface
+type getEpochBlocksRequest struct {
+	epochs []uint64
+	pbReq  *syncpb.Request
+}
+
+func newGetEpochBlocksRequest(epochs []uint64) *getEpochBlocksRequest {
+	pbReq := syncpb.MakeGetEpochBlocksRequest(epochs)
+	return &getEpochBlocksRequest{
+		epochs: epochs,
+		pbReq:  pbReq,
+	}
+}
+
+func (req *getEpochBlocksRequest) ReqID() uint64 {
+	return req.pbReq.GetReqId()
+}
+
+func (req *getEpochBlocksRequest) SetReqID(val uint64) {
+	req.pbReq.ReqId = val
+}
+
+func (req *getEpochBlocksRequest) String() string {
+	ss := make([]string, 0, len(req.epochs))
+	for _, epoch := range req.epochs {
+		ss = append(ss, strconv.FormatUint(epoch, 10))
+	}
+	epochsStr := strings.Join(ss, ",")
+	return fmt.Sprintf("REQUEST [GetEpochBlocks: %s]", epochsStr)
+}
+
+func (req *getEpochBlocksRequest) IsSupportedByProto(target sttypes.ProtoSpec) bool {
+	return target.Version.GreaterThanOrEqual(EpochBlocksVersion)
+}
+
+func (req *getEpochBlocksRequest) Encode() ([]byte, error) {
+	return encodeRequest(req.pbReq)
+}
+
+func (req *getEpochBlocksRequest) getEpochBlocksFromResponse(resp sttypes.Response) ([]*block.Header, [][]byte, error) {
+	sResp, ok := resp.(*syncResponse)
+	if !ok || sResp == nil {
+		return nil, nil, errors.New("not sync response")
+	}
+	return req.parseGetEpochBlocksResponse(sResp)
+}
+
+func (req *getEpochBlocksRequest) parseGetEpochBlocksResponse(resp *syncResponse) ([]*block.Header, [][]byte, error) {
+	if errResp := resp.pb.GetErrorResponse(); errResp != nil {
+		return nil, nil, errors.New(errResp.Error)
+	}
+	geResp := resp.pb.GetGetEpochBlocksResponse()
+	if geResp == nil {
+		return nil, nil, errors.New("response not GetEpochBlocks")
+	}
+	if len(geResp.HeadersBytes) != len(req.epochs) || len(geResp.CommitSig) != len(req.epochs) {
+		return nil, nil, fmt.Errorf("epoch blocks size not expected: %v / %v / %v",
+			len(geResp.HeadersBytes), len(geResp.CommitSig), len(req.epochs))
+	}
+	headers := make([]*block.Header, 0, len(geResp.HeadersBytes))
+	for _, hb := range geResp.HeadersBytes {
+		var header *block.Header
+		if len(hb) != 0 {
+			header = new(block.Header)
+			if err := rlp.DecodeBytes(hb, header); err != nil {
+				return nil, nil, errors.Wrap(err, "[GetEpochBlocksResponse]")
+			}
+		}
+		headers = append(headers, header)
+	}
+	return headers, geResp.CommitSig, nil
+}
+
+// getCXReceiptsProofRequest is the request for get cross shard receipts proofs which
+// implements sttypes.Request interface
+type getCXReceiptsProofRequest struct {
+	fromShardID uint32
+	toShardID   uint32
+	bns         []uint64
+	pbReq       *syncpb.Request
+}
+
+func newGetCXReceiptsProofRequest(fromShardID, toShardID uint32, bns []uint64) *getCXReceiptsProofRequest {
+	pbReq := syncpb.MakeGetCXReceiptsProofRequest(fromShardID, toShardID, bns)
+	return &getCXReceiptsProofRequest{
+		fromShardID: fromShardID,
+		toShardID:   toShardID,
+		bns:         bns,
+		pbReq:       pbReq,
+	}
+}
+
+func (req *getCXReceiptsProofRequest) ReqID() uint64 {
+	return req.pbReq.GetReqId()
+}
+
+func (req *getCXReceiptsProofRequest) SetReqID(val uint64) {
+	req.pbReq.ReqId = val
+}
+
+func (req *getCXReceiptsProofRequest) String() string {
+	ss := make([]string, 0, len(req.bns))
+	for _, bn := range req.bns {
+		ss = append(ss, strconv.FormatUint(bn, 10))
+	}
+	bnsStr := strings.Join(ss, ",")
+	return fmt.Sprintf("REQUEST [GetCXReceiptsProof: %d->%d %s]", req.fromShardID, req.toShardID, bnsStr)
+}
+
+func (req *getCXReceiptsProofRequest) IsSupportedByProto(target sttypes.ProtoSpec) bool {
+	return target.Version.GreaterThanOrEqual(CXReceiptsProofVersion)
+}
+
+func (req *getCXReceiptsProofRequest) Encode() ([]byte, error) {
+	return encodeRequest(req.pbReq)
+}
+
+func (req *getCXReceiptsProofRequest) getCXReceiptsProofsFromResponse(resp sttypes.Response) ([]*types.CXReceiptsProof, error) {
+	sResp, ok := resp.(*syncResponse)
+	if !ok || sResp == nil {
+		return nil, errors.New("not sync response")
+	}
+	return req.parseGetCXReceiptsProofResponse(sResp)
+}
+
+func (req *getCXReceiptsProofRequest) parseGetCXReceiptsProofResponse(resp *syncResponse) ([]*types.CXReceiptsProof, error) {
+	if errResp := resp.pb.GetErrorResponse(); errResp != nil {
+		return nil, errors.New(errResp.Error)
+	}
+	cxResp := resp.pb.GetGetCxReceiptsProofResponse()
+	if cxResp == nil {
+		return nil, errors.New("response not GetCXReceiptsProof")
+	}
+	if len(cxResp.ProofsBytes) > len(req.bns) {
+		return nil, fmt.Errorf("cx receipts proofs size not expected: %v / %v",
+			len(cxResp.ProofsBytes), len(req.bns))
+	}
+	proofs := make([]*types.CXReceiptsProof, 0, len(cxResp.ProofsBytes))
+	for i, pb := range cxResp.ProofsBytes {
+		var proof *types.CXReceiptsProof
+		if len(pb) != 0 {
+			proof = new(types.CXReceiptsProof)
+			if err := rlp.DecodeBytes(pb, proof); err != nil {
+				return nil, errors.Wrap(err, "[GetCXReceiptsProofResponse]")
+			}
+			if proof.ContainsEmptyField() {
+				return nil, errors.New("[GetCXReceiptsProofResponse] proof contains empty field")
+			}
+			if proof.Header.ShardID() != req.fromShardID || proof.Header.Number().Uint64() != req.bns[i] {
+				return nil, fmt.Errorf("[GetCXReceiptsProofResponse] unexpected proof of block %v of shard %v",
+					proof.Header.Number(), proof.Header.ShardID())
+			}
+		}
+		proofs = append(proofs, proof)
+	}
+	return proofs, nil
+}
@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/event"
@@ -18,12 +19,15 @@ import (
 	"github.com/harmony-one/harmony/p2p/stream/protocols/sync/message"
 	syncpb "github.com/harmony-one/harmony/p2p/stream/protocols/sync/message"
 	sttypes "github.com/harmony-one/harmony/p2p/stream/types"
+	"github.com/hashicorp/go-version"
 )
 
 var (
 	_ sttypes.Request  = &getBlocksByNumberRequest{}
 	_ sttypes.Request  = &getBlockNumberRequest{}
 	_ sttypes.Request  = &getReceiptsRequest{}
+	_ sttypes.Request  = &getEpochBlocksRequest{}
+	_ sttypes.Request  = &getCXReceiptsProofRequest{}
 	_ sttypes.Response = &syncResponse{&syncpb.Response{}}
 	// MaxHash represents the maximum possible hash value.
 	MaxHash = common.HexToHash("0xffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff")
@@ -132,6 +136,11 @@ var (
 
 	testTrieNodesResponse = syncpb.MakeGetTrieNodesResponse(0, testTrieNodes)
 
+	testEpochBlocksResponse = syncpb.MakeGetEpochBlocksResponse(0, [][]byte{testHeaderBytes, nil}, [][]byte{testHash.Bytes(), nil})
+
+	testCXReceiptsProofBytes, _ = rlp.EncodeToBytes(makeTestCXReceiptsProof(1, 0, 2))
+	testCXReceiptsProofResponse = syncpb.MakeGetCXReceiptsProofResponse(0, [][]byte{testCXReceiptsProofBytes, nil})
+
 	testErrorResponse = syncpb.MakeErrorResponse(0, errors.New("test error"))
 )
 
@@ -192,6 +201,84 @@ func TestProtocol_GetBlocksByNumber(t *testing.T) {
 	}
 }
 
+func TestProtocol_GetRawBlocksByNumberWithLimit(t *testing.T) {
+	tests := []struct {
+		getResponse getResponseFn
+		bns         []uint64
+		expErr      error
+		expBlocks   int
+	}{
+		{
+			getResponse: func(request sttypes.Request) (sttypes.Response, sttypes.StreamID) {
+				return &syncResponse{
+					pb: testBlockResponse,
+				}, makeTestStreamID(0)
+			},
+			bns:       []uint64{0, 1},
+			expErr:    nil,
+			expBlocks: 1,
+		},
+		{
+			getResponse: func(request sttypes.Request) (sttypes.Response, sttypes.StreamID) {
+				return &syncResponse{
+					pb: syncpb.MakeGetBlocksByNumResponse(0, [][]byte{testBlockBytes, testBlockBytes}, make([][]byte, 2)),
+				}, makeTestStreamID(0)
+			},
+			bns:    []uint64{0},
+			expErr: errors.New("more blocks delivered than requested"),
+		},
+		{
+			getResponse: func(request sttypes.Request) (sttypes.Response, sttypes.StreamID) {
+				return &syncResponse{
+					pb: testErrorResponse,
+				}, makeTestStreamID(0)
+			},
+			bns:    []uint64{0},
+			expErr: errors.New("test error"),
+		},
+	}
+
+	for i, test := range tests {
+		protocol := makeTestProtocol(test.getResponse)
+		blockBytes, _, _, err := protocol.GetRawBlocksByNumberWithLimit(context.Background(), test.bns, 1024)
+
+		if assErr := assertError(err, test.expErr); assErr != nil {
+			t.Errorf("Test %v: %v", i, assErr)
+			continue
+		}
+		if test.expErr == nil && len(blockBytes) != test.expBlocks {
+			t.Errorf("Test %v: unexpected blocks: %v / %v", i, len(blockBytes), test.expBlocks)
+		}
+	}
+}
+
+func TestGetBlocksByNumberRequest_IsSupportedByProto(t *testing.T) {
+	tests := []struct {
+		req   *getBlocksByNumberRequest
+		v     *version.Version
+		expOK bool
+	}{
+		{newGetBlocksByNumberRequest([]uint64{1}), version100, true},
+		{newGetBlocksByNumberRequestWithLimit([]uint64{1}, 1024), version100, false},
+		{newGetBlocksByNumberRequestWithLimit([]uint64{1}, 1024), version110, true},
+	}
+	for i, test := range tests {
+		if ok := test.req.IsSupportedByProto(sttypes.ProtoSpec{Version: test.v}); ok != test.expOK {
+			t.Errorf("Test %v: unexpected supported: %v / %v", i, ok, test.expOK)
+		}
+	}
+}
+
+func TestGetEpochBlocksRequest_IsSupportedByProto(t *testing.T) {
+	req := newGetEpochBlocksRequest([]uint64{1})
+	if req.IsSupportedByProto(sttypes.ProtoSpec{Version: version100}) {
+		t.Errorf("epoch blocks request supported by %v", version100)
+	}
+	if !req.IsSupportedByProto(sttypes.ProtoSpec{Version: version110}) {
+		t.Errorf("epoch blocks request not supported by %v", version110)
+	}
+}
+
 func TestProtocol_GetCurrentBlockNumber(t *testing.T) {
 	tests := []struct {
 		getResponse getResponseFn
@@ -754,6 +841,153 @@ func TestProtocol_GetTrieNodes(t *testing.T) {
 	}
 }
 
+func TestProtocol_GetEpochBlocks(t *testing.T) {
+	tests := []struct {
+		getResponse getResponseFn
+		expErr      error
+		expStID     sttypes.StreamID
+	}{
+		{
+			getResponse: func(request sttypes.Request) (sttypes.Response, sttypes.StreamID) {
+				return &syncResponse{
+					pb: testEpochBlocksResponse,
+				}, makeTestStreamID(0)
+			},
+			expErr:  nil,
+			expStID: makeTestStreamID(0),
+		},
+		{
+			getResponse: func(request sttypes.Request) (sttypes.Response, sttypes.StreamID) {
+				return &syncResponse{
+					pb: testBlockResponse,
+				}, makeTestStreamID(0)
+			},
+			expErr:  errors.New("response not GetEpochBlocks"),
+			expStID: makeTestStreamID(0),
+		},
+		{
+			getResponse: nil,
+			expErr:      errors.New("get response error"),
+			expStID:     "",
+		},
+		{
+			getResponse: func(request sttypes.Request) (sttypes.Response, sttypes.StreamID) {
+				return &syncResponse{
+					pb: testErrorResponse,
+				}, makeTestStreamID(0)
+			},
+			expErr:  errors.New("test error"),
+			expStID: makeTestStreamID(0),
+		},
+	}
+
+	for i, test := range tests {
+		protocol := makeTestProtocol(test.getResponse)
+		headers, sigs, stid, err := protocol.GetEpochBlocks(context.Background(), []uint64{0, 1})
+
+		if assErr := assertError(err, test.expErr); assErr != nil {
+			t.Errorf("Test %v: %v", i, assErr)
+			continue
+		}
+		if stid != test.expStID {
+			t.Errorf("Test %v: unexpected st id: %v / %v", i, stid, test.expStID)
+		}
+		if test.expErr == nil {
+			if len(headers) != 2 || len(sigs) != 2 {
+				t.Errorf("Test %v: size not 2", i)
+				continue
+			}
+			if headers[0] == nil || headers[0].Hash() != testHeader.Hash() {
+				t.Errorf("Test %v: unexpected header", i)
+			}
+			if headers[1] != nil {
+				t.Errorf("Test %v: header of unfinalized epoch not nil", i)
+			}
+		}
+	}
+}
+
+func TestProtocol_GetCXReceiptsProofs(t *testing.T) {
+	tests := []struct {
+		bns         []uint64
+		getResponse getResponseFn
+		expErr      error
+		expStID     sttypes.StreamID
+	}{
+		{
+			bns: []uint64{2, 3},
+			getResponse: func(request sttypes.Request) (sttypes.Response, sttypes.StreamID) {
+				return &syncResponse{
+					pb: testCXReceiptsProofResponse,
+				}, makeTestStreamID(0)
+			},
+			expErr:  nil,
+			expStID: makeTestStreamID(0),
+		},
+		{
+			bns: []uint64{4, 5},
+			getResponse: func(request sttypes.Request) (sttypes.Response, sttypes.StreamID) {
+				return &syncResponse{
+					pb: testCXReceiptsProofResponse,
+				}, makeTestStreamID(0)
+			},
+			expErr:  errors.New("[GetCXReceiptsProofResponse] unexpected proof of block 2 of shard 1"),
+			expStID: makeTestStreamID(0),
+		},
+		{
+			bns: []uint64{2},
+			getResponse: func(request sttypes.Request) (sttypes.Response, sttypes.StreamID) {
+				return &syncResponse{
+					pb: testCXReceiptsProofResponse,
+				}, makeTestStreamID(0)
+			},
+			expErr:  errors.New("cx receipts proofs size not expected: 2 / 1"),
+			expStID: makeTestStreamID(0),
+		},
+		{
+			bns: []uint64{2, 3},
+			getResponse: func(request sttypes.Request) (sttypes.Response, sttypes.StreamID) {
+				return &syncResponse{
+					pb: testEpochBlocksResponse,
+				}, makeTestStreamID(0)
+			},
+			expErr:  errors.New("response not GetCXReceiptsProof"),
+			expStID: makeTestStreamID(0),
+		},
+		{
+			bns:         []uint64{2, 3},
+			getResponse: nil,
+			expErr:      errors.New("get response error"),
+			expStID:     "",
+		},
+	}
+
+	for i, test := range tests {
+		protocol := makeTestProtocol(test.getResponse)
+		proofs, stid, err := protocol.GetCXReceiptsProofs(context.Background(), 1, 0, test.bns)
+
+		if assErr := assertError(err, test.expErr); assErr != nil {
+			t.Errorf("Test %v: %v", i, assErr)
+			continue
+		}
+		if stid != test.expStID {
+			t.Errorf("Test %v: unexpected st id: %v / %v", i, stid, test.expStID)
+		}
+		if test.expErr == nil {
+			if len(proofs) != 2 {
+				t.Errorf("Test %v: size not 2", i)
+				continue
+			}
+			if proofs[0] == nil || proofs[0].Header.Number().Uint64() != 2 {
+				t.Errorf("Test %v: unexpected proof", i)
+			}
+			if proofs[1] != nil {
+				t.Errorf("Test %v: proof of block without receipts not nil", i)
+			}
+		}
+	}
+}
+
 type getResponseFn func(request sttypes.Request) (sttypes.Response, sttypes.StreamID)
 
 type testHostRequestManager struct {
@@ -844,6 +1078,18 @@ func (sm *testStreamManager) GetStreamByID(id sttypes.StreamID) (sttypes.Stream,
 	return nil, false
 }
 
+func (sm *testStreamManager) RecordResponse(sttypes.StreamID, time.Duration, int) {}
+func (sm *testStreamManager) RecordTimeout(sttypes.StreamID)                      {}
+func (sm *testStreamManager) RecordInvalidResponse(sttypes.StreamID, string)      {}
+
+func (sm *testStreamManager) GetStreamScore(sttypes.StreamID) (streammanager.StreamScore, bool) {
+	return streammanager.StreamScore{}, false
+}
+
+func (sm *testStreamManager) GetStreamScores() map[sttypes.StreamID]streammanager.StreamScore {
+	return nil
+}
+
 func assertError(got, expect error) error {
 	if (got == nil) != (expect == nil) {
 		return fmt.Errorf("unexpected error: %v / %v", got, expect)
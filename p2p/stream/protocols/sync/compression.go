@@ -0,0 +1,112 @@
+package sync
+
+import (
+	"fmt"
+
+	"github.com/golang/snappy"
+	syncpb "github.com/harmony-one/harmony/p2p/stream/protocols/sync/message"
+	"github.com/klauspost/compress/zstd"
+	"github.com/pkg/errors"
+	protobuf "google.golang.org/protobuf/proto"
+)
+
+const (
+	// minCompressionSize is the minimum size of an encoded response to be compressed.
+	// Smaller responses are sent as is since the compression gains little on them.
+	minCompressionSize = 1024
+
+	// maxDecompressedSize is the maximum size of a decompressed response, which
+	// follows maxMsgBytes as 20MB defined in github.com/harmony-one/harmony/p2p/stream/types.
+	maxDecompressedSize = 20 * 1024 * 1024
+)
+
+var (
+	// supportedCompressions are the response compressions supported by the local
+	// node, in the order of preference.
+	supportedCompressions = []syncpb.Compression{
+		syncpb.Compression_ZSTD,
+		syncpb.Compression_SNAPPY,
+	}
+
+	zstdEncoder, _ = zstd.NewWriter(nil)
+	zstdDecoder, _ = zstd.NewReader(nil, zstd.WithDecoderMaxMemory(maxDecompressedSize))
+)
+
+// negotiateCompression returns the first compression accepted by the requester
+// which is supported by the local node, NO_COMPRESSION if there is none.
+func negotiateCompression(accepted []syncpb.Compression) syncpb.Compression {
+	for _, c := range accepted {
+		for _, supported := range supportedCompressions {
+			if c == supported {
+				return c
+			}
+		}
+	}
+	return syncpb.Compression_NO_COMPRESSION
+}
+
+func compress(c syncpb.Compression, b []byte) ([]byte, error) {
+	switch c {
+	case syncpb.Compression_SNAPPY:
+		return snappy.Encode(nil, b), nil
+	case syncpb.Compression_ZSTD:
+		return zstdEncoder.EncodeAll(b, nil), nil
+	default:
+		return nil, fmt.Errorf("unsupported compression %v", c)
+	}
+}
+
+func decompress(c syncpb.Compression, b []byte) ([]byte, error) {
+	switch c {
+	case syncpb.Compression_SNAPPY:
+		size, err := snappy.DecodedLen(b)
+		if err != nil {
+			return nil, err
+		}
+		if size > maxDecompressedSize {
+			return nil, fmt.Errorf("decompressed size exceed limit: %v > %v", size, maxDecompressedSize)
+		}
+		return snappy.Decode(nil, b)
+	case syncpb.Compression_ZSTD:
+		return zstdDecoder.DecodeAll(b, nil)
+	default:
+		return nil, fmt.Errorf("unsupported compression %v", c)
+	}
+}
+
+// encodeRequest encodes the request message which accepts the compressed responses
+func encodeRequest(pbReq *syncpb.Request) ([]byte, error) {
+	pbReq.AcceptCompressions = supportedCompressions
+	msg := syncpb.MakeMessageFromRequest(pbReq)
+	return protobuf.Marshal(msg)
+}
+
+// compressResponse compresses the encoded response with the given compression.
+// Returns the encoded response message as is if it is too small to be compressed.
+func compressResponse(c syncpb.Compression, resp *syncpb.Response) (*syncpb.Message, error) {
+	b, err := protobuf.Marshal(resp)
+	if err != nil {
+		return nil, err
+	}
+	if len(b) < minCompressionSize {
+		return syncpb.MakeMessageFromResponse(resp), nil
+	}
+	cb, err := compress(c, b)
+	if err != nil {
+		return nil, err
+	}
+	return syncpb.MakeCompressedResponseMessage(c, cb), nil
+}
+
+// decompressResponse decodes the response from the compressed response
+func decompressResponse(cResp *syncpb.CompressedResponse) (*syncpb.Response, error) {
+	b, err := decompress(cResp.Compression, cResp.RespBytes)
+	if err != nil {
+		return nil, errors.Wrap(err, "decompress response")
+	}
+	resp := &syncpb.Response{}
+	if err := protobuf.Unmarshal(b, resp); err != nil {
+		return nil, errors.Wrap(err, "decode decompressed response")
+	}
+	return resp, nil
+}
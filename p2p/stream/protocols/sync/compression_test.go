@@ -0,0 +1,81 @@
+package sync
+
+import (
+	"bytes"
+	"testing"
+
+	syncpb "github.com/harmony-one/harmony/p2p/stream/protocols/sync/message"
+)
+
+func TestNegotiateCompression(t *testing.T) {
+	tests := []struct {
+		accepted []syncpb.Compression
+		exp      syncpb.Compression
+	}{
+		{nil, syncpb.Compression_NO_COMPRESSION},
+		{[]syncpb.Compression{syncpb.Compression_SNAPPY}, syncpb.Compression_SNAPPY},
+		{[]syncpb.Compression{syncpb.Compression_SNAPPY, syncpb.Compression_ZSTD}, syncpb.Compression_SNAPPY},
+		{[]syncpb.Compression{syncpb.Compression(100), syncpb.Compression_ZSTD}, syncpb.Compression_ZSTD},
+		{[]syncpb.Compression{syncpb.Compression(100)}, syncpb.Compression_NO_COMPRESSION},
+	}
+	for i, test := range tests {
+		if c := negotiateCompression(test.accepted); c != test.exp {
+			t.Errorf("Test %v: unexpected compression: %v / %v", i, c, test.exp)
+		}
+	}
+}
+
+func TestCompressResponse(t *testing.T) {
+	blocksBytes := [][]byte{bytes.Repeat([]byte{1}, 2*minCompressionSize), testBlockBytes}
+	sigs := [][]byte{nil, nil}
+	resp := syncpb.MakeGetBlocksByNumResponse(1, blocksBytes, sigs)
+
+	for _, c := range supportedCompressions {
+		msg, err := compressResponse(c, resp)
+		if err != nil {
+			t.Fatal(err)
+		}
+		cResp := msg.GetCompressedResp()
+		if cResp == nil || cResp.Compression != c {
+			t.Fatalf("response not compressed with %v", c)
+		}
+		decompressed, err := decompressResponse(cResp)
+		if err != nil {
+			t.Fatal(err)
+		}
+		gbResp := decompressed.GetGetBlocksByNumResponse()
+		if decompressed.ReqId != 1 || gbResp == nil || len(gbResp.BlocksBytes) != len(blocksBytes) {
+			t.Fatalf("unexpected decompressed response of %v", c)
+		}
+		for i := range blocksBytes {
+			if !bytes.Equal(gbResp.BlocksBytes[i], blocksBytes[i]) {
+				t.Errorf("unexpected block bytes %v of %v", i, c)
+			}
+		}
+	}
+}
+
+func TestCompressResponse_Small(t *testing.T) {
+	resp := syncpb.MakeGetBlockNumberResponse(1, 100)
+
+	msg, err := compressResponse(syncpb.Compression_ZSTD, resp)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if msg.GetCompressedResp() != nil || msg.GetResp() == nil {
+		t.Errorf("small response compressed")
+	}
+}
+
+func TestDecompressResponse_Invalid(t *testing.T) {
+	tests := []*syncpb.CompressedResponse{
+		{Compression: syncpb.Compression_SNAPPY, RespBytes: []byte{1, 2, 3}},
+		{Compression: syncpb.Compression_ZSTD, RespBytes: []byte{1, 2, 3}},
+		{Compression: syncpb.Compression_NO_COMPRESSION, RespBytes: []byte{1, 2, 3}},
+	}
+	for i, test := range tests {
+		if _, err := decompressResponse(test); err == nil {
+			t.Errorf("Test %v: expect error", i)
+		}
+	}
+}
@@ -12,6 +12,11 @@ const (
 	// so the max size of a stream message is capped at 2MB * 10 = 20MB.
 	GetBlocksByNumAmountCap = 10
 
+	// GetBlocksByNumWithLimitAmountCap is the cap of request of a single GetBlocksByNum request
+	// with a bytes limit. The response stops at the bytes limit, so the cap only bounds the
+	// number of disk lookups when the blocks are small.
+	GetBlocksByNumWithLimitAmountCap = 128
+
 	// GetBlocksByHashesAmountCap is the cap of request of single GetBlocksByHashes request
 	// This number has an effect on maxMsgBytes as 20MB defined in github.com/harmony-one/harmony/p2p/stream/types.
 	// See comments for GetBlocksByNumAmountCap.
@@ -37,6 +42,21 @@ const (
 	// This number has an effect on maxMsgBytes as 20MB defined in github.com/harmony-one/harmony/p2p/stream/types.
 	GetTrieNodesRequestCap = 128
 
+	// GetEpochBlocksCap is the cap of request of single GetEpochBlocks request.
+	// Each epoch block header carries the shard state of the next epoch, which is
+	// well below 1MB, so the response stays within maxMsgBytes as 20MB.
+	GetEpochBlocksCap = 16
+
+	// GetCXReceiptsProofCap is the cap of request of single GetCXReceiptsProof request.
+	// Each proof carries a block header and the receipts of the block to one shard,
+	// so the cap follows GetBlocksByNumAmountCap.
+	GetCXReceiptsProofCap = 10
+
+	// maxBlocksResponseBytes is the maximum bytes limit of GetBlocksByNum and GetReceipts
+	// requests. The response stops at the first block reaching the limit, which is smaller
+	// than 2MB, so the response stays within maxMsgBytes as 20MB.
+	maxBlocksResponseBytes = 16 * 1024 * 1024
+
 	// stateLookupSlack defines the ratio by how much a state response can exceed
 	// the requested limit in order to try and avoid breaking up contracts into
 	// multiple packages and proving them.
@@ -0,0 +1,204 @@
+// Package light implements a light client which follows the committees of the
+// network with the epoch blocks served by the sync stream protocol. Starting
+// from the genesis, each epoch block is verified against the committee elected
+// by the previous one, and only the committees of the current epoch are kept.
+// The client may also start from a trusted checkpoint instead of the genesis.
+package light
+
+import (
+	"context"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/pkg/errors"
+
+	"github.com/harmony-one/harmony/block"
+	"github.com/harmony-one/harmony/consensus/quorum"
+	"github.com/harmony-one/harmony/consensus/signature"
+	"github.com/harmony-one/harmony/internal/chain"
+	"github.com/harmony-one/harmony/internal/params"
+	syncproto "github.com/harmony-one/harmony/p2p/stream/protocols/sync"
+	sttypes "github.com/harmony-one/harmony/p2p/stream/types"
+	"github.com/harmony-one/harmony/shard"
+)
+
+var (
+	// ErrNotGenesis is returned when the trusted header is not a genesis header
+	ErrNotGenesis = errors.New("header is not a genesis header")
+	// ErrNotBeaconChain is returned when an epoch block is not of the beacon chain
+	ErrNotBeaconChain = errors.New("epoch block is not of the beacon chain")
+	// ErrNotEpochBlock is returned when the header does not carry the shard
+	// state of the next epoch
+	ErrNotEpochBlock = errors.New("header is not the last block of the epoch")
+	// ErrUnexpectedEpoch is returned when the header is not of the current epoch
+	ErrUnexpectedEpoch = errors.New("header is not of the current epoch")
+	// ErrQuorumNotAchieved is returned when the signers are not a quorum of the
+	// committee
+	ErrQuorumNotAchieved = errors.New("not enough signature collected")
+	// ErrInvalidSignature is returned when the aggregated signature does not
+	// match the header
+	ErrInvalidSignature = errors.New("invalid aggregated signature")
+)
+
+// EpochBlocksFetcher fetches the last block header of each epoch from the
+// network along with its commit signature, which is implemented by
+// sync.Protocol.
+type EpochBlocksFetcher interface {
+	GetEpochBlocks(ctx context.Context, epochs []uint64, opts ...syncproto.Option) ([]*block.Header, [][]byte, sttypes.StreamID, error)
+}
+
+// Client is the light client of the committee chain. It is not thread safe.
+type Client struct {
+	config *params.ChainConfig
+	epoch  *big.Int
+	state  *shard.State
+	head   *block.Header
+}
+
+// NewClient creates a light client starting from the trusted genesis header,
+// which carries the committees of the first epoch.
+func NewClient(config *params.ChainConfig, genesis *block.Header) (*Client, error) {
+	if genesis.Number().Sign() != 0 {
+		return nil, ErrNotGenesis
+	}
+	state, err := shard.DecodeWrapper(genesis.ShardState())
+	if err != nil {
+		return nil, errors.Wrap(err, "decode genesis shard state")
+	}
+	return &Client{
+		config: config,
+		epoch:  new(big.Int).Set(genesis.Epoch()),
+		state:  state,
+		head:   genesis,
+	}, nil
+}
+
+// NewClientFromCheckpoint creates a light client starting from a trusted last
+// beacon chain block of an epoch, which carries the committees of the next
+// epoch.
+func NewClientFromCheckpoint(config *params.ChainConfig, header *block.Header) (*Client, error) {
+	if header.ShardID() != shard.BeaconChainShardID {
+		return nil, ErrNotBeaconChain
+	}
+	if !header.IsLastBlockInEpoch() {
+		return nil, ErrNotEpochBlock
+	}
+	state, err := shard.DecodeWrapper(header.ShardState())
+	if err != nil {
+		return nil, errors.Wrap(err, "decode checkpoint shard state")
+	}
+	return &Client{
+		config: config,
+		epoch:  new(big.Int).Add(header.Epoch(), common.Big1),
+		state:  state,
+		head:   header,
+	}, nil
+}
+
+// Epoch returns the epoch of the current committees
+func (c *Client) Epoch() *big.Int {
+	return new(big.Int).Set(c.epoch)
+}
+
+// Head returns the last verified epoch block, or the trusted header
+func (c *Client) Head() *block.Header {
+	return c.head
+}
+
+// Committee returns the committee of the shard in the current epoch
+func (c *Client) Committee(shardID uint32) (*shard.Committee, error) {
+	return c.state.FindCommitteeByID(shardID)
+}
+
+// VerifyHeader verifies the commit signature of a header of any shard in the
+// current epoch.
+func (c *Client) VerifyHeader(header *block.Header, commitSig []byte) error {
+	if header.Epoch().Cmp(c.epoch) != 0 {
+		return errors.Wrapf(ErrUnexpectedEpoch, "have %v, want %v", header.Epoch(), c.epoch)
+	}
+	committee, err := c.state.FindCommitteeByID(header.ShardID())
+	if err != nil {
+		return err
+	}
+	return verifyCommitSig(c.config, committee, header, commitSig)
+}
+
+// VerifyEpochBlock verifies the last beacon chain block of the current epoch
+// and moves the client to the committees it elects for the next epoch.
+func (c *Client) VerifyEpochBlock(header *block.Header, commitSig []byte) error {
+	if header.ShardID() != shard.BeaconChainShardID {
+		return ErrNotBeaconChain
+	}
+	if !header.IsLastBlockInEpoch() {
+		return ErrNotEpochBlock
+	}
+	if err := c.VerifyHeader(header, commitSig); err != nil {
+		return err
+	}
+	state, err := shard.DecodeWrapper(header.ShardState())
+	if err != nil {
+		return errors.Wrap(err, "decode shard state")
+	}
+	c.epoch = new(big.Int).Add(header.Epoch(), common.Big1)
+	c.state = state
+	c.head = header
+	return nil
+}
+
+// Sync fetches and verifies the epoch blocks until the remote node has no
+// more finalized epoch to serve. It returns the number of verified epochs.
+func (c *Client) Sync(ctx context.Context, fetcher EpochBlocksFetcher) (int, error) {
+	var verified int
+	for {
+		epochs := make([]uint64, 0, syncproto.GetEpochBlocksCap)
+		for i := uint64(0); i < syncproto.GetEpochBlocksCap; i++ {
+			epochs = append(epochs, c.epoch.Uint64()+i)
+		}
+		headers, sigs, stid, err := fetcher.GetEpochBlocks(ctx, epochs)
+		if err != nil {
+			return verified, err
+		}
+		for i, header := range headers {
+			if header == nil {
+				return verified, nil
+			}
+			if err := c.VerifyEpochBlock(header, sigs[i]); err != nil {
+				return verified, errors.Wrapf(err, "epoch %v from stream %v", epochs[i], stid)
+			}
+			verified++
+		}
+		if len(headers) < len(epochs) {
+			return verified, nil
+		}
+	}
+}
+
+// verifyCommitSig checks the aggregated signature of the header is signed by
+// a quorum of the committee
+func verifyCommitSig(config *params.ChainConfig, committee *shard.Committee, header *block.Header, commitSig []byte) error {
+	sig, bitmap, err := chain.ParseCommitSigAndBitmap(commitSig)
+	if err != nil {
+		return err
+	}
+	pubKeys, err := committee.BLSPublicKeys()
+	if err != nil {
+		return err
+	}
+	aggSig, mask, err := chain.DecodeSigBitmap(sig, bitmap, pubKeys)
+	if err != nil {
+		return errors.Wrap(err, "deserialize signature and bitmap")
+	}
+	epoch := header.Epoch()
+	verifier, err := quorum.NewVerifier(committee, epoch, config.IsStaking(epoch))
+	if err != nil {
+		return err
+	}
+	if !verifier.IsQuorumAchievedByMask(mask) {
+		return ErrQuorumNotAchieved
+	}
+	payload := signature.ConstructCommitPayload(config, epoch, header.Hash(), header.Number().Uint64(), header.ViewID().Uint64())
+	if !aggSig.VerifyHash(mask.AggregatePublic, payload) {
+		return ErrInvalidSignature
+	}
+	return nil
+}
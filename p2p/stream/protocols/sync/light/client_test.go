@@ -0,0 +1,187 @@
+package light
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	bls_core "github.com/harmony-one/bls/ffi/go/bls"
+	"github.com/pkg/errors"
+
+	"github.com/harmony-one/harmony/block"
+	blockfactory "github.com/harmony-one/harmony/block/factory"
+	"github.com/harmony-one/harmony/consensus/signature"
+	"github.com/harmony-one/harmony/crypto/bls"
+	"github.com/harmony-one/harmony/internal/params"
+	syncproto "github.com/harmony-one/harmony/p2p/stream/protocols/sync"
+	sttypes "github.com/harmony-one/harmony/p2p/stream/types"
+	"github.com/harmony-one/harmony/shard"
+)
+
+// epochs 0 and 1 are before staking on the localnet
+var testConfig = params.LocalnetChainConfig
+
+type testCommittee []*bls_core.SecretKey
+
+func newTestCommittee(size int) testCommittee {
+	keys := make(testCommittee, 0, size)
+	for i := 0; i < size; i++ {
+		keys = append(keys, bls.RandPrivateKey())
+	}
+	return keys
+}
+
+func (tc testCommittee) shardState(epoch int64) []byte {
+	committee := shard.Committee{ShardID: shard.BeaconChainShardID}
+	for _, key := range tc {
+		var pub bls.SerializedPublicKey
+		copy(pub[:], key.GetPublicKey().Serialize())
+		committee.Slots = append(committee.Slots, shard.Slot{BLSPublicKey: pub})
+	}
+	b, err := shard.EncodeWrapper(shard.State{
+		Epoch:  big.NewInt(epoch),
+		Shards: []shard.Committee{committee},
+	}, false)
+	if err != nil {
+		panic(err)
+	}
+	return b
+}
+
+// sign returns the commit signature and bitmap of the header signed by the
+// whole committee
+func (tc testCommittee) sign(header *block.Header) []byte {
+	payload := signature.ConstructCommitPayload(testConfig, header.Epoch(), header.Hash(),
+		header.Number().Uint64(), header.ViewID().Uint64())
+	sigs := make([]*bls_core.Sign, 0, len(tc))
+	for _, key := range tc {
+		sigs = append(sigs, key.SignHash(payload))
+	}
+	bitmap := make([]byte, (len(tc)+7)>>3)
+	for i := range tc {
+		bitmap[i>>3] |= 1 << uint(i&7)
+	}
+	return append(bls.AggregateSig(sigs).Serialize(), bitmap...)
+}
+
+func makeTestHeader(number, epoch int64, shardState []byte) *block.Header {
+	return blockfactory.NewTestHeader().With().
+		Number(big.NewInt(number)).
+		Epoch(big.NewInt(epoch)).
+		ShardState(shardState).
+		Header()
+}
+
+func TestClient_VerifyEpochBlock(t *testing.T) {
+	var (
+		committee0 = newTestCommittee(4)
+		committee1 = newTestCommittee(4)
+	)
+	genesis := makeTestHeader(0, 0, committee0.shardState(0))
+	client, err := NewClient(testConfig, genesis)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	header := makeTestHeader(5, 0, nil)
+	if err := client.VerifyHeader(header, committee0.sign(header)); err != nil {
+		t.Fatal(err)
+	}
+	if err := client.VerifyEpochBlock(header, committee0.sign(header)); err != ErrNotEpochBlock {
+		t.Fatalf("have %v, want %v", err, ErrNotEpochBlock)
+	}
+
+	epochBlock := makeTestHeader(10, 0, committee1.shardState(1))
+	if err := client.VerifyEpochBlock(epochBlock, committee1.sign(epochBlock)); errors.Cause(err) != ErrInvalidSignature {
+		t.Fatalf("have %v, want %v", err, ErrInvalidSignature)
+	}
+	if err := client.VerifyEpochBlock(epochBlock, committee0.sign(epochBlock)); err != nil {
+		t.Fatal(err)
+	}
+	if client.Epoch().Uint64() != 1 || client.Head().Hash() != epochBlock.Hash() {
+		t.Fatalf("client not moved to epoch 1: epoch %v", client.Epoch())
+	}
+
+	// headers of the new epoch are signed by the elected committee
+	header = makeTestHeader(11, 1, nil)
+	if err := client.VerifyHeader(header, committee0.sign(header)); errors.Cause(err) != ErrInvalidSignature {
+		t.Fatalf("have %v, want %v", err, ErrInvalidSignature)
+	}
+	if err := client.VerifyHeader(header, committee1.sign(header)); err != nil {
+		t.Fatal(err)
+	}
+	header = makeTestHeader(6, 0, nil)
+	if err := client.VerifyHeader(header, committee0.sign(header)); errors.Cause(err) != ErrUnexpectedEpoch {
+		t.Fatalf("have %v, want %v", err, ErrUnexpectedEpoch)
+	}
+}
+
+func TestNewClientFromCheckpoint(t *testing.T) {
+	var (
+		committee1 = newTestCommittee(4)
+		committee2 = newTestCommittee(4)
+	)
+	if _, err := NewClientFromCheckpoint(testConfig, makeTestHeader(5, 0, nil)); err != ErrNotEpochBlock {
+		t.Fatalf("have %v, want %v", err, ErrNotEpochBlock)
+	}
+
+	checkpoint := makeTestHeader(10, 0, committee1.shardState(1))
+	client, err := NewClientFromCheckpoint(testConfig, checkpoint)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if client.Epoch().Uint64() != 1 || client.Head().Hash() != checkpoint.Hash() {
+		t.Fatalf("client not started at epoch 1: epoch %v", client.Epoch())
+	}
+
+	epochBlock := makeTestHeader(20, 1, committee2.shardState(2))
+	if err := client.VerifyEpochBlock(epochBlock, committee2.sign(epochBlock)); errors.Cause(err) != ErrInvalidSignature {
+		t.Fatalf("have %v, want %v", err, ErrInvalidSignature)
+	}
+	if err := client.VerifyEpochBlock(epochBlock, committee1.sign(epochBlock)); err != nil {
+		t.Fatal(err)
+	}
+	if client.Epoch().Uint64() != 2 {
+		t.Fatalf("client not moved to epoch 2: epoch %v", client.Epoch())
+	}
+}
+
+type testFetcher struct {
+	headers map[uint64]*block.Header
+	sigs    map[uint64][]byte
+}
+
+func (f *testFetcher) GetEpochBlocks(ctx context.Context, epochs []uint64, opts ...syncproto.Option) ([]*block.Header, [][]byte, sttypes.StreamID, error) {
+	headers := make([]*block.Header, 0, len(epochs))
+	sigs := make([][]byte, 0, len(epochs))
+	for _, epoch := range epochs {
+		headers = append(headers, f.headers[epoch])
+		sigs = append(sigs, f.sigs[epoch])
+	}
+	return headers, sigs, "test stream", nil
+}
+
+func TestClient_Sync(t *testing.T) {
+	committees := []testCommittee{newTestCommittee(3), newTestCommittee(3), newTestCommittee(3)}
+	fetcher := &testFetcher{
+		headers: make(map[uint64]*block.Header),
+		sigs:    make(map[uint64][]byte),
+	}
+	for epoch := 0; epoch < 2; epoch++ {
+		header := makeTestHeader(int64(epoch+1)*10, int64(epoch), committees[epoch+1].shardState(int64(epoch+1)))
+		fetcher.headers[uint64(epoch)] = header
+		fetcher.sigs[uint64(epoch)] = committees[epoch].sign(header)
+	}
+
+	client, err := NewClient(testConfig, makeTestHeader(0, 0, committees[0].shardState(0)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	verified, err := client.Sync(context.Background(), fetcher)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if verified != 2 || client.Epoch().Uint64() != 2 {
+		t.Fatalf("have %d verified epochs at epoch %v, want 2", verified, client.Epoch())
+	}
+}
@@ -35,6 +35,19 @@ func MakeGetBlocksByNumRequest(bns []uint64) *Request {
 	}
 }
 
+// MakeGetBlocksByNumRequestWithLimit makes the GetBlockByNumber request with
+// the soft limit of the response size in bytes
+func MakeGetBlocksByNumRequestWithLimit(bns []uint64, bytes uint64) *Request {
+	return &Request{
+		Request: &Request_GetBlocksByNumRequest{
+			GetBlocksByNumRequest: &GetBlocksByNumRequest{
+				Nums:  bns,
+				Bytes: bytes,
+			},
+		},
+	}
+}
+
 // MakeGetBlockByHashesRequest makes the GetBlocksByHashes request
 func MakeGetBlocksByHashesRequest(hashes []common.Hash) *Request {
 	return &Request{
@@ -68,6 +81,19 @@ func MakeGetReceiptsRequest(hashes []common.Hash) *Request {
 	}
 }
 
+// MakeGetReceiptsRequestWithLimit makes the GetReceipts request with the soft
+// limit of the response size in bytes
+func MakeGetReceiptsRequestWithLimit(hashes []common.Hash, bytes uint64) *Request {
+	return &Request{
+		Request: &Request_GetReceiptsRequest{
+			GetReceiptsRequest: &GetReceiptsRequest{
+				BlockHashes: hashesToBytes(hashes),
+				Bytes:       bytes,
+			},
+		},
+	}
+}
+
 // MakeGetAccountRangeRequest makes the GetAccountRange request
 func MakeGetAccountRangeRequest(root common.Hash, origin common.Hash, limit common.Hash, bytes uint64) *Request {
 	return &Request{
@@ -122,10 +148,34 @@ func MakeGetTrieNodesRequest(root common.Hash, paths []*TrieNodePathSet, bytes u
 	}
 }
 
+// MakeGetEpochBlocksRequest makes the GetEpochBlocks request
+func MakeGetEpochBlocksRequest(epochs []uint64) *Request {
+	return &Request{
+		Request: &Request_GetEpochBlocksRequest{
+			GetEpochBlocksRequest: &GetEpochBlocksRequest{
+				Epochs: epochs,
+			},
+		},
+	}
+}
+
+// MakeGetCXReceiptsProofRequest makes the GetCXReceiptsProof request
+func MakeGetCXReceiptsProofRequest(fromShardID, toShardID uint32, bns []uint64) *Request {
+	return &Request{
+		Request: &Request_GetCxReceiptsProofRequest{
+			GetCxReceiptsProofRequest: &GetCXReceiptsProofRequest{
+				FromShardId: fromShardID,
+				ToShardId:   toShardID,
+				BlockNums:   bns,
+			},
+		},
+	}
+}
+
 // MakeErrorResponse makes the error response
 func MakeErrorResponseMessage(rid uint64, err error) *Message {
 	resp := MakeErrorResponse(rid, err)
-	return makeMessageFromResponse(resp)
+	return MakeMessageFromResponse(resp)
 }
 
 // MakeErrorResponse makes the error response as a response
@@ -143,7 +193,7 @@ func MakeErrorResponse(rid uint64, err error) *Response {
 // MakeGetBlockNumberResponseMessage makes the GetBlockNumber response message
 func MakeGetBlockNumberResponseMessage(rid uint64, bn uint64) *Message {
 	resp := MakeGetBlockNumberResponse(rid, bn)
-	return makeMessageFromResponse(resp)
+	return MakeMessageFromResponse(resp)
 }
 
 // MakeGetBlockNumberResponse makes the GetBlockNumber response
@@ -161,7 +211,7 @@ func MakeGetBlockNumberResponse(rid uint64, bn uint64) *Response {
 // MakeGetBlockHashesResponseMessage makes the GetBlockHashes message
 func MakeGetBlockHashesResponseMessage(rid uint64, hs []common.Hash) *Message {
 	resp := MakeGetBlockHashesResponse(rid, hs)
-	return makeMessageFromResponse(resp)
+	return MakeMessageFromResponse(resp)
 }
 
 // MakeGetBlockHashesResponse makes the GetBlockHashes response
@@ -179,7 +229,7 @@ func MakeGetBlockHashesResponse(rid uint64, hs []common.Hash) *Response {
 // MakeGetBlocksByNumResponseMessage makes the GetBlocksByNumResponse of Message type
 func MakeGetBlocksByNumResponseMessage(rid uint64, blocksBytes, sigs [][]byte) *Message {
 	resp := MakeGetBlocksByNumResponse(rid, blocksBytes, sigs)
-	return makeMessageFromResponse(resp)
+	return MakeMessageFromResponse(resp)
 }
 
 // MakeGetBlocksByNumResponseMessage make the GetBlocksByNumResponse of Response type
@@ -198,7 +248,7 @@ func MakeGetBlocksByNumResponse(rid uint64, blocksBytes, sigs [][]byte) *Respons
 // MakeGetBlocksByHashesResponseMessage makes the GetBlocksByHashesResponse of Message type
 func MakeGetBlocksByHashesResponseMessage(rid uint64, blocksBytes, sigs [][]byte) *Message {
 	resp := MakeGetBlocksByHashesResponse(rid, blocksBytes, sigs)
-	return makeMessageFromResponse(resp)
+	return MakeMessageFromResponse(resp)
 }
 
 // MakeGetBlocksByHashesResponse make the GetBlocksByHashesResponse of Response type
@@ -217,7 +267,7 @@ func MakeGetBlocksByHashesResponse(rid uint64, blocksBytes [][]byte, sigs [][]by
 // MakeGetNodeDataResponseMessage makes the GetNodeDataResponse of Message type
 func MakeGetNodeDataResponseMessage(rid uint64, nodeData [][]byte) *Message {
 	resp := MakeGetNodeDataResponse(rid, nodeData)
-	return makeMessageFromResponse(resp)
+	return MakeMessageFromResponse(resp)
 }
 
 // MakeGetNodeDataResponse make the GetNodeDataResponse of Response type
@@ -235,7 +285,7 @@ func MakeGetNodeDataResponse(rid uint64, nodeData [][]byte) *Response {
 // MakeGetReceiptsResponseMessage makes the GetReceiptsResponse of Message type
 func MakeGetReceiptsResponseMessage(rid uint64, receipts map[uint64]*Receipts) *Message {
 	resp := MakeGetReceiptsResponse(rid, receipts)
-	return makeMessageFromResponse(resp)
+	return MakeMessageFromResponse(resp)
 }
 
 // MakeGetReceiptsResponse make the GetReceiptsResponse of Response type
@@ -253,7 +303,7 @@ func MakeGetReceiptsResponse(rid uint64, receipts map[uint64]*Receipts) *Respons
 // MakeGetAccountRangeResponseMessage makes the GetAccountRangeResponse of Message type
 func MakeGetAccountRangeResponseMessage(rid uint64, accounts []*AccountData, proof [][]byte) *Message {
 	resp := MakeGetAccountRangeResponse(rid, accounts, proof)
-	return makeMessageFromResponse(resp)
+	return MakeMessageFromResponse(resp)
 }
 
 // MakeGetAccountRangeResponse make the GetAccountRangeResponse of Response type
@@ -272,7 +322,7 @@ func MakeGetAccountRangeResponse(rid uint64, accounts []*AccountData, proof [][]
 // MakeGetStorageRangesResponseMessage makes the GetStorageRangesResponse of Message type
 func MakeGetStorageRangesResponseMessage(rid uint64, slots []*StoragesData, proof [][]byte) *Message {
 	resp := MakeGetStorageRangesResponse(rid, slots, proof)
-	return makeMessageFromResponse(resp)
+	return MakeMessageFromResponse(resp)
 }
 
 // MakeGetStorageRangesResponse make the GetStorageRangesResponse of Response type
@@ -291,7 +341,7 @@ func MakeGetStorageRangesResponse(rid uint64, slots []*StoragesData, proof [][]b
 // MakeGetByteCodesResponseMessage makes the GetByteCodesResponse of Message type
 func MakeGetByteCodesResponseMessage(rid uint64, codes [][]byte) *Message {
 	resp := MakeGetByteCodesResponse(rid, codes)
-	return makeMessageFromResponse(resp)
+	return MakeMessageFromResponse(resp)
 }
 
 // MakeGetByteCodesResponse make the GetByteCodesResponse of Response type
@@ -309,7 +359,7 @@ func MakeGetByteCodesResponse(rid uint64, codes [][]byte) *Response {
 // MakeGetTrieNodesResponseMessage makes the GetTrieNodesResponse of Message type
 func MakeGetTrieNodesResponseMessage(rid uint64, nodes [][]byte) *Message {
 	resp := MakeGetTrieNodesResponse(rid, nodes)
-	return makeMessageFromResponse(resp)
+	return MakeMessageFromResponse(resp)
 }
 
 // MakeGetTrieNodesResponse make the GetTrieNodesResponse of Response type
@@ -324,6 +374,43 @@ func MakeGetTrieNodesResponse(rid uint64, nodes [][]byte) *Response {
 	}
 }
 
+// MakeGetEpochBlocksResponseMessage makes the GetEpochBlocksResponse of Message type
+func MakeGetEpochBlocksResponseMessage(rid uint64, headersBytes [][]byte, sigs [][]byte) *Message {
+	resp := MakeGetEpochBlocksResponse(rid, headersBytes, sigs)
+	return MakeMessageFromResponse(resp)
+}
+
+// MakeGetEpochBlocksResponse make the GetEpochBlocksResponse of Response type
+func MakeGetEpochBlocksResponse(rid uint64, headersBytes [][]byte, sigs [][]byte) *Response {
+	return &Response{
+		ReqId: rid,
+		Response: &Response_GetEpochBlocksResponse{
+			GetEpochBlocksResponse: &GetEpochBlocksResponse{
+				HeadersBytes: headersBytes,
+				CommitSig:    sigs,
+			},
+		},
+	}
+}
+
+// MakeGetCXReceiptsProofResponseMessage makes the GetCXReceiptsProofResponse of Message type
+func MakeGetCXReceiptsProofResponseMessage(rid uint64, proofsBytes [][]byte) *Message {
+	resp := MakeGetCXReceiptsProofResponse(rid, proofsBytes)
+	return MakeMessageFromResponse(resp)
+}
+
+// MakeGetCXReceiptsProofResponse make the GetCXReceiptsProofResponse of Response type
+func MakeGetCXReceiptsProofResponse(rid uint64, proofsBytes [][]byte) *Response {
+	return &Response{
+		ReqId: rid,
+		Response: &Response_GetCxReceiptsProofResponse{
+			GetCxReceiptsProofResponse: &GetCXReceiptsProofResponse{
+				ProofsBytes: proofsBytes,
+			},
+		},
+	}
+}
+
 // MakeMessageFromRequest makes a message from the request
 func MakeMessageFromRequest(req *Request) *Message {
 	return &Message{
@@ -333,7 +420,8 @@ func MakeMessageFromRequest(req *Request) *Message {
 	}
 }
 
-func makeMessageFromResponse(resp *Response) *Message {
+// MakeMessageFromResponse makes a message from the response
+func MakeMessageFromResponse(resp *Response) *Message {
 	return &Message{
 		ReqOrResp: &Message_Resp{
 			Resp: resp,
@@ -362,3 +450,16 @@ func bytesToHashes(bs [][]byte) []common.Hash {
 	}
 	return res
 }
+
+// MakeCompressedResponseMessage makes a message from the compressed bytes of an
+// encoded response
+func MakeCompressedResponseMessage(c Compression, respBytes []byte) *Message {
+	return &Message{
+		ReqOrResp: &Message_CompressedResp{
+			CompressedResp: &CompressedResponse{
+				Compression: c,
+				RespBytes:   respBytes,
+			},
+		},
+	}
+}
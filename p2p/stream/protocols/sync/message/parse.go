@@ -175,3 +175,35 @@ func (msg *Message) GetTrieNodesResponse() (*GetTrieNodesResponse, error) {
 	}
 	return gnResp, nil
 }
+
+// GetEpochBlocksResponse parse the message to GetEpochBlocksResponse
+func (msg *Message) GetEpochBlocksResponse() (*GetEpochBlocksResponse, error) {
+	resp := msg.GetResp()
+	if resp == nil {
+		return nil, errors.New("not response message")
+	}
+	if errResp := resp.GetErrorResponse(); errResp != nil {
+		return nil, &ResponseError{errResp.Error}
+	}
+	geResp := resp.GetGetEpochBlocksResponse()
+	if geResp == nil {
+		return nil, errors.New("not GetEpochBlocksResponse")
+	}
+	return geResp, nil
+}
+
+// GetCXReceiptsProofResponse parse the message to GetCXReceiptsProofResponse
+func (msg *Message) GetCXReceiptsProofResponse() (*GetCXReceiptsProofResponse, error) {
+	resp := msg.GetResp()
+	if resp == nil {
+		return nil, errors.New("not response message")
+	}
+	if errResp := resp.GetErrorResponse(); errResp != nil {
+		return nil, &ResponseError{errResp.Error}
+	}
+	cxResp := resp.GetGetCxReceiptsProofResponse()
+	if cxResp == nil {
+		return nil, errors.New("not GetCXReceiptsProofResponse")
+	}
+	return cxResp, nil
+}
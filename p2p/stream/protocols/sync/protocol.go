@@ -31,12 +31,23 @@ const (
 
 var (
 	version100, _ = version.NewVersion("1.0.0")
+	version110, _ = version.NewVersion("1.1.0")
 
 	// MyVersion is the version of sync protocol of the local node
-	MyVersion = version100
+	MyVersion = version110
 
 	// MinVersion is the minimum version for matching function
 	MinVersion = version100
+
+	// BytesLimitVersion is the minimum version serving the block and receipt
+	// requests with a bytes limit
+	BytesLimitVersion = version110
+
+	// EpochBlocksVersion is the minimum version serving the epoch blocks request
+	EpochBlocksVersion = version110
+
+	// CXReceiptsProofVersion is the minimum version serving the cx receipts proof request
+	CXReceiptsProofVersion = version110
 )
 
 type (
@@ -57,7 +68,8 @@ type (
 		closeC chan struct{}
 	}
 
-	// Config is the sync protocol config
+	// Config is the sync protocol config. A nil Chain makes a client only
+	// protocol, which requests the streams of the shard without serving any.
 	Config struct {
 		Chain      engine.ChainReader
 		Host       libp2p_host.Host
@@ -111,8 +123,8 @@ func (p *Protocol) Start() {
 	p.sm.Start()
 	p.rm.Start()
 	p.rl.Start()
-	// If it's not EpochChain, advertise
-	if p.config.BeaconNode || p.chain.ShardID() != shard.BeaconChainShardID {
+	// If it's not EpochChain nor a client only protocol, advertise
+	if p.chain != nil && (p.config.BeaconNode || p.chain.ShardID() != shard.BeaconChainShardID) {
 		go p.advertiseLoop()
 	}
 }
@@ -253,7 +265,7 @@ func (p *Protocol) supportedProtoIDs() []sttypes.ProtoID {
 }
 
 func (p *Protocol) supportedVersions() []*version.Version {
-	return []*version.Version{version100}
+	return []*version.Version{version100, version110}
 }
 
 func (p *Protocol) protoIDByVersion(v *version.Version) sttypes.ProtoID {
@@ -309,15 +321,41 @@ func (p *Protocol) StreamFailed(stID sttypes.StreamID, reason string) {
 	}
 }
 
+// StreamInvalidResponse records the invalid response (bad signature, hash, etc.)
+// of the stream to its score. It shall only be called for the stream proven to
+// serve the invalid data, use StreamFailed if the failure can't be attributed.
+func (p *Protocol) StreamInvalidResponse(stID sttypes.StreamID, reason string) {
+	p.sm.RecordInvalidResponse(stID, reason)
+}
+
+// StreamScores returns the scores of the streams
+func (p *Protocol) StreamScores() map[sttypes.StreamID]streammanager.StreamScore {
+	return p.sm.GetStreamScores()
+}
+
 // NumStreams return the streams with minimum version.
 // Note: nodes with sync version smaller than minVersion is not counted.
 func (p *Protocol) NumStreams() int {
+	return p.numStreamsWithVersion(MinVersion)
+}
+
+// NumStreamsWithBytesLimit return the streams serving the requests with a bytes limit.
+func (p *Protocol) NumStreamsWithBytesLimit() int {
+	return p.numStreamsWithVersion(BytesLimitVersion)
+}
+
+// NumStreamsWithCXReceiptsProof return the streams serving the cx receipts proof requests.
+func (p *Protocol) NumStreamsWithCXReceiptsProof() int {
+	return p.numStreamsWithVersion(CXReceiptsProofVersion)
+}
+
+func (p *Protocol) numStreamsWithVersion(minVersion *version.Version) int {
 	res := 0
 	sts := p.sm.GetStreams()
 
 	for _, st := range sts {
 		ps, _ := st.ProtoSpec()
-		if ps.Version.GreaterThanOrEqual(MinVersion) {
+		if ps.Version.GreaterThanOrEqual(minVersion) {
 			res++
 		}
 	}
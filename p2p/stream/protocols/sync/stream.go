@@ -29,6 +29,10 @@ type syncStream struct {
 	reqC  chan *syncpb.Request
 	respC chan *syncpb.Response
 
+	// compression of the responses to the request being handled, which is only
+	// accessed by handleReqLoop
+	respCompression syncpb.Compression
+
 	// close related fields. Concurrent call of close is possible.
 	closeC    chan struct{}
 	closeStat uint32
@@ -44,10 +48,14 @@ func (p *Protocol) wrapStream(raw libp2p_network.Stream) *syncStream {
 		Str("Remote Protocol", string(bs.ProtoID())).
 		Logger()
 
+	var ch chainHelper
+	if p.chain != nil {
+		ch = newChainHelper(p.chain, p.schedule)
+	}
 	return &syncStream{
 		BaseStream: bs,
 		protocol:   p,
-		chain:      newChainHelper(p.chain, p.schedule),
+		chain:      ch,
 		reqC:       make(chan *syncpb.Request, 100),
 		respC:      make(chan *syncpb.Response, 100),
 		closeC:     make(chan struct{}),
@@ -176,6 +184,11 @@ func (st *syncStream) CloseOnExit() error {
 }
 
 func (st *syncStream) handleReq(req *syncpb.Request) error {
+	st.respCompression = negotiateCompression(req.AcceptCompressions)
+	if st.chain == nil {
+		// client only protocol, nothing to serve
+		return st.handleUnservedRequest(req.ReqId)
+	}
 	if gnReq := req.GetGetBlockNumberRequest(); gnReq != nil {
 		return st.handleGetBlockNumberRequest(req.ReqId)
 	}
@@ -206,6 +219,12 @@ func (st *syncStream) handleReq(req *syncpb.Request) error {
 	if ndReq := req.GetGetTrieNodesRequest(); ndReq != nil {
 		return st.handleGetTrieNodesRequest(req.ReqId, ndReq)
 	}
+	if geReq := req.GetGetEpochBlocksRequest(); geReq != nil {
+		return st.handleGetEpochBlocksRequest(req.ReqId, geReq)
+	}
+	if cxReq := req.GetGetCxReceiptsProofRequest(); cxReq != nil {
+		return st.handleGetCXReceiptsProofRequest(req.ReqId, cxReq)
+	}
 	// unsupported request type
 	return st.handleUnknownRequest(req.ReqId)
 }
@@ -249,7 +268,7 @@ func (st *syncStream) handleGetBlocksByNumRequest(rid uint64, req *syncpb.GetBlo
 		"request_type": "getBlocksByNumber",
 	}).Inc()
 
-	resp, err := st.computeRespFromBlockNumber(rid, req.Nums)
+	resp, err := st.computeRespFromBlockNumber(rid, req.Nums, req.Bytes)
 	if resp == nil && err != nil {
 		resp = syncpb.MakeErrorResponseMessage(rid, err)
 	}
@@ -312,7 +331,7 @@ func (st *syncStream) handleGetReceiptsRequest(rid uint64, req *syncpb.GetReceip
 	}).Inc()
 
 	hashes := bytesToHashes(req.BlockHashes)
-	resp, err := st.computeGetReceipts(rid, hashes)
+	resp, err := st.computeGetReceipts(rid, hashes, req.Bytes)
 	if resp == nil && err != nil {
 		resp = syncpb.MakeErrorResponseMessage(rid, err)
 	}
@@ -415,6 +434,46 @@ func (st *syncStream) handleGetTrieNodesRequest(rid uint64, req *syncpb.GetTrieN
 	return errors.Wrap(err, "[GetTrieNodes]")
 }
 
+func (st *syncStream) handleGetEpochBlocksRequest(rid uint64, req *syncpb.GetEpochBlocksRequest) error {
+	serverRequestCounterVec.With(prometheus.Labels{
+		"topic":        string(st.ProtoID()),
+		"request_type": "getEpochBlocks",
+	}).Inc()
+
+	resp, err := st.computeGetEpochBlocks(rid, req.Epochs)
+	if resp == nil && err != nil {
+		resp = syncpb.MakeErrorResponseMessage(rid, err)
+	}
+	if writeErr := st.writeMsg(resp); writeErr != nil {
+		if err == nil {
+			err = writeErr
+		} else {
+			err = fmt.Errorf("%v; [writeMsg] %v", err.Error(), writeErr)
+		}
+	}
+	return errors.Wrap(err, "[GetEpochBlocks]")
+}
+
+func (st *syncStream) handleGetCXReceiptsProofRequest(rid uint64, req *syncpb.GetCXReceiptsProofRequest) error {
+	serverRequestCounterVec.With(prometheus.Labels{
+		"topic":        string(st.ProtoID()),
+		"request_type": "getCXReceiptsProof",
+	}).Inc()
+
+	resp, err := st.computeGetCXReceiptsProof(rid, req.FromShardId, req.ToShardId, req.BlockNums)
+	if resp == nil && err != nil {
+		resp = syncpb.MakeErrorResponseMessage(rid, err)
+	}
+	if writeErr := st.writeMsg(resp); writeErr != nil {
+		if err == nil {
+			err = writeErr
+		} else {
+			err = fmt.Errorf("%v; [writeMsg] %v", err.Error(), writeErr)
+		}
+	}
+	return errors.Wrap(err, "[GetCXReceiptsProof]")
+}
+
 func (st *syncStream) handleUnknownRequest(rid uint64) error {
 	serverRequestCounterVec.With(prometheus.Labels{
 		"topic":        string(st.ProtoID()),
@@ -424,6 +483,15 @@ func (st *syncStream) handleUnknownRequest(rid uint64) error {
 	return st.writeMsg(resp)
 }
 
+func (st *syncStream) handleUnservedRequest(rid uint64) error {
+	serverRequestCounterVec.With(prometheus.Labels{
+		"topic":        string(st.ProtoID()),
+		"request_type": "unserved",
+	}).Inc()
+	resp := syncpb.MakeErrorResponseMessage(rid, errNoChainServed)
+	return st.writeMsg(resp)
+}
+
 func (st *syncStream) handleResp(resp *syncpb.Response) {
 	st.protocol.rm.DeliverResponse(st.ID(), &syncResponse{resp})
 }
@@ -437,10 +505,24 @@ func (st *syncStream) readMsg() (*syncpb.Message, error) {
 	if err := protobuf.Unmarshal(b, msg); err != nil {
 		return nil, err
 	}
+	if cResp := msg.GetCompressedResp(); cResp != nil {
+		resp, err := decompressResponse(cResp)
+		if err != nil {
+			return nil, err
+		}
+		msg = syncpb.MakeMessageFromResponse(resp)
+	}
 	return msg, nil
 }
 
 func (st *syncStream) writeMsg(msg *syncpb.Message) error {
+	if resp := msg.GetResp(); resp != nil && st.respCompression != syncpb.Compression_NO_COMPRESSION {
+		cMsg, err := compressResponse(st.respCompression, resp)
+		if err != nil {
+			return err
+		}
+		msg = cMsg
+	}
 	b, err := protobuf.Marshal(msg)
 	if err != nil {
 		return err
@@ -462,32 +544,54 @@ func (st *syncStream) computeGetBlockHashesResp(rid uint64, bns []uint64) (*sync
 	return syncpb.MakeGetBlockHashesResponseMessage(rid, hashes), nil
 }
 
-func (st *syncStream) computeRespFromBlockNumber(rid uint64, bns []uint64) (*syncpb.Message, error) {
-	if len(bns) > GetBlocksByNumAmountCap {
-		err := fmt.Errorf("GetBlocksByNum amount exceed cap: %v>%v", len(bns), GetBlocksByNumAmountCap)
-		return nil, err
+// computeRespFromBlockNumber returns the blocks of the given numbers. With a non
+// zero bytes limit, the response stops at the first block reaching the limit.
+func (st *syncStream) computeRespFromBlockNumber(rid uint64, bns []uint64, bytes uint64) (*syncpb.Message, error) {
+	amountCap := GetBlocksByNumAmountCap
+	if bytes != 0 {
+		amountCap = GetBlocksByNumWithLimitAmountCap
 	}
-	blocks, err := st.chain.getBlocksByNumber(bns)
-	if err != nil {
+	if len(bns) > amountCap {
+		err := fmt.Errorf("GetBlocksByNum amount exceed cap: %v>%v", len(bns), amountCap)
 		return nil, err
 	}
+	if bytes > maxBlocksResponseBytes {
+		return nil, fmt.Errorf("requested bytes exceed limit")
+	}
 
 	var (
-		blocksBytes = make([][]byte, 0, len(blocks))
-		sigs        = make([][]byte, 0, len(blocks))
+		blocksBytes = make([][]byte, 0, len(bns))
+		sigs        = make([][]byte, 0, len(bns))
+		size        uint64
 	)
-	for _, block := range blocks {
-		bb, err := rlp.EncodeToBytes(block)
+	// load the blocks in chunks, so the blocks over the bytes limit are not read
+	for start := 0; start < len(bns); start += GetBlocksByNumAmountCap {
+		end := start + GetBlocksByNumAmountCap
+		if end > len(bns) {
+			end = len(bns)
+		}
+		blocks, err := st.chain.getBlocksByNumber(bns[start:end])
 		if err != nil {
 			return nil, err
 		}
-		blocksBytes = append(blocksBytes, bb)
+		for _, block := range blocks {
+			bb, err := rlp.EncodeToBytes(block)
+			if err != nil {
+				return nil, err
+			}
+			blocksBytes = append(blocksBytes, bb)
 
-		var sig []byte
-		if block != nil {
-			sig = block.GetCurrentCommitSig()
+			var sig []byte
+			if block != nil {
+				sig = block.GetCurrentCommitSig()
+			}
+			sigs = append(sigs, sig)
+
+			size += uint64(len(bb) + len(sig))
+			if bytes != 0 && size >= bytes {
+				return syncpb.MakeGetBlocksByNumResponseMessage(rid, blocksBytes, sigs), nil
+			}
 		}
-		sigs = append(sigs, sig)
 	}
 	return syncpb.MakeGetBlocksByNumResponseMessage(rid, blocksBytes, sigs), nil
 }
@@ -535,16 +639,25 @@ func (st *syncStream) computeGetNodeData(rid uint64, hs []common.Hash) (*syncpb.
 	return syncpb.MakeGetNodeDataResponseMessage(rid, data), nil
 }
 
-func (st *syncStream) computeGetReceipts(rid uint64, hs []common.Hash) (*syncpb.Message, error) {
+// computeGetReceipts returns the receipts of the given blocks. With a non zero
+// bytes limit, the response stops at the receipts of the first block reaching
+// the limit.
+func (st *syncStream) computeGetReceipts(rid uint64, hs []common.Hash, bytes uint64) (*syncpb.Message, error) {
 	if len(hs) > GetReceiptsCap {
 		err := fmt.Errorf("GetReceipts amount exceed cap: %v > %v", len(hs), GetReceiptsCap)
 		return nil, err
 	}
+	if bytes > maxBlocksResponseBytes {
+		return nil, fmt.Errorf("requested bytes exceed limit")
+	}
 	receipts, err := st.chain.getReceipts(hs)
 	if err != nil {
 		return nil, err
 	}
-	var normalizedReceipts = make(map[uint64]*syncpb.Receipts, len(receipts))
+	var (
+		normalizedReceipts = make(map[uint64]*syncpb.Receipts, len(receipts))
+		size               uint64
+	)
 	for i, blkReceipts := range receipts {
 		normalizedReceipts[uint64(i)] = &syncpb.Receipts{
 			ReceiptBytes: make([][]byte, 0),
@@ -555,6 +668,10 @@ func (st *syncStream) computeGetReceipts(rid uint64, hs []common.Hash) (*syncpb.
 				return nil, err
 			}
 			normalizedReceipts[uint64(i)].ReceiptBytes = append(normalizedReceipts[uint64(i)].ReceiptBytes, receiptBytes)
+			size += uint64(len(receiptBytes))
+		}
+		if bytes != 0 && size >= bytes {
+			break
 		}
 	}
 	return syncpb.MakeGetReceiptsResponseMessage(rid, normalizedReceipts), nil
@@ -628,6 +745,50 @@ func (st *syncStream) computeGetTrieNodesRequest(rid uint64, root common.Hash, p
 	return syncpb.MakeGetTrieNodesResponseMessage(rid, nodes), nil
 }
 
+func (st *syncStream) computeGetEpochBlocks(rid uint64, epochs []uint64) (*syncpb.Message, error) {
+	if len(epochs) > GetEpochBlocksCap {
+		err := fmt.Errorf("GetEpochBlocks amount exceed cap: %v > %v", len(epochs), GetEpochBlocksCap)
+		return nil, err
+	}
+	headers, sigs, err := st.chain.getEpochBlocks(epochs)
+	if err != nil {
+		return nil, err
+	}
+	headersBytes := make([][]byte, 0, len(headers))
+	for _, header := range headers {
+		var hb []byte
+		if header != nil {
+			if hb, err = rlp.EncodeToBytes(header); err != nil {
+				return nil, err
+			}
+		}
+		headersBytes = append(headersBytes, hb)
+	}
+	return syncpb.MakeGetEpochBlocksResponseMessage(rid, headersBytes, sigs), nil
+}
+
+func (st *syncStream) computeGetCXReceiptsProof(rid uint64, fromShardID, toShardID uint32, bns []uint64) (*syncpb.Message, error) {
+	if len(bns) > GetCXReceiptsProofCap {
+		err := fmt.Errorf("GetCXReceiptsProof amount exceed cap: %v > %v", len(bns), GetCXReceiptsProofCap)
+		return nil, err
+	}
+	proofs, err := st.chain.getCXReceiptsProofs(fromShardID, toShardID, bns)
+	if err != nil {
+		return nil, err
+	}
+	proofsBytes := make([][]byte, 0, len(proofs))
+	for _, proof := range proofs {
+		var pb []byte
+		if proof != nil {
+			if pb, err = rlp.EncodeToBytes(proof); err != nil {
+				return nil, err
+			}
+		}
+		proofsBytes = append(proofsBytes, pb)
+	}
+	return syncpb.MakeGetCXReceiptsProofResponseMessage(rid, proofsBytes), nil
+}
+
 func bytesToHashes(bs [][]byte) []common.Hash {
 	hs := make([]common.Hash, 0, len(bs))
 	for _, b := range bs {
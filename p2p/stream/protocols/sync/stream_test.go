@@ -7,6 +7,7 @@ import (
 	"time"
 
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/rlp"
 	syncpb "github.com/harmony-one/harmony/p2p/stream/protocols/sync/message"
 	sttypes "github.com/harmony-one/harmony/p2p/stream/types"
 	ic "github.com/libp2p/go-libp2p/core/crypto"
@@ -84,6 +85,14 @@ var (
 
 	testGetTrieNodesRequest    = syncpb.MakeGetTrieNodesRequest(root, testPaths, maxBytes)
 	testGetTrieNodesRequestMsg = syncpb.MakeMessageFromRequest(testGetTrieNodesRequest)
+
+	testGetEpochs                = []uint64{0, 1, 2}
+	testGetEpochBlocksRequest    = syncpb.MakeGetEpochBlocksRequest(testGetEpochs)
+	testGetEpochBlocksRequestMsg = syncpb.MakeMessageFromRequest(testGetEpochBlocksRequest)
+
+	testGetCXReceiptsBlockNums       = []uint64{2, 3, 4}
+	testGetCXReceiptsProofRequest    = syncpb.MakeGetCXReceiptsProofRequest(1, 0, testGetCXReceiptsBlockNums)
+	testGetCXReceiptsProofRequestMsg = syncpb.MakeMessageFromRequest(testGetCXReceiptsProofRequest)
 )
 
 func TestSyncStream_HandleGetBlocksByRequest(t *testing.T) {
@@ -107,6 +116,50 @@ func TestSyncStream_HandleGetBlocksByRequest(t *testing.T) {
 	}
 }
 
+func TestSyncStream_HandleGetBlocksByRequestWithLimit(t *testing.T) {
+	st, remoteSt := makeTestSyncStream()
+
+	go st.run()
+	defer close(st.closeC)
+
+	bns := make([]uint64, 0, 2*GetBlocksByNumAmountCap)
+	for bn := uint64(1); bn <= uint64(2*GetBlocksByNumAmountCap); bn++ {
+		bns = append(bns, bn)
+	}
+	// the response stops at the first block reaching the limit
+	expBns := bns[:GetBlocksByNumAmountCap+2]
+	testBlock := makeTestBlock(1)
+	bb, _ := rlp.EncodeToBytes(testBlock)
+	blockSize := len(bb) + len(testBlock.GetCurrentCommitSig())
+	req := syncpb.MakeGetBlocksByNumRequestWithLimit(bns, uint64(len(expBns)*blockSize-1))
+	req.AcceptCompressions = supportedCompressions
+	b, _ := protobuf.Marshal(syncpb.MakeMessageFromRequest(req))
+	err := remoteSt.WriteBytes(b)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	time.Sleep(200 * time.Millisecond)
+	receivedBytes, _ := remoteSt.ReadBytes()
+
+	var msg = &syncpb.Message{}
+	if err := protobuf.Unmarshal(receivedBytes, msg); err != nil {
+		t.Fatal(err)
+	}
+	cResp := msg.GetCompressedResp()
+	if cResp == nil || cResp.Compression != supportedCompressions[0] {
+		t.Fatalf("response not compressed with %v", supportedCompressions[0])
+	}
+	resp, err := decompressResponse(cResp)
+	if err != nil {
+		t.Fatal(err)
+	}
+	respBytes, _ := protobuf.Marshal(syncpb.MakeMessageFromResponse(resp))
+	if err := checkBlocksResult(expBns, respBytes); err != nil {
+		t.Fatal(err)
+	}
+}
+
 func TestSyncStream_HandleCurrentBlockNumber(t *testing.T) {
 	st, remoteSt := makeTestSyncStream()
 
@@ -296,6 +349,48 @@ func TestSyncStream_HandleGetTrieNodes(t *testing.T) {
 	}
 }
 
+func TestSyncStream_HandleGetEpochBlocks(t *testing.T) {
+	st, remoteSt := makeTestSyncStream()
+
+	go st.run()
+	defer close(st.closeC)
+
+	req := testGetEpochBlocksRequestMsg
+	b, _ := protobuf.Marshal(req)
+	err := remoteSt.WriteBytes(b)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	time.Sleep(200 * time.Millisecond)
+	receivedBytes, _ := remoteSt.ReadBytes()
+
+	if err := checkEpochBlocksResult(testGetEpochs, receivedBytes); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestSyncStream_HandleGetCXReceiptsProof(t *testing.T) {
+	st, remoteSt := makeTestSyncStream()
+
+	go st.run()
+	defer close(st.closeC)
+
+	req := testGetCXReceiptsProofRequestMsg
+	b, _ := protobuf.Marshal(req)
+	err := remoteSt.WriteBytes(b)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	time.Sleep(200 * time.Millisecond)
+	receivedBytes, _ := remoteSt.ReadBytes()
+
+	if err := checkCXReceiptsProofResult(1, testGetCXReceiptsBlockNums, receivedBytes); err != nil {
+		t.Fatal(err)
+	}
+}
+
 func makeTestSyncStream() (*syncStream, *testRemoteBaseStream) {
 	localRaw, remoteRaw := makePairP2PStreams()
 	remote := newTestRemoteBaseStream(remoteRaw)
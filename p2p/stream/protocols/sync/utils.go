@@ -11,6 +11,7 @@ import (
 
 var (
 	errUnknownReqType = errors.New("unknown request")
+	errNoChainServed  = errors.New("no chain served by the node")
 )
 
 // syncResponse is the sync protocol response which implements sttypes.Response
@@ -28,6 +29,11 @@ func (resp *syncResponse) GetProtobufMsg() protobuf.Message {
 	return resp.pb
 }
 
+// Size return the encoded size of the response in bytes
+func (resp *syncResponse) Size() int {
+	return protobuf.Size(resp.pb)
+}
+
 func (resp *syncResponse) String() string {
 	return fmt.Sprintf("[SyncResponse %v]", resp.pb.String())
 }
@@ -33,4 +33,5 @@ type Request interface {
 type Response interface {
 	ReqID() uint64
 	String() string
+	Size() int // encoded size in bytes
 }
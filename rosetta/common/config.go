@@ -6,6 +6,8 @@ import (
 	"time"
 
 	"github.com/coinbase/rosetta-sdk-go/types"
+	ethCommon "github.com/ethereum/go-ethereum/common"
+	internalCommon "github.com/harmony-one/harmony/internal/common"
 	shardingconfig "github.com/harmony-one/harmony/internal/configs/sharding"
 	"github.com/harmony-one/harmony/shard"
 )
@@ -28,6 +30,9 @@ const (
 
 	// SignatureType ..
 	SignatureType = types.EcdsaRecovery
+
+	// HRC20ContractMetadataKey is the currency metadata key of the HRC-20 token contract address
+	HRC20ContractMetadataKey = "contract_address"
 )
 
 var (
@@ -50,6 +55,33 @@ var (
 	NativeCurrencyHash = types.Hash(NativeCurrency)
 )
 
+// NewHRC20Currency returns the currency of the HRC-20 token contract
+func NewHRC20Currency(contract ethCommon.Address, symbol string, decimals int32) (*types.Currency, error) {
+	b32Address, err := internalCommon.AddressToBech32(contract)
+	if err != nil {
+		return nil, err
+	}
+	return &types.Currency{
+		Symbol:   symbol,
+		Decimals: decimals,
+		Metadata: map[string]interface{}{
+			HRC20ContractMetadataKey: b32Address,
+		},
+	}, nil
+}
+
+// GetHRC20Contract returns the token contract address of the HRC-20 currency
+func GetHRC20Contract(currency *types.Currency) (ethCommon.Address, error) {
+	if currency == nil || currency.Metadata == nil {
+		return ethCommon.Address{}, fmt.Errorf("currency is not a HRC-20 token")
+	}
+	b32Address, ok := currency.Metadata[HRC20ContractMetadataKey].(string)
+	if !ok {
+		return ethCommon.Address{}, fmt.Errorf("currency is not a HRC-20 token")
+	}
+	return internalCommon.Bech32ToAddress(b32Address)
+}
+
 // SyncStatus ..
 type SyncStatus int
 
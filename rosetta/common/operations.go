@@ -7,6 +7,7 @@ import (
 	"github.com/harmony-one/harmony/internal/common"
 
 	"github.com/coinbase/rosetta-sdk-go/types"
+	"github.com/ethereum/go-ethereum/common/hexutil"
 
 	rpcV2 "github.com/harmony-one/harmony/rpc/harmony/v2"
 	staking "github.com/harmony-one/harmony/staking/types"
@@ -19,6 +20,12 @@ const (
 	// ContractCreationOperation is an operation that only affects the native currency.
 	ContractCreationOperation = "ContractCreation"
 
+	// ContractCallOperation is an operation that only affects the native currency.
+	ContractCallOperation = "ContractCall"
+
+	// HRC20TransferOperation is an operation that only affects the balance of a HRC-20 token.
+	HRC20TransferOperation = "HRC20Transfer"
+
 	// NativeTransferOperation is an operation that only affects the native currency.
 	NativeTransferOperation = "NativeTransfer"
 
@@ -51,6 +58,16 @@ const (
 	// UndelegationPayoutOperation is a side effect operation for committee election block only.
 	// Note that no transaction can be constructed with this operation.
 	UndelegationPayoutOperation = "UndelegationPayout"
+
+	// RewardAccrualOperation is a side effect operation for staking era only.
+	// It credits the unclaimed rewards sub-account of a delegator.
+	// Note that no transaction can be constructed with this operation.
+	RewardAccrualOperation = "RewardAccrual"
+
+	// SlashDebitOperation is a side effect operation for blocks applying slashes only.
+	// It debits the delegation, undelegation & unclaimed rewards sub-accounts of a slashed delegator.
+	// Note that no transaction can be constructed with this operation.
+	SlashDebitOperation = "SlashDebit"
 )
 
 var (
@@ -60,9 +77,13 @@ var (
 		NativeTransferOperation,
 		NativeCrossShardTransferOperation,
 		ContractCreationOperation,
+		ContractCallOperation,
+		HRC20TransferOperation,
 		GenesisFundsOperation,
 		PreStakingBlockRewardOperation,
 		UndelegationPayoutOperation,
+		RewardAccrualOperation,
+		SlashDebitOperation,
 	}
 
 	// StakingOperationTypes ..
@@ -123,6 +144,37 @@ type CrossShardTransactionOperationMetadata struct {
 	To   *types.AccountIdentifier `json:"to"`
 }
 
+// ContractCallOperationMetadata ..
+type ContractCallOperationMetadata struct {
+	Contract *types.AccountIdentifier `json:"contract"`
+	Data     string                   `json:"data"`
+}
+
+// UnmarshalFromInterface ..
+func (s *ContractCallOperationMetadata) UnmarshalFromInterface(data interface{}) error {
+	var T ContractCallOperationMetadata
+	dat, err := json.Marshal(data)
+	if err != nil {
+		return err
+	}
+	if err := json.Unmarshal(dat, &T); err != nil {
+		return err
+	}
+	if T.Contract == nil {
+		return fmt.Errorf("expected contract to be present for ContractCallOperationMetadata")
+	}
+	if !common.IsBech32Address(T.Contract.Address) {
+		return fmt.Errorf("expected contract address to be bech32 format for ContractCallOperationMetadata")
+	}
+	if T.Data != "" {
+		if _, err := hexutil.Decode(T.Data); err != nil {
+			return fmt.Errorf("expected data to be hex format for ContractCallOperationMetadata")
+		}
+	}
+	*s = T
+	return nil
+}
+
 // UnmarshalFromInterface ..
 func (s *CrossShardTransactionOperationMetadata) UnmarshalFromInterface(data interface{}) error {
 	var T CrossShardTransactionOperationMetadata
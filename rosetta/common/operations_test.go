@@ -54,9 +54,13 @@ func TestPlainOperationTypes(t *testing.T) {
 		NativeTransferOperation,
 		NativeCrossShardTransferOperation,
 		ContractCreationOperation,
+		ContractCallOperation,
+		HRC20TransferOperation,
 		GenesisFundsOperation,
 		PreStakingBlockRewardOperation,
 		UndelegationPayoutOperation,
+		RewardAccrualOperation,
+		SlashDebitOperation,
 	}
 	sort.Strings(referenceOperationTypes)
 	sort.Strings(plainOperationTypes)
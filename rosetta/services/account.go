@@ -93,7 +93,8 @@ func (s *AccountAPI) AccountBalance(
 	}, nil
 }
 
-// getStakingBalance used for get delegated balance with sub account identifier
+// getStakingBalance used for get delegated balance, pending undelegations & unclaimed rewards
+// with sub account identifier. The balance is read from the state of the given block.
 func (s *AccountAPI) getStakingBalance(
 	subAccount *types.SubAccountIdentifier, addr ethCommon.Address, block *hmyTypes.Block,
 ) (*big.Int, *types.Error) {
@@ -106,12 +107,19 @@ func (s *AccountAPI) getStakingBalance(
 		})
 	}
 
-	switch ty.(string) {
+	subAccountType, ok := ty.(string)
+	if !ok {
+		return nil, common.NewError(common.SanityCheckError, map[string]interface{}{
+			"message": "invalid sub account type",
+		})
+	}
+
+	switch subAccountType {
 	case Delegation:
 		validatorAddr := subAccount.Address
 		validators, delegations := s.hmy.GetDelegationsByDelegatorByBlock(addr, block)
 		for index, validator := range validators {
-			if validatorAddr == internalCommon.MustAddressToBech32(validator) {
+			if delegations[index] != nil && validatorAddr == internalCommon.MustAddressToBech32(validator) {
 				balance = new(big.Int).Add(balance, delegations[index].Amount)
 			}
 		}
@@ -119,13 +127,21 @@ func (s *AccountAPI) getStakingBalance(
 		validatorAddr := subAccount.Address
 		validators, delegations := s.hmy.GetDelegationsByDelegatorByBlock(addr, block)
 		for index, validator := range validators {
-			if validatorAddr == internalCommon.MustAddressToBech32(validator) {
+			if delegations[index] != nil && validatorAddr == internalCommon.MustAddressToBech32(validator) {
 				undelegations := delegations[index].Undelegations
 				for _, undelegate := range undelegations {
 					balance = new(big.Int).Add(balance, undelegate.Amount)
 				}
 			}
 		}
+	case Reward:
+		validatorAddr := subAccount.Address
+		validators, delegations := s.hmy.GetDelegationsByDelegatorByBlock(addr, block)
+		for index, validator := range validators {
+			if delegations[index] != nil && validatorAddr == internalCommon.MustAddressToBech32(validator) {
+				balance = new(big.Int).Add(balance, delegations[index].Reward)
+			}
+		}
 	default:
 		return nil, common.NewError(common.SanityCheckError, map[string]interface{}{
 			"message": "invalid sub account type",
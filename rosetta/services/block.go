@@ -189,11 +189,17 @@ func (s *BlockAPI) BlockTransaction(
 			if rosettaError != nil {
 				return nil, rosettaError
 			}
+			contractInfo.TokenCurrencies = getHRC20Currencies(
+				ctx, s.hmy, txInfo.receipt, rpc.BlockNumber(blk.NumberU64()),
+			)
 		}
 		transaction, rosettaError = FormatTransaction(txInfo.tx, txInfo.receipt, contractInfo, true)
 		if rosettaError != nil {
 			return nil, rosettaError
 		}
+		if rosettaError = s.addCollectRewardsSubAccountOperations(ctx, blk, txInfo.tx, transaction); rosettaError != nil {
+			return nil, rosettaError
+		}
 	} else if txInfo.cxReceipt != nil {
 		transaction, rosettaError = FormatCrossShardReceiverTransaction(txInfo.cxReceipt)
 		if rosettaError != nil {
@@ -205,6 +211,70 @@ func (s *BlockAPI) BlockTransaction(
 	return &types.BlockTransactionResponse{Transaction: transaction}, nil
 }
 
+// addCollectRewardsSubAccountOperations adds the operations debiting the unclaimed rewards sub-accounts
+// of the delegator to a formatted collect rewards transaction.
+// Rewards are collected before the block rewards are distributed, so they are read from the parent block.
+func (s *BlockAPI) addCollectRewardsSubAccountOperations(
+	ctx context.Context, blk *hmytypes.Block, tx hmytypes.PoolTransaction, transaction *types.Transaction,
+) *types.Error {
+	stakingTx, ok := tx.(*stakingTypes.StakingTransaction)
+	if !ok || stakingTx.StakingType() != stakingTypes.DirectiveCollectRewards || blk.NumberU64() == 0 {
+		return nil
+	}
+	var collectOperation *types.Operation
+	for _, op := range transaction.Operations {
+		if op.Type == common.CollectRewardsOperation {
+			collectOperation = op
+		}
+	}
+	if collectOperation == nil {
+		return nil
+	}
+	delegator, err := getAddress(collectOperation.Account)
+	if err != nil {
+		return common.NewError(common.CatchAllError, map[string]interface{}{
+			"message": err.Error(),
+		})
+	}
+	parent, err := s.hmy.BlockByNumber(ctx, rpc.BlockNumber(blk.NumberU64()-1))
+	if err != nil || parent == nil {
+		return common.NewError(common.BlockNotFoundError, map[string]interface{}{
+			"message": fmt.Sprintf("parent block of block %v not found", blk.NumberU64()),
+		})
+	}
+
+	opIndex := transaction.Operations[len(transaction.Operations)-1].OperationIdentifier.Index + 1
+	validators, delegations := s.hmy.GetDelegationsByDelegatorByBlock(delegator, parent)
+	for i, validator := range validators {
+		if delegations[i] == nil || delegations[i].Reward.Sign() == 0 {
+			continue
+		}
+		accountID, rosettaError := newAccountIdentifierWithSubAccount(delegator, validator, map[string]interface{}{
+			SubAccountMetadataKey: Reward,
+		})
+		if rosettaError != nil {
+			return rosettaError
+		}
+		transaction.Operations = append(transaction.Operations, &types.Operation{
+			OperationIdentifier: &types.OperationIdentifier{
+				Index: opIndex,
+			},
+			RelatedOperations: []*types.OperationIdentifier{
+				collectOperation.OperationIdentifier,
+			},
+			Type:    collectOperation.Type,
+			Status:  collectOperation.Status,
+			Account: accountID,
+			Amount: &types.Amount{
+				Value:    negativeBigValue(delegations[i].Reward),
+				Currency: &common.NativeCurrency,
+			},
+		})
+		opIndex++
+	}
+	return nil
+}
+
 // transactionInfo stores all related information for any transaction on the Harmony chain
 // Note that some elements can be nil if not applicable
 type transactionInfo struct {
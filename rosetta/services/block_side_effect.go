@@ -3,6 +3,7 @@ package services
 import (
 	"context"
 	"fmt"
+	"math/big"
 	"strings"
 
 	"github.com/coinbase/rosetta-sdk-go/types"
@@ -10,6 +11,7 @@ import (
 
 	"github.com/harmony-one/harmony/core"
 	hmytypes "github.com/harmony-one/harmony/core/types"
+	"github.com/harmony-one/harmony/internal/utils"
 	"github.com/harmony-one/harmony/rosetta/common"
 )
 
@@ -20,7 +22,11 @@ func (s *BlockAPI) containsSideEffectTransaction(
 	if blk == nil {
 		return false
 	}
-	return s.hmy.IsCommitteeSelectionBlock(blk.Header()) || !s.hmy.IsStakingEpoch(blk.Epoch()) || blk.NumberU64() == 0
+	if s.hmy.IsCommitteeSelectionBlock(blk.Header()) || !s.hmy.IsStakingEpoch(blk.Epoch()) || blk.NumberU64() == 0 {
+		return true
+	}
+	changes, err := s.hmy.GetDelegationChanges(ctx, blk)
+	return err == nil && len(changes) > 0
 }
 
 const (
@@ -32,7 +38,8 @@ const (
 
 // getSideEffectTransactionIdentifier fetches 'transaction identifier' for side effect operations
 // for a  given block.
-// Side effects are genesis funds, pre-staking era block rewards, and undelegation payouts.
+// Side effects are genesis funds, pre-staking era block rewards, undelegation payouts,
+// staking era reward accruals and slash debits.
 // Must include block hash to guarantee uniqueness of tx identifiers.
 func getSideEffectTransactionIdentifier(
 	blockHash ethcommon.Hash,
@@ -62,7 +69,8 @@ func unpackSideEffectTransactionIdentifier(
 }
 
 // getSideEffectTransaction returns the side effect transaction for a block if said block has one.
-// Side effects to reports are: genesis funds, undelegation payouts, permissioned-phase block rewards.
+// Side effects to reports are: genesis funds, undelegation payouts, permissioned-phase block rewards,
+// reward accruals & slash debits of delegations.
 func (s *BlockAPI) getSideEffectTransaction(
 	ctx context.Context, blk *hmytypes.Block,
 ) (*types.Transaction, *types.Error) {
@@ -119,6 +127,14 @@ func (s *BlockAPI) getSideEffectTransaction(
 		}
 		updateStartingOpIndex(ops)
 	}
+	// Handle reward accruals & slash debits of delegations
+	if s.hmy.IsStakingEpoch(blk.Epoch()) && blk.NumberU64() > 0 {
+		ops, rosettaError := s.getDelegationSideEffectOperations(ctx, blk, txOperations, startingOpIndex)
+		if rosettaError != nil {
+			return nil, rosettaError
+		}
+		updateStartingOpIndex(ops)
+	}
 
 	return &types.Transaction{
 		TransactionIdentifier: getSideEffectTransactionIdentifier(blk.Hash()),
@@ -152,3 +168,143 @@ func (s *BlockAPI) sideEffectBlockTransaction(
 	}
 	return &types.BlockTransactionResponse{Transaction: tx}, nil
 }
+
+// subAccountKey identifies a sub-account of an account
+type subAccountKey struct {
+	address, subAddress, subType string
+}
+
+// addSubAccountAmounts adds the amounts of the successful sub-account operations to the given sums.
+func addSubAccountAmounts(sums map[subAccountKey]*big.Int, operations []*types.Operation) *types.Error {
+	for _, op := range operations {
+		if op.Account == nil || op.Account.SubAccount == nil || op.Amount == nil ||
+			(op.Status != nil && *op.Status != common.SuccessOperationStatus.Status) {
+			continue
+		}
+		subType, _ := op.Account.SubAccount.Metadata[SubAccountMetadataKey].(string)
+		amount, err := types.AmountValue(op.Amount)
+		if err != nil {
+			return common.NewError(common.CatchAllError, map[string]interface{}{
+				"message": err.Error(),
+			})
+		}
+		key := subAccountKey{op.Account.Address, op.Account.SubAccount.Address, subType}
+		if sum, ok := sums[key]; ok {
+			amount = new(big.Int).Add(sum, amount)
+		}
+		sums[key] = amount
+	}
+	return nil
+}
+
+// getDelegationSideEffectOperations returns the reward accrual & slash debit operations of the block.
+// They are the changes of the delegation, undelegation & reward sub-accounts that are not reported by
+// the staking transactions of the block or by the given side effect operations (i.e: undelegation payouts).
+func (s *BlockAPI) getDelegationSideEffectOperations(
+	ctx context.Context, blk *hmytypes.Block, sideEffectOperations []*types.Operation,
+	startingOperationIndex *int64,
+) ([]*types.Operation, *types.Error) {
+	changes, err := s.hmy.GetDelegationChanges(ctx, blk)
+	if err != nil {
+		return nil, common.NewError(common.CatchAllError, map[string]interface{}{
+			"message": err.Error(),
+		})
+	}
+	operations := []*types.Operation{}
+	if len(changes) == 0 {
+		return operations, nil
+	}
+
+	// Sum up what is already reported
+	reported := map[subAccountKey]*big.Int{}
+	if rosettaError := addSubAccountAmounts(reported, sideEffectOperations); rosettaError != nil {
+		return nil, rosettaError
+	}
+	if len(blk.StakingTransactions()) > 0 {
+		receipts, err := s.hmy.GetReceipts(ctx, blk.Hash())
+		if err != nil {
+			return nil, common.NewError(common.CatchAllError, map[string]interface{}{
+				"message": err.Error(),
+			})
+		}
+		for i, tx := range blk.StakingTransactions() {
+			receiptIndex := len(blk.Transactions()) + i
+			if receiptIndex >= len(receipts) {
+				return nil, common.NewError(common.CatchAllError, map[string]interface{}{
+					"message": fmt.Sprintf("receipt not found for staking transaction %v", tx.Hash().String()),
+				})
+			}
+			transaction, rosettaError := FormatTransaction(tx, receipts[receiptIndex], &ContractInfo{}, true)
+			if rosettaError != nil {
+				return nil, rosettaError
+			}
+			if rosettaError := s.addCollectRewardsSubAccountOperations(ctx, blk, tx, transaction); rosettaError != nil {
+				return nil, rosettaError
+			}
+			if rosettaError := addSubAccountAmounts(reported, transaction.Operations); rosettaError != nil {
+				return nil, rosettaError
+			}
+		}
+	}
+
+	var opIndex int64
+	if startingOperationIndex != nil {
+		opIndex = *startingOperationIndex
+	}
+	for _, change := range changes {
+		for _, subAccount := range []struct {
+			subType string
+			delta   *big.Int
+		}{
+			{Delegation, change.Amount},
+			{UnDelegation, change.Undelegation},
+			{Reward, change.Reward},
+		} {
+			accountID, rosettaError := newAccountIdentifierWithSubAccount(
+				change.Delegator, change.Validator, map[string]interface{}{
+					SubAccountMetadataKey: subAccount.subType,
+				},
+			)
+			if rosettaError != nil {
+				return nil, rosettaError
+			}
+			remainder := new(big.Int).Set(subAccount.delta)
+			key := subAccountKey{accountID.Address, accountID.SubAccount.Address, subAccount.subType}
+			if amount, ok := reported[key]; ok {
+				remainder.Sub(remainder, amount)
+			}
+
+			var opType string
+			switch {
+			case remainder.Sign() < 0:
+				opType = common.SlashDebitOperation
+			case remainder.Sign() > 0 && subAccount.subType == Reward:
+				opType = common.RewardAccrualOperation
+			case remainder.Sign() > 0:
+				utils.Logger().Warn().
+					Uint64("block", blk.NumberU64()).
+					Str("account", accountID.Address).
+					Str("subAccount", accountID.SubAccount.Address).
+					Str("type", subAccount.subType).
+					Msg("[Rosetta] unexplained sub-account balance increase")
+				continue
+			default:
+				continue
+			}
+			operations = append(operations, &types.Operation{
+				OperationIdentifier: &types.OperationIdentifier{
+					Index: opIndex,
+				},
+				Type:    opType,
+				Status:  &common.SuccessOperationStatus.Status,
+				Account: accountID,
+				Amount: &types.Amount{
+					Value:    remainder.String(),
+					Currency: &common.NativeCurrency,
+				},
+			})
+			opIndex++
+		}
+	}
+	return operations, nil
+}
@@ -44,3 +44,59 @@ func TestSideEffectTransactionIdentifier(t *testing.T) {
 		t.Error("expected error code to be catch call error")
 	}
 }
+
+func TestAddSubAccountAmounts(t *testing.T) {
+	delegator := ethcommon.HexToAddress("0x0B585F8DaEfBC68a311FbD4cB20d9174aD174016")
+	validator := ethcommon.HexToAddress("0x7Ee5b9b2e9E0b6bbB5b2CfDDe9d1c2bF6cC4C7Ba")
+	newOperation := func(subType string, amount *big.Int, status string) *types.Operation {
+		accountID, rosettaError := newAccountIdentifierWithSubAccount(delegator, validator, map[string]interface{}{
+			SubAccountMetadataKey: subType,
+		})
+		if rosettaError != nil {
+			t.Fatal(rosettaError)
+		}
+		return &types.Operation{
+			Status:  &status,
+			Account: accountID,
+			Amount: &types.Amount{
+				Value:    amount.String(),
+				Currency: &common.NativeCurrency,
+			},
+		}
+	}
+	mainAccountID, rosettaError := newAccountIdentifier(delegator)
+	if rosettaError != nil {
+		t.Fatal(rosettaError)
+	}
+
+	sums := map[subAccountKey]*big.Int{}
+	rosettaError = addSubAccountAmounts(sums, []*types.Operation{
+		newOperation(Delegation, tenOnes, common.SuccessOperationStatus.Status),
+		newOperation(Delegation, new(big.Int).Neg(oneBig), common.SuccessOperationStatus.Status),
+		newOperation(Delegation, twelveOnes, common.FailureOperationStatus.Status),
+		newOperation(Reward, oneBig, common.SuccessOperationStatus.Status),
+		{
+			Status:  &common.SuccessOperationStatus.Status,
+			Account: mainAccountID,
+			Amount: &types.Amount{
+				Value:    twelveOnes.String(),
+				Currency: &common.NativeCurrency,
+			},
+		},
+	})
+	if rosettaError != nil {
+		t.Fatal(rosettaError)
+	}
+	if len(sums) != 2 {
+		t.Fatalf("expected 2 sub-accounts, got %v", len(sums))
+	}
+	subAddress := newOperation(Delegation, oneBig, "").Account.SubAccount.Address
+	delegation := sums[subAccountKey{mainAccountID.Address, subAddress, Delegation}]
+	if delegation == nil || delegation.Cmp(new(big.Int).Sub(tenOnes, oneBig)) != 0 {
+		t.Errorf("expected delegation sum of successful operations, got %v", delegation)
+	}
+	reward := sums[subAccountKey{mainAccountID.Address, subAddress, Reward}]
+	if reward == nil || reward.Cmp(oneBig) != 0 {
+		t.Errorf("expected reward sum %v, got %v", oneBig, reward)
+	}
+}
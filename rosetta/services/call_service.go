@@ -17,6 +17,7 @@ import (
 
 var CallMethod = []string{
 	"hmyv2_call",
+	"hmyv2_estimateGas",
 	"hmyv2_getCode",
 	"hmyv2_getStorageAt",
 	"hmyv2_getDelegationsByDelegator",
@@ -47,6 +48,8 @@ func (c *CallAPIService) Call(
 	switch request.Method {
 	case "hmyv2_call":
 		return c.call(ctx, request)
+	case "hmyv2_estimateGas":
+		return c.estimateGas(ctx, request)
 	case "hmyv2_getCode":
 		return c.getCode(ctx, request)
 	case "hmyv2_getStorageAt":
@@ -395,7 +398,7 @@ func (c *CallAPIService) call(
 			"message": errors.WithMessage(err, "invalid parameters").Error(),
 		})
 	}
-	data, err := contractAPI.Call(ctx, args.CallArgs, rpc.BlockNumberOrHashWithNumber(rpc.BlockNumber(args.BlockNum)))
+	data, err := contractAPI.Call(ctx, args.CallArgs, rpc.BlockNumberOrHashWithNumber(rpc.BlockNumber(args.BlockNum)), nil, nil)
 	if err != nil {
 		return nil, common.NewError(common.ErrCallExecute, map[string]interface{}{
 			"message": errors.WithMessage(err, "call smart contract error").Error(),
@@ -408,6 +411,36 @@ func (c *CallAPIService) call(
 	}, nil
 }
 
+func (c *CallAPIService) estimateGas(
+	ctx context.Context, request *types.CallRequest,
+) (*types.CallResponse, *types.Error) {
+	args := CallRequest{}
+	if err := args.UnmarshalFromInterface(request.Parameters); err != nil {
+		return nil, common.NewError(common.ErrCallParametersInvalid, map[string]interface{}{
+			"message": errors.WithMessage(err, "invalid parameters").Error(),
+		})
+	}
+	gas, err := estimateGas(ctx, c.hmy, args.CallArgs, rpc.BlockNumber(args.BlockNum))
+	if err != nil {
+		return nil, common.NewError(common.ErrCallExecute, map[string]interface{}{
+			"message": errors.WithMessage(err, "estimate gas error").Error(),
+		})
+	}
+	return &types.CallResponse{
+		Result: map[string]interface{}{
+			"result": gas,
+		},
+	}, nil
+}
+
+// estimateGas returns the gas needed to execute the call at the given block.
+// It is shared by the hmyv2_estimateGas call & the construction metadata of contract calls.
+func estimateGas(
+	ctx context.Context, hmy *hmy.Harmony, args rpc2.CallArgs, blockNum rpc.BlockNumber,
+) (uint64, error) {
+	return rpc2.EstimateGas(ctx, hmy, args, rpc.BlockNumberOrHashWithNumber(blockNum), nil, nil, nil)
+}
+
 type CallRequest struct {
 	rpc2.CallArgs
 	BlockNum int64 `json:"block_num"`
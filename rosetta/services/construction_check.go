@@ -23,6 +23,8 @@ type ConstructMetadataOptions struct {
 	TransactionMetadata *TransactionMetadata `json:"transaction_metadata"`
 	OperationType       string               `json:"operation_type,omitempty"`
 	GasPriceMultiplier  *float64             `json:"gas_price_multiplier,omitempty"`
+	// Amount is the native amount sent with a contract call
+	Amount *big.Int `json:"amount,omitempty"`
 }
 
 // UnmarshalFromInterface ..
@@ -98,6 +100,18 @@ func (s *ConstructAPI) ConstructionPreprocess(
 			"message": "given from & to shard are different for a native same shard transfer",
 		})
 	}
+	var amount *big.Int
+	switch components.Type {
+	case common.ContractCallOperation:
+		data := hexutil.Encode(components.Data)
+		txMetadata.ContractAccountIdentifier = components.To
+		txMetadata.Data = &data
+		amount = components.Amount
+	case common.HRC20TransferOperation:
+		data := hexutil.Encode(components.Data)
+		txMetadata.ContractAccountIdentifier = components.Contract
+		txMetadata.Data = &data
+	}
 	if request.SuggestedFeeMultiplier != nil && *request.SuggestedFeeMultiplier < 1 {
 		return nil, common.NewError(common.InvalidTransactionConstructionError, map[string]interface{}{
 			"message": "given gas price multiplier must be at least 1",
@@ -108,6 +122,7 @@ func (s *ConstructAPI) ConstructionPreprocess(
 		TransactionMetadata: txMetadata,
 		OperationType:       components.Type,
 		GasPriceMultiplier:  request.SuggestedFeeMultiplier,
+		Amount:              amount,
 	})
 	if err != nil {
 		return nil, common.NewError(common.CatchAllError, map[string]interface{}{
@@ -229,14 +244,25 @@ func (s *ConstructAPI) ConstructionMetadata(
 	}
 
 	latest := ethRpc.BlockNumberOrHashWithNumber(ethRpc.LatestBlockNumber)
+	isContractCall := options.OperationType == common.ContractCallOperation ||
+		options.OperationType == common.HRC20TransferOperation
+	var value *hexutil.Big
+	if options.Amount != nil {
+		value = (*hexutil.Big)(options.Amount)
+	}
 	var estGasUsed uint64
 	if !isStakingOperation(options.OperationType) {
-		if options.OperationType == common.ContractCreationOperation {
-			estGasUsed, err = rpc.EstimateGas(ctx, s.hmy, rpc.CallArgs{From: senderAddr, Data: &data}, latest, nil)
+		if isContractCall {
+			estGasUsed, err = estimateGas(
+				ctx, s.hmy, rpc.CallArgs{From: senderAddr, To: &contractAddress, Value: value, Data: &data},
+				ethRpc.LatestBlockNumber,
+			)
+		} else if options.OperationType == common.ContractCreationOperation {
+			estGasUsed, err = rpc.EstimateGas(ctx, s.hmy, rpc.CallArgs{From: senderAddr, Data: &data}, latest, nil, nil, nil)
 			estGasUsed *= 2 // HACK to account for imperfect contract creation estimation
 		} else {
 			estGasUsed, err = rpc.EstimateGas(
-				ctx, s.hmy, rpc.CallArgs{From: senderAddr, To: &contractAddress, Data: &data}, latest, nil,
+				ctx, s.hmy, rpc.CallArgs{From: senderAddr, To: &contractAddress, Data: &data}, latest, nil, nil, nil,
 			)
 		}
 	} else {
@@ -259,18 +285,19 @@ func (s *ConstructAPI) ConstructionMetadata(
 	evmErrorMsg := ""
 	evmReturn := hexutil.Bytes{}
 	if len(data) > 0 && (options.OperationType == common.ContractCreationOperation ||
-		options.OperationType == common.NativeTransferOperation) {
+		options.OperationType == common.NativeTransferOperation || isContractCall) {
 		gas := hexutil.Uint64(estGasUsed)
 		callArgs := rpc.CallArgs{
-			From: senderAddr,
-			Data: &data,
-			Gas:  &gas,
+			From:  senderAddr,
+			Value: value,
+			Data:  &data,
+			Gas:   &gas,
 		}
-		if options.OperationType == common.NativeTransferOperation {
+		if options.OperationType != common.ContractCreationOperation {
 			callArgs.To = &contractAddress
 		}
 		evmExe, err := rpc.DoEVMCall(
-			ctx, s.hmy, callArgs, latest, s.evmCallTimeout,
+			ctx, s.hmy, callArgs, latest, nil, nil, s.evmCallTimeout,
 		)
 		if err != nil {
 			return nil, common.NewError(common.CatchAllError, map[string]interface{}{
@@ -32,6 +32,9 @@ type WrappedTransaction struct {
 	IsStaking    bool                     `json:"is_staking"`
 	ContractCode hexutil.Bytes            `json:"contract_code"`
 	From         *types.AccountIdentifier `json:"from"`
+	// OperationType & Currency restore the intent of contract calls & HRC-20 transfers when parsing
+	OperationType string          `json:"operation_type,omitempty"`
+	Currency      *types.Currency `json:"currency,omitempty"`
 }
 
 // unpackWrappedTransactionFromString ..
@@ -328,10 +331,12 @@ func (s *ConstructAPI) ConstructionPayloads(
 		})
 	}
 	wrappedTxMarshalledBytes, err := json.Marshal(WrappedTransaction{
-		RLPBytes:     buf.Bytes(),
-		From:         senderID,
-		ContractCode: metadata.ContractCode,
-		IsStaking:    components.IsStaking(),
+		RLPBytes:      buf.Bytes(),
+		From:          senderID,
+		ContractCode:  metadata.ContractCode,
+		IsStaking:     components.IsStaking(),
+		OperationType: components.Type,
+		Currency:      components.Currency,
 	})
 	if err != nil {
 		return nil, common.NewError(common.CatchAllError, map[string]interface{}{
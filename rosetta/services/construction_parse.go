@@ -5,6 +5,7 @@ import (
 	"fmt"
 
 	"github.com/coinbase/rosetta-sdk-go/types"
+	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/pkg/errors"
 
 	hmyTypes "github.com/harmony-one/harmony/core/types"
@@ -74,6 +75,11 @@ func parseUnsignedTransaction(
 	if rosettaError != nil {
 		return nil, rosettaError
 	}
+	if formattedTx.Operations, rosettaError = formatContractCallOperations(
+		wrappedTransaction, tx, formattedTx.Operations,
+	); rosettaError != nil {
+		return nil, rosettaError
+	}
 	tempAccID, rosettaError := newAccountIdentifier(FormatDefaultSenderAddress)
 	if rosettaError != nil {
 		return nil, rosettaError
@@ -131,6 +137,11 @@ func parseSignedTransaction(
 			"message": "wrapped transaction sender/from does not match transaction signer",
 		})
 	}
+	if formattedTx.Operations, rosettaError = formatContractCallOperations(
+		wrappedTransaction, tx, formattedTx.Operations,
+	); rosettaError != nil {
+		return nil, rosettaError
+	}
 	for _, op := range formattedTx.Operations {
 		op.Status = nil
 	}
@@ -139,3 +150,67 @@ func parseSignedTransaction(
 		AccountIdentifierSigners: []*types.AccountIdentifier{senderID},
 	}, nil
 }
+
+// formatContractCallOperations restores the contract call & HRC-20 transfer operations of the
+// wrapped transaction, as they are formatted as a native transfer to the contract otherwise.
+func formatContractCallOperations(
+	wrappedTransaction *WrappedTransaction, tx hmyTypes.PoolTransaction, operations []*types.Operation,
+) ([]*types.Operation, *types.Error) {
+	if wrappedTransaction.OperationType != common.ContractCallOperation &&
+		wrappedTransaction.OperationType != common.HRC20TransferOperation {
+		return operations, nil
+	}
+	plainTx, ok := tx.(*hmyTypes.Transaction)
+	if !ok || plainTx.To() == nil {
+		return nil, common.NewError(common.InvalidTransactionConstructionError, map[string]interface{}{
+			"message": "contract call must be a plain transaction with a receiver",
+		})
+	}
+	contract, rosettaError := newAccountIdentifier(*plainTx.To())
+	if rosettaError != nil {
+		return nil, rosettaError
+	}
+
+	if wrappedTransaction.OperationType == common.ContractCallOperation {
+		for _, op := range operations {
+			if op.Type != common.NativeTransferOperation {
+				continue
+			}
+			op.Type = common.ContractCallOperation
+			if op.Account.Address != contract.Address {
+				op.Metadata = map[string]interface{}{
+					"contract": contract,
+					"data":     hexutil.Encode(plainTx.Data()),
+				}
+			}
+		}
+		return operations, nil
+	}
+
+	if wrappedTransaction.Currency == nil {
+		return nil, common.NewError(common.InvalidTransactionConstructionError, map[string]interface{}{
+			"message": "HRC-20 transfer requires the token currency",
+		})
+	}
+	to, amount, ok := unpackHRC20Transfer(plainTx.Data())
+	if !ok {
+		return nil, common.NewError(common.InvalidTransactionConstructionError, map[string]interface{}{
+			"message": "invalid HRC-20 transfer data",
+		})
+	}
+	toID, rosettaError := newAccountIdentifier(to)
+	if rosettaError != nil {
+		return nil, rosettaError
+	}
+	// only keep the gas expenditure, the transfer of zero native tokens to the contract is implied
+	tokenOps := []*types.Operation{}
+	for _, op := range operations {
+		if op.Type == common.ExpendGasOperation {
+			tokenOps = append(tokenOps, op)
+		}
+	}
+	startingIndex := int64(len(tokenOps))
+	return append(tokenOps, newHRC20TransferOperations(
+		wrappedTransaction.From, toID, amount, wrappedTransaction.Currency, "", &startingIndex,
+	)...), nil
+}
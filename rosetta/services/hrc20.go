@@ -0,0 +1,231 @@
+package services
+
+import (
+	"bytes"
+	"context"
+	"math/big"
+	"strings"
+
+	"github.com/coinbase/rosetta-sdk-go/types"
+	ethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+	lru "github.com/hashicorp/golang-lru"
+
+	"github.com/harmony-one/harmony/core"
+	hmytypes "github.com/harmony-one/harmony/core/types"
+	"github.com/harmony-one/harmony/eth/rpc"
+	"github.com/harmony-one/harmony/hmy"
+	"github.com/harmony-one/harmony/rosetta/common"
+	rpc2 "github.com/harmony-one/harmony/rpc/harmony"
+)
+
+const (
+	// hrc20TransferDataLength is the length of the call data of transfer(address,uint256)
+	hrc20TransferDataLength = 4 + 32 + 32
+
+	// maxHRC20DecimalPlaces bounds the decimals reported by a token contract
+	maxHRC20DecimalPlaces = 77
+)
+
+var (
+	hrc20TransferSelector   = crypto.Keccak256([]byte("transfer(address,uint256)"))[:4]
+	hrc20SymbolSelector     = crypto.Keccak256([]byte("symbol()"))[:4]
+	hrc20DecimalsSelector   = crypto.Keccak256([]byte("decimals()"))[:4]
+	hrc20TransferEventTopic = crypto.Keccak256Hash([]byte("Transfer(address,address,uint256)"))
+
+	// hrc20CurrencyCache keeps the resolved currency of token contracts, nil for other contracts
+	hrc20CurrencyCache, _ = lru.New(maxCacheNum)
+)
+
+// packHRC20Transfer returns the call data of transfer(to, amount)
+func packHRC20Transfer(to ethcommon.Address, amount *big.Int) []byte {
+	data := make([]byte, 0, hrc20TransferDataLength)
+	data = append(data, hrc20TransferSelector...)
+	data = append(data, ethcommon.LeftPadBytes(to.Bytes(), 32)...)
+	return append(data, ethcommon.LeftPadBytes(amount.Bytes(), 32)...)
+}
+
+// unpackHRC20Transfer returns the receiver & amount of transfer(to, amount) call data
+func unpackHRC20Transfer(data []byte) (ethcommon.Address, *big.Int, bool) {
+	if len(data) != hrc20TransferDataLength || !bytes.Equal(data[:4], hrc20TransferSelector) {
+		return ethcommon.Address{}, nil, false
+	}
+	to := ethcommon.BytesToAddress(data[4:36])
+	return to, new(big.Int).SetBytes(data[36:]), true
+}
+
+// isHRC20TransferLog returns true if the log is a HRC-20 Transfer event.
+// Note that ERC-721 Transfer events have the same signature but index the token ID.
+func isHRC20TransferLog(log *hmytypes.Log) bool {
+	return len(log.Topics) == 3 && log.Topics[0] == hrc20TransferEventTopic && len(log.Data) == 32
+}
+
+// getHRC20TransferOperations extracts & formats the HRC-20 token transfers from the Transfer
+// events of the receipt. Transfers of tokens without a known currency are skipped.
+func getHRC20TransferOperations(
+	receipt *hmytypes.Receipt, currencies map[ethcommon.Address]*types.Currency, status string,
+	startingOperationIndex *int64,
+) ([]*types.Operation, *types.Error) {
+	ops := []*types.Operation{}
+	for _, log := range receipt.Logs {
+		if !isHRC20TransferLog(log) {
+			continue
+		}
+		currency, ok := currencies[log.Address]
+		if !ok {
+			continue
+		}
+		from, rosettaError := newAccountIdentifier(ethcommon.BytesToAddress(log.Topics[1].Bytes()))
+		if rosettaError != nil {
+			return nil, rosettaError
+		}
+		to, rosettaError := newAccountIdentifier(ethcommon.BytesToAddress(log.Topics[2].Bytes()))
+		if rosettaError != nil {
+			return nil, rosettaError
+		}
+		ops = append(ops, newHRC20TransferOperations(
+			from, to, new(big.Int).SetBytes(log.Data), currency, status, startingOperationIndex,
+		)...)
+		nextOpIndex := ops[len(ops)-1].OperationIdentifier.Index + 1
+		startingOperationIndex = &nextOpIndex
+	}
+	return ops, nil
+}
+
+// newHRC20TransferOperations creates a new slice of operations for a HRC-20 token transfer.
+func newHRC20TransferOperations(
+	from, to *types.AccountIdentifier, amount *big.Int, currency *types.Currency, status string,
+	startingOperationIndex *int64,
+) []*types.Operation {
+	var opIndex int64
+	if startingOperationIndex != nil {
+		opIndex = *startingOperationIndex
+	}
+	subOperationID := &types.OperationIdentifier{
+		Index: opIndex,
+	}
+	return []*types.Operation{
+		{
+			OperationIdentifier: subOperationID,
+			Type:                common.HRC20TransferOperation,
+			Status:              &status,
+			Account:             from,
+			Amount: &types.Amount{
+				Value:    negativeBigValue(amount),
+				Currency: currency,
+			},
+		},
+		{
+			OperationIdentifier: &types.OperationIdentifier{
+				Index: opIndex + 1,
+			},
+			RelatedOperations: []*types.OperationIdentifier{
+				subOperationID,
+			},
+			Type:    common.HRC20TransferOperation,
+			Status:  &status,
+			Account: to,
+			Amount: &types.Amount{
+				Value:    amount.String(),
+				Currency: currency,
+			},
+		},
+	}
+}
+
+// getHRC20Currencies resolves the currency of the token contracts emitting Transfer events in the receipt.
+// Contracts that do not implement symbol() and decimals() are skipped.
+func getHRC20Currencies(
+	ctx context.Context, hmy *hmy.Harmony, receipt *hmytypes.Receipt, blockNum rpc.BlockNumber,
+) map[ethcommon.Address]*types.Currency {
+	currencies := map[ethcommon.Address]*types.Currency{}
+	for _, log := range receipt.Logs {
+		if !isHRC20TransferLog(log) {
+			continue
+		}
+		if _, ok := currencies[log.Address]; ok {
+			continue
+		}
+		if currency := getHRC20Currency(ctx, hmy, log.Address, blockNum); currency != nil {
+			currencies[log.Address] = currency
+		}
+	}
+	return currencies
+}
+
+// getHRC20Currency returns the currency of the token contract, nil if it cannot be resolved.
+// Contracts that are not tokens are cached as well, so that they are not called again.
+func getHRC20Currency(
+	ctx context.Context, hmy *hmy.Harmony, contract ethcommon.Address, blockNum rpc.BlockNumber,
+) *types.Currency {
+	if cached, ok := hrc20CurrencyCache.Get(contract); ok {
+		return cached.(*types.Currency)
+	}
+	currency, err := resolveHRC20Currency(ctx, hmy, contract, blockNum)
+	if err != nil {
+		// the call itself failed, e.g. on a timeout, so the contract may be resolved next time
+		return nil
+	}
+	hrc20CurrencyCache.Add(contract, currency)
+	return currency
+}
+
+// resolveHRC20Currency calls symbol() and decimals() of the token contract. The currency is nil
+// if the contract does not implement them, and the error is only set if a call cannot be made.
+func resolveHRC20Currency(
+	ctx context.Context, hmy *hmy.Harmony, contract ethcommon.Address, blockNum rpc.BlockNumber,
+) (*types.Currency, error) {
+	symbolResult, err := callHRC20(ctx, hmy, contract, hrc20SymbolSelector, blockNum)
+	if err != nil || symbolResult.Failed() {
+		return nil, err
+	}
+	symbol, ok := unpackHRC20Symbol(symbolResult.ReturnData)
+	if !ok {
+		return nil, nil
+	}
+	decimalsResult, err := callHRC20(ctx, hmy, contract, hrc20DecimalsSelector, blockNum)
+	if err != nil || decimalsResult.Failed() || len(decimalsResult.ReturnData) != 32 {
+		return nil, err
+	}
+	decimals := new(big.Int).SetBytes(decimalsResult.ReturnData)
+	if !decimals.IsUint64() || decimals.Uint64() > maxHRC20DecimalPlaces {
+		return nil, nil
+	}
+	currency, err := common.NewHRC20Currency(contract, symbol, int32(decimals.Uint64()))
+	if err != nil {
+		return nil, nil
+	}
+	return currency, nil
+}
+
+func callHRC20(
+	ctx context.Context, hmy *hmy.Harmony, contract ethcommon.Address, data []byte, blockNum rpc.BlockNumber,
+) (core.ExecutionResult, error) {
+	input := hexutil.Bytes(data)
+	return rpc2.DoEVMCall(
+		ctx, hmy, rpc2.CallArgs{To: &contract, Data: &input}, rpc.BlockNumberOrHashWithNumber(blockNum),
+		nil, nil, hmy.NodeAPI.GetConfig().NodeConfig.RPCServer.EvmCallTimeout,
+	)
+}
+
+// unpackHRC20Symbol decodes the symbol returned as an ABI string, or as bytes32 by older tokens
+func unpackHRC20Symbol(data []byte) (string, bool) {
+	if len(data) == 32 {
+		symbol := strings.TrimRight(string(data), "\x00")
+		return symbol, symbol != ""
+	}
+	if len(data) < 64 {
+		return "", false
+	}
+	offset := new(big.Int).SetBytes(data[:32])
+	if !offset.IsUint64() || offset.Uint64()+32 > uint64(len(data)) {
+		return "", false
+	}
+	start := offset.Uint64() + 32
+	length := new(big.Int).SetBytes(data[offset.Uint64():start])
+	if !length.IsUint64() || length.Uint64() == 0 || start+length.Uint64() > uint64(len(data)) {
+		return "", false
+	}
+	return string(data[start : start+length.Uint64()]), true
+}
@@ -0,0 +1,129 @@
+package services
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/coinbase/rosetta-sdk-go/types"
+	ethcommon "github.com/ethereum/go-ethereum/common"
+
+	hmytypes "github.com/harmony-one/harmony/core/types"
+	"github.com/harmony-one/harmony/rosetta/common"
+)
+
+func TestPackHRC20Transfer(t *testing.T) {
+	refTo := ethcommon.HexToAddress("0x0B585F8DaEfBC68a311FbD4cB20d9174aD174016")
+	refAmount := big.NewInt(123456789)
+
+	data := packHRC20Transfer(refTo, refAmount)
+	if len(data) != hrc20TransferDataLength {
+		t.Fatalf("expected data length %v, got %v", hrc20TransferDataLength, len(data))
+	}
+	to, amount, ok := unpackHRC20Transfer(data)
+	if !ok {
+		t.Fatal("expected transfer data to unpack")
+	}
+	if to != refTo || amount.Cmp(refAmount) != 0 {
+		t.Errorf("expected %v & %v, got %v & %v", refTo.String(), refAmount, to.String(), amount)
+	}
+
+	if _, _, ok := unpackHRC20Transfer(data[:len(data)-1]); ok {
+		t.Error("expected truncated data to not unpack")
+	}
+	data[0] ^= 0xff
+	if _, _, ok := unpackHRC20Transfer(data); ok {
+		t.Error("expected data of another method to not unpack")
+	}
+}
+
+func TestUnpackHRC20Symbol(t *testing.T) {
+	// bytes32 symbol
+	symbol, ok := unpackHRC20Symbol(ethcommon.RightPadBytes([]byte("ONE"), 32))
+	if !ok || symbol != "ONE" {
+		t.Errorf("expected symbol ONE, got %v", symbol)
+	}
+
+	// ABI string symbol
+	data := ethcommon.LeftPadBytes(big.NewInt(32).Bytes(), 32)
+	data = append(data, ethcommon.LeftPadBytes(big.NewInt(4).Bytes(), 32)...)
+	data = append(data, ethcommon.RightPadBytes([]byte("WONE"), 32)...)
+	symbol, ok = unpackHRC20Symbol(data)
+	if !ok || symbol != "WONE" {
+		t.Errorf("expected symbol WONE, got %v", symbol)
+	}
+
+	// bad offset
+	data[31] = 0xff
+	if _, ok := unpackHRC20Symbol(data); ok {
+		t.Error("expected bad offset to not unpack")
+	}
+}
+
+func TestGetHRC20TransferOperations(t *testing.T) {
+	refContract := ethcommon.HexToAddress("0xcF664087a5bB0237a0BAd6742852ec6c8d69A27a")
+	refCurrency, err := common.NewHRC20Currency(refContract, "WONE", 18)
+	if err != nil {
+		t.Fatal(err)
+	}
+	refFrom := ethcommon.HexToAddress("0x0B585F8DaEfBC68a311FbD4cB20d9174aD174016")
+	refTo := ethcommon.HexToAddress("0x7Ee5b9b2e9E0b6bbB5b2CfDDe9d1c2bF6cC4C7Ba")
+	refAmount := big.NewInt(1e18)
+	transferLog := &hmytypes.Log{
+		Address: refContract,
+		Topics: []ethcommon.Hash{
+			hrc20TransferEventTopic,
+			ethcommon.BytesToHash(refFrom.Bytes()),
+			ethcommon.BytesToHash(refTo.Bytes()),
+		},
+		Data: ethcommon.LeftPadBytes(refAmount.Bytes(), 32),
+	}
+	// ERC-721 transfer, which indexes the token ID
+	nftLog := &hmytypes.Log{
+		Address: refContract,
+		Topics:  append(append([]ethcommon.Hash{}, transferLog.Topics...), ethcommon.Hash{}),
+	}
+	// transfer of a token without a known currency
+	unknownLog := &hmytypes.Log{
+		Address: refTo,
+		Topics:  transferLog.Topics,
+		Data:    transferLog.Data,
+	}
+	receipt := &hmytypes.Receipt{
+		Logs: []*hmytypes.Log{transferLog, nftLog, unknownLog},
+	}
+
+	startingIndex := int64(3)
+	ops, rosettaError := getHRC20TransferOperations(
+		receipt, map[ethcommon.Address]*types.Currency{refContract: refCurrency},
+		common.SuccessOperationStatus.Status, &startingIndex,
+	)
+	if rosettaError != nil {
+		t.Fatal(rosettaError)
+	}
+	if len(ops) != 2 {
+		t.Fatalf("expected 2 operations, got %v", len(ops))
+	}
+	fromID, rosettaError := newAccountIdentifier(refFrom)
+	if rosettaError != nil {
+		t.Fatal(rosettaError)
+	}
+	toID, rosettaError := newAccountIdentifier(refTo)
+	if rosettaError != nil {
+		t.Fatal(rosettaError)
+	}
+	if types.Hash(ops[0].Account) != types.Hash(fromID) || types.Hash(ops[1].Account) != types.Hash(toID) {
+		t.Error("expected sender & receiver of the transfer")
+	}
+	if ops[0].Amount.Value != negativeBigValue(refAmount) || ops[1].Amount.Value != refAmount.String() {
+		t.Error("expected amounts of the transfer")
+	}
+	if types.Hash(ops[0].Amount.Currency) != types.Hash(refCurrency) {
+		t.Error("expected token currency")
+	}
+	if ops[0].OperationIdentifier.Index != startingIndex || ops[1].OperationIdentifier.Index != startingIndex+1 {
+		t.Error("expected operation indices to start at the starting index")
+	}
+	if len(ops[1].RelatedOperations) != 1 || ops[1].RelatedOperations[0].Index != startingIndex {
+		t.Error("expected receiver operation to relate to sender operation")
+	}
+}
@@ -75,6 +75,14 @@ func ConstructTransaction(
 		if tx, rosettaError = constructContractCreationTransaction(components, metadata, sourceShardID); rosettaError != nil {
 			return nil, rosettaError
 		}
+	case common.ContractCallOperation:
+		if tx, rosettaError = constructContractCallTransaction(components, metadata, sourceShardID); rosettaError != nil {
+			return nil, rosettaError
+		}
+	case common.HRC20TransferOperation:
+		if tx, rosettaError = constructHRC20TransferTransaction(components, metadata, sourceShardID); rosettaError != nil {
+			return nil, rosettaError
+		}
 	case common.NativeTransferOperation:
 		if tx, rosettaError = constructPlainTransaction(components, metadata, sourceShardID); rosettaError != nil {
 			return nil, rosettaError
@@ -171,6 +179,53 @@ func constructContractCreationTransaction(
 	), nil
 }
 
+// constructContractCallTransaction ..
+func constructContractCallTransaction(
+	components *OperationComponents, metadata *ConstructMetadata, sourceShardID uint32,
+) (hmyTypes.PoolTransaction, *types.Error) {
+	if components.To == nil {
+		return nil, common.NewError(common.InvalidTransactionConstructionError, map[string]interface{}{
+			"message": "contract call requires a contract",
+		})
+	}
+	contract, err := getAddress(components.To)
+	if err != nil {
+		return nil, common.NewError(common.InvalidTransactionConstructionError, map[string]interface{}{
+			"message": errors.WithMessage(err, "invalid contract address").Error(),
+		})
+	}
+	return hmyTypes.NewTransaction(
+		metadata.Nonce, contract, sourceShardID, components.Amount, metadata.GasLimit, metadata.GasPrice,
+		components.Data,
+	), nil
+}
+
+// constructHRC20TransferTransaction ..
+func constructHRC20TransferTransaction(
+	components *OperationComponents, metadata *ConstructMetadata, sourceShardID uint32,
+) (hmyTypes.PoolTransaction, *types.Error) {
+	if components.Contract == nil {
+		return nil, common.NewError(common.InvalidTransactionConstructionError, map[string]interface{}{
+			"message": "HRC-20 transfer requires a token contract",
+		})
+	}
+	contract, err := getAddress(components.Contract)
+	if err != nil {
+		return nil, common.NewError(common.InvalidTransactionConstructionError, map[string]interface{}{
+			"message": errors.WithMessage(err, "invalid contract address").Error(),
+		})
+	}
+	if _, _, ok := unpackHRC20Transfer(components.Data); !ok {
+		return nil, common.NewError(common.InvalidTransactionConstructionError, map[string]interface{}{
+			"message": "invalid HRC-20 transfer data",
+		})
+	}
+	return hmyTypes.NewTransaction(
+		metadata.Nonce, contract, sourceShardID, big.NewInt(0), metadata.GasLimit, metadata.GasPrice,
+		components.Data,
+	), nil
+}
+
 func constructCreateValidatorTransaction(
 	components *OperationComponents, metadata *ConstructMetadata,
 ) (hmyTypes.PoolTransaction, *types.Error) {
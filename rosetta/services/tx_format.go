@@ -27,6 +27,8 @@ type ContractInfo struct {
 	// ContractCode is the code of the primary (or first) contract related to the tx.
 	ContractCode    []byte                    `json:"contract_code"`
 	ExecutionResult []*tracers.RosettaLogItem `json:"execution_result"`
+	// TokenCurrencies are the currencies of the HRC-20 tokens transferred by the tx.
+	TokenCurrencies map[ethcommon.Address]*types.Currency `json:"token_currencies"`
 }
 
 // FormatTransaction for staking, cross-shard sender, and plain transactions
@@ -54,6 +56,17 @@ func FormatTransaction(
 		if rosettaError != nil {
 			return nil, rosettaError
 		}
+		if len(contractInfo.TokenCurrencies) > 0 {
+			status := GetTransactionStatus(tx, receipt)
+			startingIndex := operations[len(operations)-1].OperationIdentifier.Index + 1
+			tokenOperations, rosettaError := getHRC20TransferOperations(
+				receipt, contractInfo.TokenCurrencies, *status, &startingIndex,
+			)
+			if rosettaError != nil {
+				return nil, rosettaError
+			}
+			operations = append(operations, tokenOperations...)
+		}
 		isCrossShard = plainTx.ShardID() != plainTx.ToShardID()
 		isContractCreation = tx.To() == nil
 		toShard = plainTx.ToShardID()
@@ -25,6 +25,7 @@ const (
 	SubAccountMetadataKey = "type"
 	Delegation            = "delegation"
 	UnDelegation          = "undelegation"
+	Reward                = "reward"
 	UndelegationPayout    = "UndelegationPayout"
 )
 
@@ -7,6 +7,7 @@ import (
 	common2 "github.com/harmony-one/harmony/internal/common"
 
 	"github.com/coinbase/rosetta-sdk-go/types"
+	"github.com/ethereum/go-ethereum/common/hexutil"
 
 	"github.com/harmony-one/harmony/rosetta/common"
 	"github.com/pkg/errors"
@@ -27,6 +28,12 @@ type OperationComponents struct {
 	To             *types.AccountIdentifier `json:"to"`
 	Amount         *big.Int                 `json:"amount"`
 	StakingMessage interface{}              `json:"staking_message,omitempty"`
+	// Contract is the token contract of HRC-20 transfers
+	Contract *types.AccountIdentifier `json:"contract,omitempty"`
+	// Currency is the token currency of HRC-20 transfers
+	Currency *types.Currency `json:"currency,omitempty"`
+	// Data is the call data of contract calls & HRC-20 transfers
+	Data hexutil.Bytes `json:"data,omitempty"`
 }
 
 // IsStaking ..
@@ -55,6 +62,9 @@ func GetOperationComponents(
 	}
 
 	if len(operations) == transferOperationCount {
+		if operations[0].Type == common.HRC20TransferOperation {
+			return getHRC20TransferOperationComponents(operations)
+		}
 		return getTransferOperationComponents(operations)
 	}
 	switch operations[0].Type {
@@ -62,6 +72,8 @@ func GetOperationComponents(
 		return getCrossShardOperationComponents(operations[0])
 	case common.ContractCreationOperation:
 		return getContractCreationOperationComponents(operations[0])
+	case common.ContractCallOperation:
+		return getContractCallOperationComponents(operations[0])
 	case common.CreateValidatorOperation:
 		return getCreateValidatorOperationComponents(operations[0])
 	case common.EditValidatorOperation:
@@ -245,6 +257,141 @@ func getContractCreationOperationComponents(
 	return components, nil
 }
 
+// getContractCallOperationComponents ..
+func getContractCallOperationComponents(
+	operation *types.Operation,
+) (*OperationComponents, *types.Error) {
+	if operation == nil {
+		return nil, common.NewError(common.CatchAllError, map[string]interface{}{
+			"message": "nil operation",
+		})
+	}
+	metadata := common.ContractCallOperationMetadata{}
+	if err := metadata.UnmarshalFromInterface(operation.Metadata); err != nil {
+		return nil, common.NewError(common.InvalidTransactionConstructionError, map[string]interface{}{
+			"message": errors.WithMessage(err, "invalid metadata").Error(),
+		})
+	}
+	amount, err := types.AmountValue(operation.Amount)
+	if err != nil {
+		return nil, common.NewError(common.InvalidTransactionConstructionError, map[string]interface{}{
+			"message": err.Error(),
+		})
+	}
+	if amount.Sign() == 1 {
+		return nil, common.NewError(common.InvalidTransactionConstructionError, map[string]interface{}{
+			"message": "sender amount must not be positive for contract call",
+		})
+	}
+	if types.Hash(operation.Amount.Currency) != common.NativeCurrencyHash {
+		return nil, common.NewError(common.InvalidTransactionConstructionError, map[string]interface{}{
+			"message": "invalid currency for provided amounts",
+		})
+	}
+	data := hexutil.Bytes{}
+	if metadata.Data != "" {
+		// data format already got checked inside UnmarshalFromInterface
+		data = hexutil.MustDecode(metadata.Data)
+	}
+
+	components := &OperationComponents{
+		Type:   operation.Type,
+		From:   operation.Account,
+		To:     metadata.Contract,
+		Amount: new(big.Int).Abs(amount),
+		Data:   data,
+	}
+	if components.From == nil {
+		return nil, common.NewError(common.InvalidTransactionConstructionError, map[string]interface{}{
+			"message": "operation must have account sender/from identifier for contract call",
+		})
+	}
+	return components, nil
+}
+
+// getHRC20TransferOperationComponents ..
+func getHRC20TransferOperationComponents(
+	operations []*types.Operation,
+) (*OperationComponents, *types.Error) {
+	if len(operations) != transferOperationCount {
+		return nil, common.NewError(common.CatchAllError, map[string]interface{}{
+			"message": "require exactly 2 operations",
+		})
+	}
+	op0, op1 := operations[0], operations[1]
+	if op0.Type != common.HRC20TransferOperation || op1.Type != common.HRC20TransferOperation {
+		return nil, common.NewError(common.InvalidTransactionConstructionError, map[string]interface{}{
+			"message": "invalid operation type(s) for HRC-20 transfer",
+		})
+	}
+
+	val0, err := types.AmountValue(op0.Amount)
+	if err != nil {
+		return nil, common.NewError(common.InvalidTransactionConstructionError, map[string]interface{}{
+			"message": err.Error(),
+		})
+	}
+	val1, err := types.AmountValue(op1.Amount)
+	if err != nil {
+		return nil, common.NewError(common.InvalidTransactionConstructionError, map[string]interface{}{
+			"message": err.Error(),
+		})
+	}
+	if new(big.Int).Add(val0, val1).Cmp(big.NewInt(0)) != 0 {
+		return nil, common.NewError(common.InvalidTransactionConstructionError, map[string]interface{}{
+			"message": "amount taken from sender is not exactly paid out to receiver for HRC-20 transfer",
+		})
+	}
+	if types.Hash(op0.Amount.Currency) != types.Hash(op1.Amount.Currency) {
+		return nil, common.NewError(common.InvalidTransactionConstructionError, map[string]interface{}{
+			"message": "invalid currency for provided amounts",
+		})
+	}
+	contractAddr, err := common.GetHRC20Contract(op0.Amount.Currency)
+	if err != nil {
+		return nil, common.NewError(common.InvalidTransactionConstructionError, map[string]interface{}{
+			"message": errors.WithMessage(err, "invalid currency for provided amounts").Error(),
+		})
+	}
+	contract, rosettaError := newAccountIdentifier(contractAddr)
+	if rosettaError != nil {
+		return nil, rosettaError
+	}
+	if len(op0.RelatedOperations) > 1 || len(op1.RelatedOperations) > 1 ||
+		len(op0.RelatedOperations)^len(op1.RelatedOperations) != 1 {
+		return nil, common.NewError(common.InvalidTransactionConstructionError, map[string]interface{}{
+			"message": "operations must only relate to one another in one direction for HRC-20 transfers",
+		})
+	}
+
+	components := &OperationComponents{
+		Type:     op0.Type,
+		Amount:   new(big.Int).Abs(val0),
+		Contract: contract,
+		Currency: op0.Amount.Currency,
+	}
+	if val0.Sign() != 1 {
+		components.From = op0.Account
+		components.To = op1.Account
+	} else {
+		components.From = op1.Account
+		components.To = op0.Account
+	}
+	if components.From == nil || components.To == nil {
+		return nil, common.NewError(common.InvalidTransactionConstructionError, map[string]interface{}{
+			"message": "both operations must have account identifiers for HRC-20 transfer",
+		})
+	}
+	to, err := getAddress(components.To)
+	if err != nil {
+		return nil, common.NewError(common.InvalidTransactionConstructionError, map[string]interface{}{
+			"message": errors.WithMessage(err, "invalid receiver address").Error(),
+		})
+	}
+	components.Data = packHRC20Transfer(to, components.Amount)
+	return components, nil
+}
+
 func getCreateValidatorOperationComponents(
 	operation *types.Operation,
 ) (*OperationComponents, *types.Error) {
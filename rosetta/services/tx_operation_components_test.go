@@ -490,6 +490,194 @@ func TestGetTransferOperationComponents(t *testing.T) {
 	}
 }
 
+func TestGetContractCallOperationComponents(t *testing.T) {
+	refKey := internalCommon.MustGeneratePrivateKey()
+	refFrom, rosettaError := newAccountIdentifier(crypto.PubkeyToAddress(refKey.PublicKey))
+	if rosettaError != nil {
+		t.Fatal(rosettaError)
+	}
+	refContractKey := internalCommon.MustGeneratePrivateKey()
+	refContract, rosettaError := newAccountIdentifier(crypto.PubkeyToAddress(refContractKey.PublicKey))
+	if rosettaError != nil {
+		t.Fatal(rosettaError)
+	}
+	refMetadata := map[string]interface{}{
+		"contract": refContract,
+		"data":     "0xa9059cbb",
+	}
+
+	// test valid operation
+	refOperation := &types.Operation{
+		Type: common.ContractCallOperation,
+		Amount: &types.Amount{
+			Value:    "-12000",
+			Currency: &common.NativeCurrency,
+		},
+		Account:  refFrom,
+		Metadata: refMetadata,
+	}
+	testComponents, rosettaError := getContractCallOperationComponents(refOperation)
+	if rosettaError != nil {
+		t.Fatal(rosettaError)
+	}
+	if testComponents.Type != refOperation.Type {
+		t.Error("expected same operation")
+	}
+	if testComponents.From == nil || types.Hash(testComponents.From) != types.Hash(refFrom) {
+		t.Error("expect same sender")
+	}
+	if testComponents.To == nil || types.Hash(testComponents.To) != types.Hash(refContract) {
+		t.Error("expect contract as receiver")
+	}
+	if testComponents.Amount.Cmp(big.NewInt(12000)) != 0 {
+		t.Error("expected amount to be absolute value of reference amount")
+	}
+	if testComponents.Data.String() != "0xa9059cbb" {
+		t.Errorf("expected data to be %v, got %v", "0xa9059cbb", testComponents.Data)
+	}
+
+	// test missing contract
+	_, rosettaError = getContractCallOperationComponents(&types.Operation{
+		Type:     common.ContractCallOperation,
+		Amount:   refOperation.Amount,
+		Account:  refFrom,
+		Metadata: map[string]interface{}{"data": "0xa9059cbb"},
+	})
+	if rosettaError == nil {
+		t.Error("expected error")
+	}
+
+	// test invalid data
+	_, rosettaError = getContractCallOperationComponents(&types.Operation{
+		Type:    common.ContractCallOperation,
+		Amount:  refOperation.Amount,
+		Account: refFrom,
+		Metadata: map[string]interface{}{
+			"contract": refContract,
+			"data":     "not hex",
+		},
+	})
+	if rosettaError == nil {
+		t.Error("expected error")
+	}
+
+	// test positive amount
+	_, rosettaError = getContractCallOperationComponents(&types.Operation{
+		Type: common.ContractCallOperation,
+		Amount: &types.Amount{
+			Value:    "12000",
+			Currency: &common.NativeCurrency,
+		},
+		Account:  refFrom,
+		Metadata: refMetadata,
+	})
+	if rosettaError == nil {
+		t.Error("expected error")
+	}
+
+	// test nil account
+	_, rosettaError = getContractCallOperationComponents(&types.Operation{
+		Type:     common.ContractCallOperation,
+		Amount:   refOperation.Amount,
+		Metadata: refMetadata,
+	})
+	if rosettaError == nil {
+		t.Error("expected error")
+	}
+
+	// test nil operation
+	_, rosettaError = getContractCallOperationComponents(nil)
+	if rosettaError == nil {
+		t.Error("expected error")
+	}
+}
+
+func TestGetHRC20TransferOperationComponents(t *testing.T) {
+	refContractAddr := crypto.PubkeyToAddress(internalCommon.MustGeneratePrivateKey().PublicKey)
+	refCurrency, err := common.NewHRC20Currency(refContractAddr, "TKN", 18)
+	if err != nil {
+		t.Fatal(err)
+	}
+	refFromKey := internalCommon.MustGeneratePrivateKey()
+	refFrom, rosettaError := newAccountIdentifier(crypto.PubkeyToAddress(refFromKey.PublicKey))
+	if rosettaError != nil {
+		t.Fatal(rosettaError)
+	}
+	refToAddr := crypto.PubkeyToAddress(internalCommon.MustGeneratePrivateKey().PublicKey)
+	refTo, rosettaError := newAccountIdentifier(refToAddr)
+	if rosettaError != nil {
+		t.Fatal(rosettaError)
+	}
+	newOperations := func(fromValue, toValue string, currency *types.Currency) []*types.Operation {
+		return []*types.Operation{
+			{
+				OperationIdentifier: &types.OperationIdentifier{Index: 0},
+				Type:                common.HRC20TransferOperation,
+				Amount:              &types.Amount{Value: fromValue, Currency: currency},
+				Account:             refFrom,
+			},
+			{
+				OperationIdentifier: &types.OperationIdentifier{Index: 1},
+				RelatedOperations:   []*types.OperationIdentifier{{Index: 0}},
+				Type:                common.HRC20TransferOperation,
+				Amount:              &types.Amount{Value: toValue, Currency: currency},
+				Account:             refTo,
+			},
+		}
+	}
+
+	// test valid operations
+	testComponents, rosettaError := GetOperationComponents(newOperations("-12000", "12000", refCurrency))
+	if rosettaError != nil {
+		t.Fatal(rosettaError)
+	}
+	if testComponents.Type != common.HRC20TransferOperation {
+		t.Error("expected same operation")
+	}
+	if testComponents.From == nil || types.Hash(testComponents.From) != types.Hash(refFrom) {
+		t.Error("expect same sender")
+	}
+	if testComponents.To == nil || types.Hash(testComponents.To) != types.Hash(refTo) {
+		t.Error("expect same receiver")
+	}
+	if testComponents.Amount.Cmp(big.NewInt(12000)) != 0 {
+		t.Error("expected amount to be absolute value of reference amount")
+	}
+	if testComponents.Currency == nil || types.Hash(testComponents.Currency) != types.Hash(refCurrency) {
+		t.Error("expected same currency")
+	}
+	contract, err := getAddress(testComponents.Contract)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if contract != refContractAddr {
+		t.Errorf("expected contract %v, got %v", refContractAddr.String(), contract.String())
+	}
+	to, amount, ok := unpackHRC20Transfer(testComponents.Data)
+	if !ok || to != refToAddr || amount.Cmp(big.NewInt(12000)) != 0 {
+		t.Error("expected data to be the transfer call of the receiver & amount")
+	}
+
+	// test mismatched amounts
+	if _, rosettaError = GetOperationComponents(newOperations("-12000", "11000", refCurrency)); rosettaError == nil {
+		t.Error("expected error")
+	}
+
+	// test native currency
+	if _, rosettaError = GetOperationComponents(
+		newOperations("-12000", "12000", &common.NativeCurrency),
+	); rosettaError == nil {
+		t.Error("expected error")
+	}
+
+	// test mixed operation types
+	operations := newOperations("-12000", "12000", refCurrency)
+	operations[1].Type = common.NativeTransferOperation
+	if _, rosettaError = GetOperationComponents(operations); rosettaError == nil {
+		t.Error("expected error")
+	}
+}
+
 func TestCreateValidatorOperationComponents(t *testing.T) {
 	refFromKey := internalCommon.MustGeneratePrivateKey()
 	refFrom, rosettaError := newAccountIdentifier(crypto.PubkeyToAddress(refFromKey.PublicKey))
@@ -0,0 +1,50 @@
+package rpc
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	"github.com/harmony-one/harmony/internal/utils"
+)
+
+// authTokenLength is the number of random bytes of a generated auth token
+const authTokenLength = 32
+
+// loadAuthToken returns the bearer token of the auth port kept in the file.
+// A new random token is written to the file if it does not exist yet, and
+// no file means no token.
+func loadAuthToken(file string) (string, error) {
+	if file == "" {
+		return "", nil
+	}
+	data, err := os.ReadFile(file)
+	if err == nil {
+		token := strings.TrimSpace(string(data))
+		if token == "" {
+			return "", errors.Errorf("empty auth token in %v", file)
+		}
+		return token, nil
+	}
+	if !errors.Is(err, os.ErrNotExist) {
+		return "", errors.Wrapf(err, "cannot read auth token file %v", file)
+	}
+
+	b := make([]byte, authTokenLength)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	token := hex.EncodeToString(b)
+	if err := os.MkdirAll(filepath.Dir(file), 0700); err != nil {
+		return "", err
+	}
+	if err := os.WriteFile(file, []byte(token), 0600); err != nil {
+		return "", errors.Wrapf(err, "cannot write auth token file %v", file)
+	}
+	utils.Logger().Info().Str("file", file).Msg("Generated new auth token")
+	return token, nil
+}
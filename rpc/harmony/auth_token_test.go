@@ -0,0 +1,34 @@
+package rpc
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestLoadAuthToken(t *testing.T) {
+	token, err := loadAuthToken("")
+	require.NoError(t, err)
+	require.Empty(t, token)
+
+	file := filepath.Join(t.TempDir(), "auth", "token")
+	token, err = loadAuthToken(file)
+	require.NoError(t, err)
+	require.Len(t, token, 2*authTokenLength)
+
+	// the generated token is kept across restarts
+	loaded, err := loadAuthToken(file)
+	require.NoError(t, err)
+	require.Equal(t, token, loaded)
+
+	require.NoError(t, os.WriteFile(file, []byte(" secret\n"), 0600))
+	loaded, err = loadAuthToken(file)
+	require.NoError(t, err)
+	require.Equal(t, "secret", loaded)
+
+	require.NoError(t, os.WriteFile(file, []byte("\n"), 0600))
+	_, err = loadAuthToken(file)
+	require.Error(t, err)
+}
@@ -49,6 +49,16 @@ type ConsensusInternal struct {
 	ConsensusTime int64  `json:"finality"`
 }
 
+// PrivateTxStatus captures the delivery status of a privately submitted transaction
+type PrivateTxStatus struct {
+	Hash        string   `json:"hash"`
+	Status      string   `json:"status"`
+	ExpiryBlock uint64   `json:"expiry-block"`
+	SubmittedAt int64    `json:"submitted-at"`
+	Leaders     []string `json:"leaders"`
+	Error       string   `json:"error,omitempty"`
+}
+
 // NodeMetadata captures select metadata of the RPC answering node
 type NodeMetadata struct {
 	BLSPublicKey    []string           `json:"blskey"`
@@ -77,11 +87,24 @@ type P struct {
 	Peers []peer.ID `json:"peers"`
 }
 
+// SyncPeerScore captures the reputation of a stream sync peer
+type SyncPeerScore struct {
+	ShardID          uint32  `json:"shard-id"`
+	PeerID           string  `json:"peerid"`
+	Score            float64 `json:"score"`
+	LatencyMs        int64   `json:"latency-ms"`
+	Throughput       float64 `json:"throughput"`
+	Responses        int     `json:"responses"`
+	Timeouts         int     `json:"timeouts"`
+	InvalidResponses int     `json:"invalid-responses"`
+}
+
 // NodePeerInfo captures the peer connectivity info of the node
 type NodePeerInfo struct {
-	PeerID       peer.ID   `json:"peerid"`
-	BlockedPeers []peer.ID `json:"blocked-peers"`
-	P            []P       `json:"connected-peers"`
+	PeerID       peer.ID         `json:"peerid"`
+	BlockedPeers []peer.ID       `json:"blocked-peers"`
+	P            []P             `json:"connected-peers"`
+	SyncPeers    []SyncPeerScore `json:"sync-peers,omitempty"`
 }
 
 type Config struct {
@@ -79,8 +79,10 @@ func (s *PublicContractService) wait(limiter *rate.Limiter, ctx context.Context)
 
 // Call executes the given transaction on the state for the given block number.
 // It doesn't make and changes in the state/blockchain and is useful to execute and retrieve values.
+// The optional state and block overrides are applied on a copy of the state before the execution.
 func (s *PublicContractService) Call(
 	ctx context.Context, args CallArgs, blockNrOrHash rpc.BlockNumberOrHash,
+	overrides *hmy.StateOverride, blockOverrides *hmy.BlockOverrides,
 ) (hexutil.Bytes, error) {
 	timer := DoMetricRPCRequest(Call)
 	defer DoRPCRequestDuration(Call, timer)
@@ -92,7 +94,7 @@ func (s *PublicContractService) Call(
 	}
 
 	// Execute call
-	result, err := DoEVMCall(ctx, s.hmy, args, blockNrOrHash, s.evmCallTimeout)
+	result, err := DoEVMCall(ctx, s.hmy, args, blockNrOrHash, overrides, blockOverrides, s.evmCallTimeout)
 	if err != nil {
 		return nil, err
 	}
@@ -158,7 +160,7 @@ func (s *PublicContractService) GetStorageAt(
 // DoEVMCall executes an EVM call
 func DoEVMCall(
 	ctx context.Context, hmy *hmy.Harmony, args CallArgs, blockNrOrHash rpc.BlockNumberOrHash,
-	timeout time.Duration,
+	overrides *hmy.StateOverride, blockOverrides *hmy.BlockOverrides, timeout time.Duration,
 ) (core.ExecutionResult, error) {
 	defer func(start time.Time) {
 		utils.Logger().Debug().
@@ -172,6 +174,13 @@ func DoEVMCall(
 		DoMetricRPCQueryInfo(DoEvmCall, FailedNumber)
 		return core.ExecutionResult{}, err
 	}
+	if overrides != nil {
+		state = state.Copy()
+		if err := overrides.Apply(state); err != nil {
+			DoMetricRPCQueryInfo(DoEvmCall, FailedNumber)
+			return core.ExecutionResult{}, err
+		}
+	}
 
 	// Create new call message
 	msg := args.ToMessage(hmy.RPCGasCap)
@@ -190,7 +199,7 @@ func DoEVMCall(
 	defer cancel()
 
 	// Get a new instance of the EVM.
-	evm, err := hmy.GetEVM(ctx, msg, state, header)
+	evm, err := hmy.GetEVM(ctx, msg, state, header, blockOverrides)
 	if err != nil {
 		DoMetricRPCQueryInfo(DoEvmCall, FailedNumber)
 		return core.ExecutionResult{}, err
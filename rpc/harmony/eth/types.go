@@ -53,21 +53,26 @@ type BlockWithFullTx struct {
 
 // Transaction represents a transaction that will serialize to the RPC representation of a transaction
 type Transaction struct {
-	BlockHash        *common.Hash    `json:"blockHash"`
-	BlockNumber      *hexutil.Big    `json:"blockNumber"`
-	From             common.Address  `json:"from"`
-	Timestamp        hexutil.Uint64  `json:"timestamp"` // Not exposed by Ethereum anymore
-	Gas              hexutil.Uint64  `json:"gas"`
-	GasPrice         *hexutil.Big    `json:"gasPrice"`
-	Hash             common.Hash     `json:"hash"`
-	Input            hexutil.Bytes   `json:"input"`
-	Nonce            hexutil.Uint64  `json:"nonce"`
-	To               *common.Address `json:"to"`
-	TransactionIndex *hexutil.Uint64 `json:"transactionIndex"`
-	Value            *hexutil.Big    `json:"value"`
-	V                *hexutil.Big    `json:"v"`
-	R                *hexutil.Big    `json:"r"`
-	S                *hexutil.Big    `json:"s"`
+	BlockHash        *common.Hash      `json:"blockHash"`
+	BlockNumber      *hexutil.Big      `json:"blockNumber"`
+	From             common.Address    `json:"from"`
+	Timestamp        hexutil.Uint64    `json:"timestamp"` // Not exposed by Ethereum anymore
+	Gas              hexutil.Uint64    `json:"gas"`
+	GasPrice         *hexutil.Big      `json:"gasPrice"`
+	GasFeeCap        *hexutil.Big      `json:"maxFeePerGas,omitempty"`
+	GasTipCap        *hexutil.Big      `json:"maxPriorityFeePerGas,omitempty"`
+	Hash             common.Hash       `json:"hash"`
+	Input            hexutil.Bytes     `json:"input"`
+	Nonce            hexutil.Uint64    `json:"nonce"`
+	To               *common.Address   `json:"to"`
+	TransactionIndex *hexutil.Uint64   `json:"transactionIndex"`
+	Value            *hexutil.Big      `json:"value"`
+	Type             hexutil.Uint64    `json:"type"`
+	ChainID          *hexutil.Big      `json:"chainId,omitempty"`
+	AccessList       *types.AccessList `json:"accessList,omitempty"`
+	V                *hexutil.Big      `json:"v"`
+	R                *hexutil.Big      `json:"r"`
+	S                *hexutil.Big      `json:"s"`
 }
 
 // NewTransaction returns a transaction that will serialize to the RPC
@@ -88,11 +93,21 @@ func NewTransaction(
 		Nonce:     hexutil.Uint64(tx.Nonce()),
 		To:        tx.To(),
 		Value:     (*hexutil.Big)(tx.Value()),
+		Type:      hexutil.Uint64(tx.Type()),
 		Timestamp: hexutil.Uint64(timestamp),
 		V:         (*hexutil.Big)(v),
 		R:         (*hexutil.Big)(r),
 		S:         (*hexutil.Big)(s),
 	}
+	if tx.Type() != types.LegacyTxType {
+		al := tx.AccessList()
+		result.AccessList = &al
+		result.ChainID = (*hexutil.Big)(tx.ChainID())
+	}
+	if tx.Type() == types.DynamicFeeTxType {
+		result.GasFeeCap = (*hexutil.Big)(tx.GasFeeCap())
+		result.GasTipCap = (*hexutil.Big)(tx.GasTipCap())
+	}
 	if blockHash != (common.Hash{}) {
 		result.BlockHash = &blockHash
 		result.BlockNumber = (*hexutil.Big)(new(big.Int).SetUint64(blockNumber))
@@ -119,11 +134,21 @@ func NewTransactionFromTransaction(
 		Nonce:     hexutil.Uint64(tx.Nonce()),
 		To:        tx.To(),
 		Value:     (*hexutil.Big)(tx.Value()),
+		Type:      hexutil.Uint64(tx.Type()),
 		Timestamp: hexutil.Uint64(timestamp),
 		V:         (*hexutil.Big)(v),
 		R:         (*hexutil.Big)(r),
 		S:         (*hexutil.Big)(s),
 	}
+	if tx.Type() != types.LegacyTxType {
+		al := tx.AccessList()
+		result.AccessList = &al
+		result.ChainID = (*hexutil.Big)(tx.ChainID())
+	}
+	if tx.Type() == types.DynamicFeeTxType {
+		result.GasFeeCap = (*hexutil.Big)(tx.GasFeeCap())
+		result.GasTipCap = (*hexutil.Big)(tx.GasTipCap())
+	}
 	if blockHash != (common.Hash{}) {
 		result.BlockHash = &blockHash
 		result.BlockNumber = (*hexutil.Big)(new(big.Int).SetUint64(blockNumber))
@@ -153,6 +178,7 @@ func NewReceipt(senderAddr common.Address, tx *types.EthTransaction, blockHash c
 		"logs":              receipt.Logs,
 		"logsBloom":         receipt.Bloom,
 		"effectiveGasPrice": hexutil.Big(*receipt.EffectiveGasPrice),
+		"type":              hexutil.Uint(tx.Type()),
 	}
 
 	// Assign receipt status or post state.
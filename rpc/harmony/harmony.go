@@ -82,6 +82,69 @@ func (s *PublicHarmonyService) GasPrice(ctx context.Context) (interface{}, error
 	}
 }
 
+// MaxPriorityFeePerGas returns a suggestion for the tip of dynamic fee transactions.
+// Note that the return type is an interface to account for the different versions
+func (s *PublicHarmonyService) MaxPriorityFeePerGas(ctx context.Context) (interface{}, error) {
+	tip, err := s.hmy.SuggestTipCap(ctx)
+	if err != nil {
+		return nil, err
+	}
+	// Format response according to version
+	switch s.version {
+	case V1, Eth:
+		return (*hexutil.Big)(tip), nil
+	case V2:
+		return tip, nil
+	default:
+		return nil, ErrUnknownRPCVersion
+	}
+}
+
+// FeeHistory returns the base fee, gas used ratio and the tips at the requested
+// percentiles for the range of blocks ending with lastBlock.
+// Note that the return type is an interface to account for the different versions
+func (s *PublicHarmonyService) FeeHistory(
+	ctx context.Context, blockCount BlockCount, lastBlock BlockNumber, rewardPercentiles []float64,
+) (interface{}, error) {
+	oldest, reward, baseFee, gasUsed, err := s.hmy.FeeHistory(ctx, int(blockCount), lastBlock.EthBlockNumber(), rewardPercentiles)
+	if err != nil {
+		return nil, err
+	}
+	// Format response according to version
+	switch s.version {
+	case V1, Eth:
+		result := &FeeHistoryResult{
+			OldestBlock:  (*hexutil.Big)(oldest),
+			GasUsedRatio: gasUsed,
+		}
+		if reward != nil {
+			result.Reward = make([][]*hexutil.Big, len(reward))
+			for i, w := range reward {
+				result.Reward[i] = make([]*hexutil.Big, len(w))
+				for j, v := range w {
+					result.Reward[i][j] = (*hexutil.Big)(v)
+				}
+			}
+		}
+		if baseFee != nil {
+			result.BaseFee = make([]*hexutil.Big, len(baseFee))
+			for i, v := range baseFee {
+				result.BaseFee[i] = (*hexutil.Big)(v)
+			}
+		}
+		return result, nil
+	case V2:
+		return &FeeHistoryResultV2{
+			OldestBlock:  oldest,
+			Reward:       reward,
+			BaseFee:      baseFee,
+			GasUsedRatio: gasUsed,
+		}, nil
+	default:
+		return nil, ErrUnknownRPCVersion
+	}
+}
+
 // GetNodeMetadata produces a NodeMetadata record, data is from the answering RPC node
 func (s *PublicHarmonyService) GetNodeMetadata(
 	ctx context.Context,
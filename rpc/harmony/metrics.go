@@ -45,6 +45,7 @@ const (
 	GetStorageAt = "GetStorageAt"
 	Call         = "Call"
 	DoEvmCall    = "DoEVMCall"
+	SimulateV1   = "SimulateV1"
 
 	// net
 	PeerCount  = "PeerCount"
@@ -59,6 +60,14 @@ const (
 	GetCurrentTransactionErrorSink = "GetCurrentTransactionErrorSink"
 	GetCurrentStakingErrorSink     = "GetCurrentStakingErrorSink"
 	GetPendingCXReceipts           = "GetPendingCXReceipts"
+	SendPrivateRawTransaction      = "SendPrivateRawTransaction"
+	GetPrivateTransactionStatus    = "GetPrivateTransactionStatus"
+
+	// txpool
+	TxPoolContent     = "TxPoolContent"
+	TxPoolContentFrom = "TxPoolContentFrom"
+	TxPoolInspect     = "TxPoolInspect"
+	TxPoolStatus      = "TxPoolStatus"
 
 	// staking
 	GetTotalStaking                         = "GetTotalStaking"
@@ -80,6 +89,8 @@ const (
 	GetDelegationsByValidator               = "GetDelegationsByValidator"
 	GetDelegationByDelegatorAndValidator    = "GetDelegationByDelegatorAndValidator"
 	GetAvailableRedelegationBalance         = "GetAvailableRedelegationBalance"
+	GetValidatorProof                       = "GetValidatorProof"
+	GetDelegationProof                      = "GetDelegationProof"
 
 	// tracer
 	TraceChain         = "TraceChain"
@@ -89,6 +100,11 @@ const (
 	TraceTransaction   = "TraceTransaction"
 	TraceCall          = "TraceCall"
 
+	// state access profiling
+	ProfileStateAccessCall        = "ProfileStateAccessCall"
+	ProfileStateAccessTransaction = "ProfileStateAccessTransaction"
+	ProfileStateAccessRange       = "ProfileStateAccessRange"
+
 	// tracer parity
 	Block       = "Block"
 	Transaction = "Transaction"
@@ -103,6 +119,9 @@ const (
 	GetStakingTransactionByHash                = "GetStakingTransactionByHash"
 	GetTransactionsHistory                     = "GetTransactionsHistory"
 	GetStakingTransactionsHistory              = "GetStakingTransactionsHistory"
+	GetTokenTransfersByAddress                 = "GetTokenTransfersByAddress"
+	GetTokenTransfersByContract                = "GetTokenTransfersByContract"
+	GetInternalTransactionsHistory             = "GetInternalTransactionsHistory"
 	GetBlockTransactionCountByNumber           = "GetBlockTransactionCountByNumber"
 	GetBlockTransactionCountByHash             = "GetBlockTransactionCountByHash"
 	GetTransactionByBlockNumberAndIndex        = "GetTransactionByBlockNumberAndIndex"
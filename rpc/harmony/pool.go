@@ -20,6 +20,7 @@ import (
 	common2 "github.com/harmony-one/harmony/internal/common"
 	nodeconfig "github.com/harmony-one/harmony/internal/configs/node"
 	"github.com/harmony-one/harmony/internal/utils"
+	commonRPC "github.com/harmony-one/harmony/rpc/harmony/common"
 	eth "github.com/harmony-one/harmony/rpc/harmony/eth"
 	v1 "github.com/harmony-one/harmony/rpc/harmony/v1"
 	v2 "github.com/harmony-one/harmony/rpc/harmony/v2"
@@ -74,32 +75,8 @@ func (s *PublicPoolService) SendRawTransaction(
 	timer := DoMetricRPCRequest(SendRawTransaction)
 	defer DoRPCRequestDuration(SendRawTransaction, timer)
 
-	// DOS prevention
-	if len(encodedTx) >= types.MaxEncodedPoolTransactionSize {
-		err := errors.Wrapf(core.ErrOversizedData, "encoded tx size: %d", len(encodedTx))
-		return common.Hash{}, err
-	}
-
-	var tx *types.Transaction
-	var txHash common.Hash
-
-	if s.version == Eth {
-		ethTx := new(types.EthTransaction)
-		if err := rlp.DecodeBytes(encodedTx, ethTx); err != nil {
-			return common.Hash{}, err
-		}
-		txHash = ethTx.Hash()
-		tx = ethTx.ConvertToHmy()
-	} else {
-		tx = new(types.Transaction)
-		if err := rlp.DecodeBytes(encodedTx, tx); err != nil {
-			return common.Hash{}, err
-		}
-		txHash = tx.Hash()
-	}
-
-	// Verify chainID
-	if err := s.verifyChainID(tx); err != nil {
+	tx, txHash, err := s.decodeRawTransaction(encodedTx)
+	if err != nil {
 		return common.Hash{}, err
 	}
 
@@ -112,7 +89,7 @@ func (s *PublicPoolService) SendRawTransaction(
 	// Log submission
 	if tx.To() == nil {
 		signer := types.MakeSigner(s.hmy.ChainConfig(), s.hmy.CurrentBlock().Epoch())
-		ethSigner := types.NewEIP155Signer(s.hmy.ChainConfig().EthCompatibleChainID)
+		ethSigner := types.NewLondonSigner(s.hmy.ChainConfig().EthCompatibleChainID)
 
 		if tx.IsEthCompatible() {
 			signer = ethSigner
@@ -140,6 +117,85 @@ func (s *PublicPoolService) SendRawTransaction(
 	return txHash, nil
 }
 
+// SendPrivateRawTransaction will send the signed transaction to the current and next leaders
+// of the shard only, without broadcasting it to the public pool. The leaders drop the transaction
+// after the expiry block (default lifetime if not positive, capped to the max lifetime). If it is
+// not included in time, the transaction is broadcast to the public pool.
+func (s *PublicPoolService) SendPrivateRawTransaction(
+	ctx context.Context, encodedTx hexutil.Bytes, expiryBlock BlockNumber,
+) (common.Hash, error) {
+	timer := DoMetricRPCRequest(SendPrivateRawTransaction)
+	defer DoRPCRequestDuration(SendPrivateRawTransaction, timer)
+
+	tx, txHash, err := s.decodeRawTransaction(encodedTx)
+	if err != nil {
+		DoMetricRPCQueryInfo(SendPrivateRawTransaction, FailedNumber)
+		return common.Hash{}, err
+	}
+	expiry := uint64(0)
+	if expiryBlock.Int64() > 0 {
+		expiry = uint64(expiryBlock.Int64())
+	}
+	if err := s.hmy.SendPrivateTx(ctx, tx, expiry); err != nil {
+		DoMetricRPCQueryInfo(SendPrivateRawTransaction, FailedNumber)
+		utils.Logger().Warn().Err(err).Msg("Could not submit private transaction")
+		return common.Hash{}, err
+	}
+	utils.Logger().Info().
+		Str("fullhash", tx.Hash().Hex()).
+		Str("hashByType", tx.HashByType().Hex()).
+		Msg("Submitted private transaction")
+
+	// Response output is the same for all versions
+	return txHash, nil
+}
+
+// GetPrivateTransactionStatus returns the delivery status of a private transaction
+// submitted through this node.
+func (s *PublicPoolService) GetPrivateTransactionStatus(
+	ctx context.Context, hash common.Hash,
+) (*commonRPC.PrivateTxStatus, error) {
+	timer := DoMetricRPCRequest(GetPrivateTransactionStatus)
+	defer DoRPCRequestDuration(GetPrivateTransactionStatus, timer)
+
+	// Response output is the same for all versions
+	return s.hmy.GetPrivateTxStatus(hash)
+}
+
+// decodeRawTransaction decodes the signed transaction according to the version,
+// returning the transaction & the hash reported to the caller.
+func (s *PublicPoolService) decodeRawTransaction(encodedTx hexutil.Bytes) (*types.Transaction, common.Hash, error) {
+	// DOS prevention
+	if len(encodedTx) >= types.MaxEncodedPoolTransactionSize {
+		err := errors.Wrapf(core.ErrOversizedData, "encoded tx size: %d", len(encodedTx))
+		return nil, common.Hash{}, err
+	}
+
+	var tx *types.Transaction
+	var txHash common.Hash
+
+	if s.version == Eth {
+		ethTx := new(types.EthTransaction)
+		if err := ethTx.UnmarshalBinary(encodedTx); err != nil {
+			return nil, common.Hash{}, err
+		}
+		txHash = ethTx.Hash()
+		tx = ethTx.ConvertToHmy()
+	} else {
+		tx = new(types.Transaction)
+		if err := tx.UnmarshalBinary(encodedTx); err != nil {
+			return nil, common.Hash{}, err
+		}
+		txHash = tx.Hash()
+	}
+
+	// Verify chainID
+	if err := s.verifyChainID(tx); err != nil {
+		return nil, common.Hash{}, err
+	}
+	return tx, txHash, nil
+}
+
 func (s *PublicPoolService) verifyChainID(tx *types.Transaction) error {
 	nodeChainID := s.hmy.ChainConfig().ChainID
 	ethChainID := nodeconfig.GetDefaultConfig().GetNetworkType().ChainConfig().EthCompatibleChainID
@@ -0,0 +1,127 @@
+package rpc
+
+import (
+	"context"
+	"time"
+
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/pkg/errors"
+
+	"github.com/harmony-one/harmony/eth/rpc"
+	"github.com/harmony-one/harmony/hmy"
+	"github.com/harmony-one/harmony/internal/utils"
+	rpc_common "github.com/harmony-one/harmony/rpc/harmony/common"
+)
+
+// PrivateAdminService Internal JSON RPC to control the node at runtime.
+// It is only served on the IPC socket and on the token authenticated HTTP auth port.
+type PrivateAdminService struct {
+	hmy *hmy.Harmony
+}
+
+// NewPrivateAdminAPI creates a new API for the RPC interface
+func NewPrivateAdminAPI(hmy *hmy.Harmony) rpc.API {
+	return rpc.API{
+		Namespace: adminNamespace,
+		Version:   APIVersion,
+		Service:   &PrivateAdminService{hmy},
+		Public:    false,
+	}
+}
+
+// NodeInfo is the runtime status of the node
+type NodeInfo struct {
+	Metadata rpc_common.NodeMetadata `json:"metadata"`
+	Peers    rpc_common.NodePeerInfo `json:"peers"`
+	Services map[string]bool         `json:"services"`
+}
+
+// AddPeer connects to the peer of the given multiaddress,
+// ex: /ip4/127.0.0.1/tcp/9000/p2p/QmSomePeerID
+func (s *PrivateAdminService) AddPeer(ctx context.Context, addr string) (bool, error) {
+	if err := s.hmy.NodeAPI.AddPeerByAddress(addr); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// RemovePeer disconnects the peer and forgets its addresses
+func (s *PrivateAdminService) RemovePeer(ctx context.Context, peerID string) (bool, error) {
+	id, err := peer.Decode(peerID)
+	if err != nil {
+		return false, err
+	}
+	if err := s.hmy.NodeAPI.RemovePeer(id); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// BlockPeer disconnects the peer and refuses its connections for the given duration, ex: 1h
+func (s *PrivateAdminService) BlockPeer(ctx context.Context, peerID string, duration string) (bool, error) {
+	id, err := peer.Decode(peerID)
+	if err != nil {
+		return false, err
+	}
+	d, err := time.ParseDuration(duration)
+	if err != nil {
+		return false, err
+	}
+	if d <= 0 {
+		return false, errors.Errorf("invalid block duration %v", duration)
+	}
+	if err := s.hmy.NodeAPI.BlockPeer(id, d); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// ReloadTxPoolFilters reads the tx pool blacklist and allowed transactions files again,
+// returns the number of evicted transactions of newly blacklisted senders.
+func (s *PrivateAdminService) ReloadTxPoolFilters(ctx context.Context) (int, error) {
+	return s.hmy.NodeAPI.ReloadTxPoolFilters()
+}
+
+// StartService starts the registered node service of the given name. Only the
+// restartable services can be started, ex: pprof
+func (s *PrivateAdminService) StartService(ctx context.Context, name string) (bool, error) {
+	if err := s.hmy.NodeAPI.StartService(name); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// StopService stops the registered node service of the given name. Only the
+// restartable services can be stopped, ex: pprof
+func (s *PrivateAdminService) StopService(ctx context.Context, name string) (bool, error) {
+	if err := s.hmy.NodeAPI.StopService(name); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// NodeInfo returns the metadata, the peers and the services status of the node
+func (s *PrivateAdminService) NodeInfo(ctx context.Context) (*NodeInfo, error) {
+	return &NodeInfo{
+		Metadata: s.hmy.GetNodeMetadata(),
+		Peers:    s.hmy.GetPeerInfo(),
+		Services: s.hmy.NodeAPI.ServicesStatus(),
+	}, nil
+}
+
+// SetNodeToBackupMode sets the node to backup mode, returns false if the mode is unchanged
+func (s *PrivateAdminService) SetNodeToBackupMode(ctx context.Context, isBackup bool) (bool, error) {
+	return s.hmy.NodeAPI.SetNodeBackupMode(isBackup), nil
+}
+
+// SetLogVerbosity sets log verbosity on runtime
+func (s *PrivateAdminService) SetLogVerbosity(ctx context.Context, level int) (map[string]interface{}, error) {
+	if level < int(log.LvlCrit) || level > int(log.LvlTrace) {
+		return nil, ErrInvalidLogLevel
+	}
+
+	verbosity := log.Lvl(level)
+	utils.SetLogVerbosity(verbosity)
+	return map[string]interface{}{"verbosity": verbosity.String()}, nil
+}
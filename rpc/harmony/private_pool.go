@@ -0,0 +1,77 @@
+package rpc
+
+import (
+	"context"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/harmony-one/harmony/core/types"
+	"github.com/harmony-one/harmony/eth/rpc"
+	"github.com/harmony-one/harmony/hmy"
+	hmyCommon "github.com/harmony-one/harmony/internal/common"
+)
+
+// PrivatePoolService Internal JSON RPC to manage the transaction pool.
+// It is served in the admin namespace, only on the IPC socket and on the token
+// authenticated HTTP auth port.
+type PrivatePoolService struct {
+	hmy *hmy.Harmony
+}
+
+// NewPrivatePoolAPI creates a new API for the RPC interface
+func NewPrivatePoolAPI(hmy *hmy.Harmony) rpc.API {
+	return rpc.API{
+		Namespace: adminNamespace,
+		Version:   APIVersion,
+		Service:   &PrivatePoolService{hmy},
+		Public:    false,
+	}
+}
+
+// EvictTransaction removes the transaction from the pool, returns the evicted hashes.
+// The reason is reported in the transaction error sink.
+func (s *PrivatePoolService) EvictTransaction(
+	ctx context.Context, hash common.Hash, reason string,
+) []common.Hash {
+	return evictedHashes(s.hmy.EvictPoolTransactions([]common.Hash{hash}, reason, false))
+}
+
+// EvictTransactionsFrom removes all transactions of the sender from the pool,
+// returns the evicted hashes.
+func (s *PrivatePoolService) EvictTransactionsFrom(
+	ctx context.Context, address string, reason string,
+) ([]common.Hash, error) {
+	addr, err := hmyCommon.ParseAddr(address)
+	if err != nil {
+		return nil, err
+	}
+	return evictedHashes(s.hmy.EvictPoolTransactionsFrom(addr, reason, false)), nil
+}
+
+// CancelTransaction removes the transaction from the pool and refuses it
+// if it is submitted again, returns the cancelled hashes.
+func (s *PrivatePoolService) CancelTransaction(
+	ctx context.Context, hash common.Hash, reason string,
+) []common.Hash {
+	return evictedHashes(s.hmy.EvictPoolTransactions([]common.Hash{hash}, reason, true))
+}
+
+// CancelTransactionsFrom removes all transactions of the sender from the pool and
+// refuses them if they are submitted again, returns the cancelled hashes.
+func (s *PrivatePoolService) CancelTransactionsFrom(
+	ctx context.Context, address string, reason string,
+) ([]common.Hash, error) {
+	addr, err := hmyCommon.ParseAddr(address)
+	if err != nil {
+		return nil, err
+	}
+	return evictedHashes(s.hmy.EvictPoolTransactionsFrom(addr, reason, true)), nil
+}
+
+func evictedHashes(txs types.PoolTransactions) []common.Hash {
+	hashes := make([]common.Hash, 0, txs.Len())
+	for _, tx := range txs {
+		hashes = append(hashes, tx.Hash())
+	}
+	return hashes
+}
@@ -38,22 +38,30 @@ const (
 	netV1Namespace = "netv1"
 	netV2Namespace = "netv2"
 	web3Namespace  = "web3"
+
+	txPoolNamespace = "txpool"
+	adminNamespace  = "admin"
 )
 
 var (
 	// HTTPModules ..
-	HTTPModules = []string{"hmy", "hmyv2", "eth", "debug", "trace", netNamespace, netV1Namespace, netV2Namespace, web3Namespace, "explorer", "preimages"}
+	HTTPModules = []string{"hmy", "hmyv2", "eth", "debug", "trace", netNamespace, netV1Namespace, netV2Namespace, web3Namespace, "explorer", "preimages", txPoolNamespace, adminNamespace}
 	// WSModules ..
-	WSModules = []string{"hmy", "hmyv2", "eth", "debug", "trace", netNamespace, netV1Namespace, netV2Namespace, web3Namespace, "web3"}
+	WSModules = []string{"hmy", "hmyv2", "eth", "debug", "trace", netNamespace, netV1Namespace, netV2Namespace, web3Namespace, "web3", txPoolNamespace}
 
 	httpListener     net.Listener
 	httpHandler      *rpc.Server
+	httpAuthListener net.Listener
+	httpAuthHandler  *rpc.Server
 	wsListener       net.Listener
 	wsHandler        *rpc.Server
+	ipcListener      net.Listener
+	ipcHandler       *rpc.Server
 	httpEndpoint     = ""
 	httpAuthEndpoint = ""
 	wsEndpoint       = ""
 	wsAuthEndpoint   = ""
+	ipcEndpoint      = ""
 	httpVirtualHosts = []string{"*"}
 	httpOrigins      = []string{"*"}
 	wsOrigins        = []string{"*"}
@@ -74,6 +82,8 @@ func StartServers(hmy *hmy.Harmony, apis []rpc.API, config nodeconfig.RPCServerC
 	if rpcOpt.PreimagesEnabled {
 		authApis = append(authApis, NewPreimagesAPI(hmy, "preimages"))
 	}
+	// the admin apis are only served on the ipc socket and the token authenticated auth port
+	adminApis := append(append([]rpc.API{}, authApis...), NewPrivateAdminAPI(hmy), NewPrivatePoolAPI(hmy))
 	// load method filter from file (if exist)
 	var rmf rpc.RpcMethodFilter
 	rpcFilterFilePath := strings.TrimSpace(rpcOpt.RpcFilterFile)
@@ -96,7 +106,16 @@ func StartServers(hmy *hmy.Harmony, apis []rpc.API, config nodeconfig.RPCServerC
 		}
 
 		httpAuthEndpoint = fmt.Sprintf("%v:%v", config.HTTPIp, config.HTTPAuthPort)
-		if err := startAuthHTTP(authApis, &rmf, timeouts); err != nil {
+		authToken, err := loadAuthToken(config.HTTPAuthTokenFile)
+		if err != nil {
+			return err
+		}
+		if authToken != "" {
+			err = startAuthHTTP(adminApis, &rmf, timeouts, authToken)
+		} else {
+			err = startAuthHTTP(authApis, &rmf, timeouts, authToken)
+		}
+		if err != nil {
 			return err
 		}
 	}
@@ -113,6 +132,13 @@ func StartServers(hmy *hmy.Harmony, apis []rpc.API, config nodeconfig.RPCServerC
 		}
 	}
 
+	if config.IPCPath != "" {
+		ipcEndpoint = config.IPCPath
+		if err := startIPC(adminApis, &rmf); err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
@@ -131,6 +157,19 @@ func StopServers() error {
 		httpHandler.Stop()
 		httpHandler = nil
 	}
+	if httpAuthListener != nil {
+		if err := httpAuthListener.Close(); err != nil {
+			return err
+		}
+		httpAuthListener = nil
+		utils.Logger().Info().
+			Str("url", fmt.Sprintf("http://%s", httpAuthEndpoint)).
+			Msg("HTTP auth endpoint closed")
+	}
+	if httpAuthHandler != nil {
+		httpAuthHandler.Stop()
+		httpAuthHandler = nil
+	}
 	if wsListener != nil {
 		if err := wsListener.Close(); err != nil {
 			return err
@@ -144,6 +183,19 @@ func StopServers() error {
 		wsHandler.Stop()
 		wsHandler = nil
 	}
+	if ipcListener != nil {
+		if err := ipcListener.Close(); err != nil {
+			return err
+		}
+		ipcListener = nil
+		utils.Logger().Info().
+			Str("path", ipcEndpoint).
+			Msg("IPC endpoint closed")
+	}
+	if ipcHandler != nil {
+		ipcHandler.Stop()
+		ipcHandler = nil
+	}
 	return nil
 }
 
@@ -168,6 +220,7 @@ func getAPIs(hmy *hmy.Harmony, config nodeconfig.RPCServerConfig) []rpc.API {
 		NewPublicTransactionAPI(hmy, V2),
 		NewPublicPoolAPI(hmy, V1, config.RateLimiterEnabled, config.RequestsPerSecond),
 		NewPublicPoolAPI(hmy, V2, config.RateLimiterEnabled, config.RequestsPerSecond),
+		NewPublicTxPoolAPI(hmy),
 	}
 
 	// Legacy methods (subject to removal)
@@ -231,9 +284,9 @@ func startHTTP(apis []rpc.API, rmf *rpc.RpcMethodFilter, httpTimeouts rpc.HTTPTi
 	return nil
 }
 
-func startAuthHTTP(apis []rpc.API, rmf *rpc.RpcMethodFilter, httpTimeouts rpc.HTTPTimeouts) (err error) {
-	httpListener, httpHandler, err = rpc.StartHTTPEndpoint(
-		httpAuthEndpoint, apis, HTTPModules, rmf, httpOrigins, httpVirtualHosts, httpTimeouts,
+func startAuthHTTP(apis []rpc.API, rmf *rpc.RpcMethodFilter, httpTimeouts rpc.HTTPTimeouts, token string) (err error) {
+	httpAuthListener, httpAuthHandler, err = rpc.StartAuthHTTPEndpoint(
+		httpAuthEndpoint, apis, HTTPModules, rmf, httpOrigins, httpVirtualHosts, httpTimeouts, token,
 	)
 	if err != nil {
 		return err
@@ -243,6 +296,7 @@ func startAuthHTTP(apis []rpc.API, rmf *rpc.RpcMethodFilter, httpTimeouts rpc.HT
 		Str("url", fmt.Sprintf("http://%s", httpAuthEndpoint)).
 		Str("cors", strings.Join(httpOrigins, ",")).
 		Str("vhosts", strings.Join(httpVirtualHosts, ",")).
+		Bool("token", token != "").
 		Msg("HTTP endpoint opened")
 	fmt.Printf("Started Auth-RPC server at: %v\n", httpAuthEndpoint)
 	return nil
@@ -273,3 +327,16 @@ func startAuthWS(apis []rpc.API, rmf *rpc.RpcMethodFilter) (err error) {
 	fmt.Printf("Started Auth-WS server at: %v\n", wsAuthEndpoint)
 	return nil
 }
+
+func startIPC(apis []rpc.API, rmf *rpc.RpcMethodFilter) (err error) {
+	ipcListener, ipcHandler, err = rpc.StartIPCEndpoint(ipcEndpoint, apis, rmf)
+	if err != nil {
+		return err
+	}
+
+	utils.Logger().Info().
+		Str("path", ipcEndpoint).
+		Msg("IPC endpoint opened")
+	fmt.Printf("Started IPC server at: %v\n", ipcEndpoint)
+	return nil
+}
@@ -0,0 +1,326 @@
+package rpc
+
+import (
+	"context"
+	"encoding/binary"
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/pkg/errors"
+
+	"github.com/harmony-one/harmony/block"
+	"github.com/harmony-one/harmony/core"
+	"github.com/harmony-one/harmony/core/state"
+	"github.com/harmony-one/harmony/core/types"
+	"github.com/harmony-one/harmony/core/vm"
+	"github.com/harmony-one/harmony/eth/rpc"
+	"github.com/harmony-one/harmony/hmy"
+	"github.com/harmony-one/harmony/internal/utils"
+)
+
+const (
+	// maxSimulateBlocks is the maximum number of blocks of a single simulation
+	maxSimulateBlocks = 256
+	// simulateBlockTime is the default interval in seconds between two simulated blocks
+	simulateBlockTime = 2
+	// errCodeVMError is the error code of a simulated call failed in the EVM
+	errCodeVMError = -32015
+)
+
+// SimulateOpts is the input of SimulateV1, the blocks to simulate on top of
+// the base block.
+type SimulateOpts struct {
+	BlockStateCalls []SimBlock `json:"blockStateCalls"`
+}
+
+// SimBlock is a simulated block. The state overrides are applied before the
+// calls of the block, and the block overrides default to the block following
+// the previous one.
+type SimBlock struct {
+	BlockOverrides *hmy.BlockOverrides `json:"blockOverrides"`
+	StateOverrides *hmy.StateOverride  `json:"stateOverrides"`
+	Calls          []SimCallArgs       `json:"calls"`
+}
+
+// SimCallArgs is a simulated call. A call with a toShardID other than the
+// current shard is a cross-shard transfer, which only subtracts the value on
+// this shard and produces a cross-shard receipt.
+type SimCallArgs struct {
+	CallArgs
+	ToShardID *hexutil.Uint64 `json:"toShardID"`
+}
+
+// SimBlockResult is the result of a simulated block
+type SimBlockResult struct {
+	Number    hexutil.Uint64  `json:"number"`
+	Timestamp hexutil.Uint64  `json:"timestamp"`
+	GasLimit  hexutil.Uint64  `json:"gasLimit"`
+	GasUsed   hexutil.Uint64  `json:"gasUsed"`
+	Miner     common.Address  `json:"miner"`
+	Calls     []SimCallResult `json:"calls"`
+}
+
+// SimCallResult is the result of a simulated call
+type SimCallResult struct {
+	ReturnData hexutil.Bytes  `json:"returnData"`
+	Logs       []*types.Log   `json:"logs"`
+	GasUsed    hexutil.Uint64 `json:"gasUsed"`
+	Status     hexutil.Uint64 `json:"status"`
+	Error      *SimCallError  `json:"error,omitempty"`
+	CXReceipt  *SimCXReceipt  `json:"cxReceipt,omitempty"`
+}
+
+// SimCallError is the error of a failed simulated call
+type SimCallError struct {
+	Code    int    `json:"code"`
+	Message string `json:"message"`
+	Data    string `json:"data,omitempty"`
+}
+
+// SimCXReceipt is the cross-shard receipt produced by a simulated call
+type SimCXReceipt struct {
+	From      common.Address  `json:"from"`
+	To        *common.Address `json:"to"`
+	ShardID   uint32          `json:"shardID"`
+	ToShardID uint32          `json:"toShardID"`
+	Amount    *hexutil.Big    `json:"value"`
+}
+
+// SimulateV1 executes the calls of a series of simulated blocks on top of the
+// given block. Each call sees the state changes of the earlier calls, and
+// nothing is written to the chain.
+func (s *PublicContractService) SimulateV1(
+	ctx context.Context, opts SimulateOpts, blockNrOrHash *rpc.BlockNumberOrHash,
+) ([]SimBlockResult, error) {
+	timer := DoMetricRPCRequest(SimulateV1)
+	defer DoRPCRequestDuration(SimulateV1, timer)
+
+	err := s.wait(s.limiterCall, ctx)
+	if err != nil {
+		DoMetricRPCQueryInfo(SimulateV1, RateLimitedNumber)
+		return nil, err
+	}
+
+	bNrOrHash := rpc.BlockNumberOrHashWithNumber(rpc.LatestBlockNumber)
+	if blockNrOrHash != nil {
+		bNrOrHash = *blockNrOrHash
+	}
+	results, err := DoSimulate(ctx, s.hmy, opts, bNrOrHash, s.evmCallTimeout)
+	if err != nil {
+		DoMetricRPCQueryInfo(SimulateV1, FailedNumber)
+		return nil, err
+	}
+	return results, nil
+}
+
+// DoSimulate executes the simulated blocks on a copy of the state of the given block
+func DoSimulate(
+	ctx context.Context, hmy *hmy.Harmony, opts SimulateOpts, blockNrOrHash rpc.BlockNumberOrHash,
+	timeout time.Duration,
+) ([]SimBlockResult, error) {
+	defer func(start time.Time) {
+		utils.Logger().Debug().
+			Dur("runtime", time.Since(start)).
+			Msg("Executing simulation finished")
+	}(time.Now())
+
+	if len(opts.BlockStateCalls) == 0 {
+		return nil, errors.New("no block to simulate")
+	}
+	blk, err := hmy.BlockByNumberOrHash(ctx, blockNrOrHash)
+	if err != nil {
+		return nil, err
+	}
+	if blk == nil {
+		return nil, errors.New("block not found")
+	}
+	blockOverrides, err := sanitizeSimBlocks(blk.Header(), opts.BlockStateCalls)
+	if err != nil {
+		return nil, err
+	}
+	statedb, err := hmy.ComputeStateDB(blk, defaultTraceReexec)
+	if err != nil {
+		return nil, err
+	}
+	statedb = statedb.Copy()
+
+	// Setup context so it may be cancelled the simulation has completed
+	// or, in case of unmetered gas, setup a context with a timeout.
+	var cancel context.CancelFunc
+	if timeout > 0 {
+		ctx, cancel = context.WithTimeout(ctx, timeout)
+	} else {
+		ctx, cancel = context.WithCancel(ctx)
+	}
+	defer cancel()
+
+	results := make([]SimBlockResult, 0, len(opts.BlockStateCalls))
+	for i, simBlock := range opts.BlockStateCalls {
+		if err := simBlock.StateOverrides.Apply(statedb); err != nil {
+			return nil, errors.Wrapf(err, "block %d", i)
+		}
+		result, err := simulateBlock(ctx, hmy, statedb, blk.Header(), &blockOverrides[i], simBlock.Calls)
+		if err != nil {
+			return nil, errors.Wrapf(err, "block %d", i)
+		}
+		results = append(results, *result)
+	}
+	return results, nil
+}
+
+// simulateBlock executes the calls of a simulated block one after another
+func simulateBlock(
+	ctx context.Context, hmy *hmy.Harmony, statedb *state.DB, base *block.Header,
+	overrides *hmy.BlockOverrides, calls []SimCallArgs,
+) (*SimBlockResult, error) {
+	var (
+		config   = hmy.BlockChain.Config()
+		vmConfig = *hmy.BlockChain.GetVMConfig()
+		number   = overrides.Number.ToInt().Uint64()
+		gp       = new(core.GasPool).AddGas(uint64(*overrides.GasLimit))
+		result   = &SimBlockResult{
+			Number:    hexutil.Uint64(number),
+			Timestamp: *overrides.Time,
+			GasLimit:  *overrides.GasLimit,
+			Calls:     make([]SimCallResult, 0, len(calls)),
+		}
+	)
+	if overrides.Coinbase != nil {
+		result.Miner = *overrides.Coinbase
+	}
+	for i, call := range calls {
+		// Calls without gas share the remaining gas of the block
+		if call.Gas == nil {
+			remaining := hexutil.Uint64(gp.Gas())
+			call.Gas = &remaining
+		}
+		msg := call.ToMessage(hmy.RPCGasCap)
+		vmCtx := core.NewEVMContext(msg, base, hmy.BlockChain, nil)
+		// Calls without a gas price are not subject to the base fee.
+		if msg.GasPrice().Sign() == 0 {
+			vmCtx.BaseFee = nil
+		}
+		overrides.Apply(&vmCtx)
+
+		crossShard := call.ToShardID != nil && uint32(*call.ToShardID) != vmCtx.ShardID
+		if crossShard {
+			switch {
+			case msg.To() == nil:
+				return nil, fmt.Errorf("call %d: cross-shard transfer without recipient", i)
+			case !config.AcceptsCrossTx(vmCtx.EpochNumber):
+				return nil, fmt.Errorf("call %d: cannot handle cross-shard transaction until after epoch %v", i, config.CrossTxEpoch)
+			case uint32(*call.ToShardID) >= vmCtx.NumShards:
+				return nil, fmt.Errorf("call %d: toShardID %d out of bounds", i, *call.ToShardID)
+			}
+			vmCtx.TxType = types.SubtractionOnly
+		}
+
+		txHash := simTxHash(number, i)
+		statedb.Prepare(txHash, common.Hash{}, i)
+		evm := vm.NewEVM(vmCtx, statedb, config, vmConfig)
+		go func() {
+			<-ctx.Done()
+			evm.Cancel()
+		}()
+		res, err := core.ApplyMessage(evm, msg, gp)
+		if err != nil {
+			return nil, errors.Wrapf(err, "call %d", i)
+		}
+		if evm.Cancelled() {
+			return nil, fmt.Errorf("execution aborted (timeout = %v)", ctx.Err())
+		}
+		statedb.Finalise(true)
+
+		callResult := SimCallResult{
+			ReturnData: res.ReturnData,
+			Logs:       statedb.GetLogs(txHash, number, common.Hash{}),
+			GasUsed:    hexutil.Uint64(res.UsedGas),
+			Status:     hexutil.Uint64(types.ReceiptStatusSuccessful),
+		}
+		if callResult.Logs == nil {
+			callResult.Logs = []*types.Log{}
+		}
+		switch {
+		case res.Failed():
+			callResult.Status = hexutil.Uint64(types.ReceiptStatusFailed)
+			callResult.Error = &SimCallError{Code: errCodeVMError, Message: res.VMErr.Error()}
+			if len(res.Revert()) > 0 {
+				revertErr := newRevertError(&res)
+				callResult.Error = &SimCallError{
+					Code:    revertErr.ErrorCode(),
+					Message: revertErr.Error(),
+					Data:    revertErr.reason,
+				}
+			}
+		case crossShard:
+			callResult.CXReceipt = &SimCXReceipt{
+				From:      msg.From(),
+				To:        msg.To(),
+				ShardID:   vmCtx.ShardID,
+				ToShardID: uint32(*call.ToShardID),
+				Amount:    (*hexutil.Big)(msg.Value()),
+			}
+		case evm.CXReceipt != nil:
+			callResult.CXReceipt = &SimCXReceipt{
+				From:      evm.CXReceipt.From,
+				To:        evm.CXReceipt.To,
+				ShardID:   evm.CXReceipt.ShardID,
+				ToShardID: evm.CXReceipt.ToShardID,
+				Amount:    (*hexutil.Big)(evm.CXReceipt.Amount),
+			}
+		}
+		result.GasUsed += callResult.GasUsed
+		result.Calls = append(result.Calls, callResult)
+	}
+	return result, nil
+}
+
+// sanitizeSimBlocks returns the block overrides of each simulated block, where
+// the unset number, time and gas limit follow the previous block.
+func sanitizeSimBlocks(base *block.Header, blocks []SimBlock) ([]hmy.BlockOverrides, error) {
+	if len(blocks) > maxSimulateBlocks {
+		return nil, fmt.Errorf("too many blocks to simulate: %d > %d", len(blocks), maxSimulateBlocks)
+	}
+	var (
+		number    = base.Number().Uint64()
+		timestamp = base.Time().Uint64()
+		overrides = make([]hmy.BlockOverrides, 0, len(blocks))
+	)
+	for i, b := range blocks {
+		var o hmy.BlockOverrides
+		if b.BlockOverrides != nil {
+			o = *b.BlockOverrides
+		}
+		if o.Number == nil {
+			o.Number = (*hexutil.Big)(new(big.Int).SetUint64(number + 1))
+		} else if n := o.Number.ToInt(); !n.IsUint64() || n.Uint64() <= number {
+			return nil, fmt.Errorf("block %d: number %v is not above %d", i, n, number)
+		}
+		next := o.Number.ToInt().Uint64()
+		if o.Time == nil {
+			t := hexutil.Uint64(timestamp + (next-number)*simulateBlockTime)
+			o.Time = &t
+		} else if uint64(*o.Time) <= timestamp {
+			return nil, fmt.Errorf("block %d: time %d is not above %d", i, uint64(*o.Time), timestamp)
+		}
+		if o.GasLimit == nil {
+			gasLimit := hexutil.Uint64(base.GasLimit())
+			o.GasLimit = &gasLimit
+		}
+		number, timestamp = next, uint64(*o.Time)
+		overrides = append(overrides, o)
+	}
+	return overrides, nil
+}
+
+// simTxHash returns the hash identifying the logs of a simulated call
+func simTxHash(number uint64, index int) common.Hash {
+	var b [16]byte
+	binary.BigEndian.PutUint64(b[:8], number)
+	binary.BigEndian.PutUint64(b[8:], uint64(index))
+	return crypto.Keccak256Hash(b[:])
+}
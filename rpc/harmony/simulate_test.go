@@ -0,0 +1,120 @@
+package rpc
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/stretchr/testify/require"
+
+	blockfactory "github.com/harmony-one/harmony/block/factory"
+	"github.com/harmony-one/harmony/core"
+	"github.com/harmony-one/harmony/core/types"
+	"github.com/harmony-one/harmony/eth/rpc"
+	"github.com/harmony-one/harmony/hmy"
+	"github.com/harmony-one/harmony/internal/params"
+)
+
+func TestSanitizeSimBlocks(t *testing.T) {
+	base := blockfactory.NewTestHeader().With().
+		Number(big.NewInt(100)).
+		Time(big.NewInt(1000)).
+		GasLimit(30000000).
+		Header()
+
+	number := hexutil.Big(*big.NewInt(105))
+	gasLimit := hexutil.Uint64(1000000)
+	overrides, err := sanitizeSimBlocks(base, []SimBlock{
+		{},
+		{BlockOverrides: &hmy.BlockOverrides{Number: &number, GasLimit: &gasLimit}},
+		{},
+	})
+	require.NoError(t, err)
+	require.Len(t, overrides, 3)
+
+	exp := []struct {
+		number, time, gasLimit uint64
+	}{
+		{101, 1002, 30000000},
+		{105, 1010, 1000000},
+		{106, 1012, 30000000},
+	}
+	for i, e := range exp {
+		require.Equal(t, e.number, overrides[i].Number.ToInt().Uint64())
+		require.Equal(t, e.time, uint64(*overrides[i].Time))
+		require.Equal(t, e.gasLimit, uint64(*overrides[i].GasLimit))
+	}
+
+	number = hexutil.Big(*big.NewInt(100))
+	_, err = sanitizeSimBlocks(base, []SimBlock{{BlockOverrides: &hmy.BlockOverrides{Number: &number}}})
+	require.Error(t, err)
+
+	tm := hexutil.Uint64(1000)
+	_, err = sanitizeSimBlocks(base, []SimBlock{{BlockOverrides: &hmy.BlockOverrides{Time: &tm}}})
+	require.Error(t, err)
+
+	_, err = sanitizeSimBlocks(base, make([]SimBlock, maxSimulateBlocks+1))
+	require.Error(t, err)
+}
+
+func TestDoSimulate(t *testing.T) {
+	var (
+		from    = common.HexToAddress("0x00000000000000000000000000000000000000aa")
+		to      = common.HexToAddress("0x00000000000000000000000000000000000000bb")
+		checker = common.HexToAddress("0x00000000000000000000000000000000000000cc")
+	)
+	h := newTestHarmony(t, params.TestChainConfig, core.GenesisAlloc{
+		from: {Balance: big.NewInt(1e18)},
+	})
+
+	// the checker contract returns the balance of the recipient:
+	// PUSH20 to BALANCE PUSH1 0 MSTORE PUSH1 32 PUSH1 0 RETURN
+	code := hexutil.Bytes(append(append([]byte{0x73}, to.Bytes()...), 0x31, 0x60, 0x00, 0x52, 0x60, 0x20, 0x60, 0x00, 0xf3))
+	value := func(v int64) *hexutil.Big { return (*hexutil.Big)(big.NewInt(v)) }
+	toShardID := hexutil.Uint64(1)
+
+	results, err := DoSimulate(context.Background(), h, SimulateOpts{
+		BlockStateCalls: []SimBlock{
+			{
+				Calls: []SimCallArgs{
+					{CallArgs: CallArgs{From: &from, To: &to, Value: value(1000)}},
+				},
+			},
+			{
+				StateOverrides: &hmy.StateOverride{checker: {Code: &code}},
+				Calls: []SimCallArgs{
+					{CallArgs: CallArgs{From: &from, To: &checker}},
+					{CallArgs: CallArgs{From: &to, To: &from, Value: value(5)}, ToShardID: &toShardID},
+					{CallArgs: CallArgs{From: &from, To: &checker}},
+				},
+			},
+		},
+	}, rpc.BlockNumberOrHashWithNumber(rpc.LatestBlockNumber), 0)
+	require.NoError(t, err)
+	require.Len(t, results, 2)
+	require.Equal(t, hexutil.Uint64(1), results[0].Number)
+	require.Equal(t, hexutil.Uint64(2), results[1].Number)
+	for _, result := range results {
+		for _, call := range result.Calls {
+			require.Nil(t, call.Error)
+			require.Equal(t, hexutil.Uint64(types.ReceiptStatusSuccessful), call.Status)
+		}
+	}
+
+	// the second block sees the transfer of the first one
+	calls := results[1].Calls
+	require.Len(t, calls, 3)
+	require.Equal(t, common.BigToHash(big.NewInt(1000)).Bytes(), []byte(calls[0].ReturnData))
+
+	// the cross-shard transfer only subtracts the value on this shard
+	require.Equal(t, &SimCXReceipt{
+		From:      to,
+		To:        &from,
+		ShardID:   0,
+		ToShardID: 1,
+		Amount:    value(5),
+	}, calls[1].CXReceipt)
+	require.Equal(t, common.BigToHash(big.NewInt(995)).Bytes(), []byte(calls[2].ReturnData))
+}
@@ -17,6 +17,7 @@ import (
 	internal_common "github.com/harmony-one/harmony/internal/common"
 	"github.com/harmony-one/harmony/shard"
 	staking "github.com/harmony-one/harmony/staking/types"
+	"github.com/harmony-one/harmony/staking/verify"
 	"github.com/pkg/errors"
 )
 
@@ -848,6 +849,66 @@ func (s *PublicStakingService) GetDelegationByDelegatorAndValidator(
 	return nil, nil
 }
 
+// GetValidatorProof returns the validator wrapper of a validator along with the
+// Merkle proofs to verify it against the state root of the block.
+func (s *PublicStakingService) GetValidatorProof(
+	ctx context.Context, address string, blockNrOrHash rpc.BlockNumberOrHash,
+) (ret *verify.ValidatorProof, err error) {
+	timer := DoMetricRPCRequest(GetValidatorProof)
+	defer DoRPCRequestDuration(GetValidatorProof, timer)
+
+	defer func() {
+		if ret == nil || err != nil {
+			DoMetricRPCQueryInfo(GetValidatorProof, FailedNumber)
+		}
+	}()
+
+	if !isBeaconShard(s.hmy) {
+		return nil, ErrNotBeaconShard
+	}
+	addr, err := internal_common.ParseAddr(address)
+	if err != nil {
+		return nil, err
+	}
+	state, _, err := s.hmy.StateAndHeaderByNumberOrHash(ctx, blockNrOrHash)
+	if state == nil || err != nil {
+		return nil, err
+	}
+	return verify.ProveValidator(state, addr)
+}
+
+// GetDelegationProof returns the delegation of a delegator with a validator
+// along with the Merkle proofs to verify it against the state root of the block.
+func (s *PublicStakingService) GetDelegationProof(
+	ctx context.Context, address string, validator string, blockNrOrHash rpc.BlockNumberOrHash,
+) (ret *verify.DelegationProof, err error) {
+	timer := DoMetricRPCRequest(GetDelegationProof)
+	defer DoRPCRequestDuration(GetDelegationProof, timer)
+
+	defer func() {
+		if ret == nil || err != nil {
+			DoMetricRPCQueryInfo(GetDelegationProof, FailedNumber)
+		}
+	}()
+
+	if !isBeaconShard(s.hmy) {
+		return nil, ErrNotBeaconShard
+	}
+	delegatorAddress, err := internal_common.ParseAddr(address)
+	if err != nil {
+		return nil, err
+	}
+	validatorAddress, err := internal_common.ParseAddr(validator)
+	if err != nil {
+		return nil, err
+	}
+	state, _, err := s.hmy.StateAndHeaderByNumberOrHash(ctx, blockNrOrHash)
+	if state == nil || err != nil {
+		return nil, err
+	}
+	return verify.ProveDelegation(state, validatorAddress, delegatorAddress)
+}
+
 // GetAvailableRedelegationBalance returns the amount of locked undelegated tokens
 func (s *PublicStakingService) GetAvailableRedelegationBalance(
 	ctx context.Context, address string,
@@ -30,6 +30,7 @@ import (
 	"github.com/harmony-one/harmony/core/types"
 	"github.com/harmony-one/harmony/eth/rpc"
 	"github.com/harmony-one/harmony/hmy"
+	"github.com/harmony-one/harmony/hmy/tracers"
 )
 
 const (
@@ -176,8 +177,9 @@ func (s *PublicTracerService) TraceTransaction(ctx context.Context, hash common.
 // TraceCall lets you trace a given eth_call. It collects the structured logs created during the execution of EVM
 // if the given transaction was added on top of the provided block and returns them as a JSON object.
 // You can provide -2 as a block number to trace on top of the pending block.
+// The state and block overrides of the config are applied on a copy of the state before the execution.
 // NOTE: Our version only supports block number as an input
-func (s *PublicTracerService) TraceCall(ctx context.Context, args CallArgs, blockNr rpc.BlockNumber, config *hmy.TraceConfig) (interface{}, error) {
+func (s *PublicTracerService) TraceCall(ctx context.Context, args CallArgs, blockNr rpc.BlockNumber, config *hmy.TraceCallConfig) (interface{}, error) {
 	timer := DoMetricRPCRequest(TraceCall)
 	defer DoRPCRequestDuration(TraceCall, timer)
 
@@ -205,6 +207,100 @@ func (s *PublicTracerService) TraceCall(ctx context.Context, args CallArgs, bloc
 	// Execute the trace
 	msg := args.ToMessage(s.hmy.RPCGasCap)
 	vmctx := core.NewEVMContext(msg, header, s.hmy.BlockChain, nil)
+	var traceConfig *hmy.TraceConfig
+	if config != nil {
+		if config.StateOverrides != nil {
+			statedb = statedb.Copy()
+			if err := config.StateOverrides.Apply(statedb); err != nil {
+				DoMetricRPCQueryInfo(TraceCall, FailedNumber)
+				return nil, err
+			}
+		}
+		config.BlockOverrides.Apply(&vmctx)
+		traceConfig = &config.TraceConfig
+	}
+	// Calls without a gas price are not subject to the base fee.
+	if msg.GasPrice().Sign() == 0 {
+		vmctx.BaseFee = nil
+	}
 	// Trace the transaction and return
-	return s.hmy.TraceTx(ctx, msg, vmctx, statedb, config)
+	return s.hmy.TraceTx(ctx, msg, vmctx, statedb, traceConfig)
+}
+
+// ProfileStateAccessCall executes the call on top of the given block and
+// returns every account and storage slot it read or wrote, with their values
+// before and after the call and the gas charged for the accesses.
+func (s *PublicTracerService) ProfileStateAccessCall(ctx context.Context, args CallArgs, blockNrOrHash rpc.BlockNumberOrHash, overrides *hmy.StateOverride) (*hmy.StateAccessProfile, error) {
+	timer := DoMetricRPCRequest(ProfileStateAccessCall)
+	defer DoRPCRequestDuration(ProfileStateAccessCall, timer)
+
+	statedb, header, err := s.hmy.StateAndHeaderByNumberOrHash(ctx, blockNrOrHash)
+	if statedb == nil || err != nil {
+		DoMetricRPCQueryInfo(ProfileStateAccessCall, FailedNumber)
+		return nil, err
+	}
+	statedb = statedb.Copy()
+	if err := overrides.Apply(statedb); err != nil {
+		DoMetricRPCQueryInfo(ProfileStateAccessCall, FailedNumber)
+		return nil, err
+	}
+	msg := args.ToMessage(s.hmy.RPCGasCap)
+	vmctx := core.NewEVMContext(msg, header, s.hmy.BlockChain, nil)
+	// Calls without a gas price are not subject to the base fee.
+	if msg.GasPrice().Sign() == 0 {
+		vmctx.BaseFee = nil
+	}
+	return s.hmy.ProfileStateAccess(ctx, msg, vmctx, statedb)
+}
+
+// ProfileStateAccessTransaction re-executes the mined transaction and returns
+// every account and storage slot it read or wrote.
+func (s *PublicTracerService) ProfileStateAccessTransaction(ctx context.Context, hash common.Hash) (*hmy.StateAccessProfile, error) {
+	timer := DoMetricRPCRequest(ProfileStateAccessTransaction)
+	defer DoRPCRequestDuration(ProfileStateAccessTransaction, timer)
+
+	tx, blockHash, _, index := rawdb.ReadTransaction(s.hmy.ChainDb(), hash)
+	if tx == nil {
+		DoMetricRPCQueryInfo(ProfileStateAccessTransaction, FailedNumber)
+		return nil, fmt.Errorf("transaction %#x not found", hash)
+	}
+	block := s.hmy.BlockChain.GetBlockByHash(blockHash)
+	if block == nil {
+		DoMetricRPCQueryInfo(ProfileStateAccessTransaction, FailedNumber)
+		return nil, fmt.Errorf("block %#x not found", blockHash)
+	}
+	msg, vmctx, statedb, err := s.hmy.ComputeTxEnv(block, int(index), defaultTraceReexec)
+	if err != nil {
+		DoMetricRPCQueryInfo(ProfileStateAccessTransaction, FailedNumber)
+		return nil, err
+	}
+	statedb.Prepare(tx.ConvertToEth().Hash(), block.Hash(), int(index))
+	return s.hmy.ProfileStateAccess(ctx, msg, vmctx, statedb)
+}
+
+// ProfileStateAccessRange re-executes the transactions of the blocks from start
+// to end, both included, and returns their state accesses aggregated per
+// contract, which shows the most accessed accounts and slots of the range.
+func (s *PublicTracerService) ProfileStateAccessRange(ctx context.Context, start, end rpc.BlockNumber) (tracers.StateAccessStats, error) {
+	timer := DoMetricRPCRequest(ProfileStateAccessRange)
+	defer DoRPCRequestDuration(ProfileStateAccessRange, timer)
+
+	current := s.hmy.CurrentBlock().NumberU64()
+	startNum, endNum := uint64(start), uint64(end)
+	if start == rpc.LatestBlockNumber {
+		startNum = current
+	}
+	if end == rpc.LatestBlockNumber {
+		endNum = current
+	}
+	if startNum > current || endNum > current {
+		DoMetricRPCQueryInfo(ProfileStateAccessRange, FailedNumber)
+		return nil, ErrRequestedBlockTooHigh
+	}
+	stats, err := s.hmy.ProfileStateAccessRange(ctx, startNum, endNum, defaultTraceReexec)
+	if err != nil {
+		DoMetricRPCQueryInfo(ProfileStateAccessRange, FailedNumber)
+		return nil, err
+	}
+	return stats, nil
 }
@@ -0,0 +1,65 @@
+package rpc
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/stretchr/testify/require"
+
+	blockfactory "github.com/harmony-one/harmony/block/factory"
+	"github.com/harmony-one/harmony/core"
+	"github.com/harmony-one/harmony/core/rawdb"
+	"github.com/harmony-one/harmony/core/vm"
+	"github.com/harmony-one/harmony/eth/rpc"
+	"github.com/harmony-one/harmony/hmy"
+	chain2 "github.com/harmony-one/harmony/internal/chain"
+	"github.com/harmony-one/harmony/internal/params"
+)
+
+// newTestHarmony returns a harmony backend over a fresh chain holding only the genesis block.
+func newTestHarmony(t *testing.T, config *params.ChainConfig, alloc core.GenesisAlloc) *hmy.Harmony {
+	database := rawdb.NewMemoryDatabase()
+	gspec := core.Genesis{
+		Config:   config,
+		Factory:  blockfactory.NewFactory(config),
+		Alloc:    alloc,
+		GasLimit: 1e18,
+		ShardID:  0,
+	}
+	gspec.MustCommit(database)
+	cacheConfig := &core.CacheConfig{SnapshotLimit: 0}
+	chain, err := core.NewBlockChain(database, nil, nil, cacheConfig, gspec.Config, chain2.NewEngine(), vm.Config{})
+	require.NoError(t, err)
+	return &hmy.Harmony{BlockChain: chain, RPCGasCap: big.NewInt(25000000)}
+}
+
+// londonTestConfig returns a copy of the test chain config with London active from genesis.
+func londonTestConfig() *params.ChainConfig {
+	config := *params.TestChainConfig
+	config.LondonEpoch = big.NewInt(0)
+	return &config
+}
+
+func TestTraceCallLondon(t *testing.T) {
+	s := &PublicTracerService{hmy: newTestHarmony(t, londonTestConfig(), core.GenesisAlloc{}), version: Debug}
+	require.NotNil(t, s.hmy.BlockChain.CurrentBlock().Header().BaseFee())
+
+	to := common.HexToAddress("0x000000000000000000000000000000000000dead")
+	gas := hexutil.Uint64(100000)
+
+	// calls without a gas price are not charged and ignore the base fee
+	res, err := s.TraceCall(context.Background(), CallArgs{From: &testAddr1, To: &to, Gas: &gas}, rpc.BlockNumber(0), nil)
+	require.NoError(t, err)
+	result, ok := res.(*hmy.ExecutionResult)
+	require.True(t, ok)
+	require.False(t, result.Failed)
+	require.Equal(t, params.TxGas, result.Gas)
+
+	// a gas price below the base fee is still refused
+	price := hexutil.Big(*big.NewInt(1))
+	_, err = s.TraceCall(context.Background(), CallArgs{From: &testAddr1, To: &to, Gas: &gas, GasPrice: &price}, rpc.BlockNumber(0), nil)
+	require.Error(t, err)
+}
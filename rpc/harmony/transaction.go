@@ -28,6 +28,7 @@ import (
 
 const (
 	defaultPageSize = uint32(100)
+	maxPageSize     = uint32(1000)
 )
 
 // PublicTransactionService provides an API to access Harmony's transaction service.
@@ -162,8 +163,10 @@ func (s *PublicTransactionService) GetStakingTransactionsCount(
 
 // EstimateGas returns an estimate of the amount of gas needed to execute the
 // given transaction against the current pending block.
+// The optional state and block overrides are applied on a copy of the state before the execution.
 func (s *PublicTransactionService) EstimateGas(
 	ctx context.Context, args CallArgs, blockNrOrHash *rpc.BlockNumberOrHash,
+	overrides *hmy.StateOverride, blockOverrides *hmy.BlockOverrides,
 ) (hexutil.Uint64, error) {
 	timer := DoMetricRPCRequest(RpcEstimateGas)
 	defer DoRPCRequestDuration(RpcEstimateGas, timer)
@@ -171,7 +174,7 @@ func (s *PublicTransactionService) EstimateGas(
 	if blockNrOrHash != nil {
 		bNrOrHash = *blockNrOrHash
 	}
-	gas, err := EstimateGas(ctx, s.hmy, args, bNrOrHash, nil)
+	gas, err := EstimateGas(ctx, s.hmy, args, bNrOrHash, overrides, blockOverrides, nil)
 	if err != nil {
 		return 0, err
 	}
@@ -832,6 +835,97 @@ func (s *PublicTransactionService) ResendCx(ctx context.Context, txID common.Has
 	return success, nil
 }
 
+// GetTokenTransfersByAddress returns a page of the ERC-20, ERC-721 and ERC-1155
+// token transfers sent or received by an address.
+func (s *PublicTransactionService) GetTokenTransfersByAddress(
+	ctx context.Context, args TokenTransferArgs,
+) (StructuredResponse, error) {
+	timer := DoMetricRPCRequest(GetTokenTransfersByAddress)
+	defer DoRPCRequestDuration(GetTokenTransfersByAddress, timer)
+
+	address, pageSize, err := tokenTransferQuery(args)
+	if err != nil {
+		DoMetricRPCQueryInfo(GetTokenTransfersByAddress, FailedNumber)
+		return nil, err
+	}
+	transfers, err := s.hmy.GetTokenTransfersByAddress(address, args.PageIndex, pageSize, args.Order)
+	if err != nil {
+		DoMetricRPCQueryInfo(GetTokenTransfersByAddress, FailedNumber)
+		return nil, err
+	}
+	// Response output is the same for all versions
+	return StructuredResponse{"transfers": transfers}, nil
+}
+
+// GetTokenTransfersByContract returns a page of the token transfers of a
+// token contract.
+func (s *PublicTransactionService) GetTokenTransfersByContract(
+	ctx context.Context, args TokenTransferArgs,
+) (StructuredResponse, error) {
+	timer := DoMetricRPCRequest(GetTokenTransfersByContract)
+	defer DoRPCRequestDuration(GetTokenTransfersByContract, timer)
+
+	address, pageSize, err := tokenTransferQuery(args)
+	if err != nil {
+		DoMetricRPCQueryInfo(GetTokenTransfersByContract, FailedNumber)
+		return nil, err
+	}
+	transfers, err := s.hmy.GetTokenTransfersByContract(address, args.PageIndex, pageSize, args.Order)
+	if err != nil {
+		DoMetricRPCQueryInfo(GetTokenTransfersByContract, FailedNumber)
+		return nil, err
+	}
+	// Response output is the same for all versions
+	return StructuredResponse{"transfers": transfers}, nil
+}
+
+// GetInternalTransactionsHistory returns a page of the internal transactions,
+// the calls made inside contracts that move value or create a contract, sent
+// or received by an address. Only blocks traced by the node are indexed.
+func (s *PublicTransactionService) GetInternalTransactionsHistory(
+	ctx context.Context, args InternalTxHistoryArgs,
+) (StructuredResponse, error) {
+	timer := DoMetricRPCRequest(GetInternalTransactionsHistory)
+	defer DoRPCRequestDuration(GetInternalTransactionsHistory, timer)
+
+	address, pageSize, err := tokenTransferQuery(TokenTransferArgs(args))
+	if err != nil {
+		DoMetricRPCQueryInfo(GetInternalTransactionsHistory, FailedNumber)
+		return nil, err
+	}
+	itxs, err := s.hmy.GetInternalTransactionsHistory(address, args.PageIndex, pageSize, args.Order)
+	if err != nil {
+		DoMetricRPCQueryInfo(GetInternalTransactionsHistory, FailedNumber)
+		return nil, err
+	}
+	// Response output is the same for all versions
+	return StructuredResponse{"transactions": itxs}, nil
+}
+
+// tokenTransferQuery returns the bech32 address and the page size of the
+// token transfer query
+func tokenTransferQuery(args TokenTransferArgs) (string, uint32, error) {
+	pageSize := defaultPageSize
+	if args.PageSize > 0 {
+		pageSize = args.PageSize
+	}
+	if pageSize > maxPageSize {
+		return "", 0, fmt.Errorf("page size %d exceeds the maximum of %d", pageSize, maxPageSize)
+	}
+	if strings.HasPrefix(args.Address, "one1") {
+		return args.Address, pageSize, nil
+	}
+	addr, err := internal_common.ParseAddr(args.Address)
+	if err != nil {
+		return "", 0, err
+	}
+	address, err := internal_common.AddressToBech32(addr)
+	if err != nil {
+		return "", 0, err
+	}
+	return address, pageSize, nil
+}
+
 // returnHashesWithPagination returns result with pagination (offset, page in TxHistoryArgs).
 func returnHashesWithPagination(hashes []common.Hash, pageIndex uint32, pageSize uint32) []common.Hash {
 	size := defaultPageSize
@@ -848,7 +942,10 @@ func returnHashesWithPagination(hashes []common.Hash, pageIndex uint32, pageSize
 }
 
 // EstimateGas - estimate gas cost for a given operation
-func EstimateGas(ctx context.Context, hmy *hmy.Harmony, args CallArgs, blockNrOrHash rpc.BlockNumberOrHash, gasCap *big.Int) (uint64, error) {
+func EstimateGas(
+	ctx context.Context, hmy *hmy.Harmony, args CallArgs, blockNrOrHash rpc.BlockNumberOrHash,
+	overrides *hmy.StateOverride, blockOverrides *hmy.BlockOverrides, gasCap *big.Int,
+) (uint64, error) {
 	// Binary search the gas requirement, as it may be higher than the amount used
 	var (
 		lo  uint64 = params.TxGas - 1
@@ -862,6 +959,8 @@ func EstimateGas(ctx context.Context, hmy *hmy.Harmony, args CallArgs, blockNrOr
 	// Determine the highest gas limit can be used during the estimation.
 	if args.Gas != nil && uint64(*args.Gas) >= params.TxGas {
 		hi = uint64(*args.Gas)
+	} else if blockOverrides != nil && blockOverrides.GasLimit != nil {
+		hi = uint64(*blockOverrides.GasLimit)
 	} else {
 
 		// Retrieve the block to act as the gas ceiling
@@ -877,6 +976,12 @@ func EstimateGas(ctx context.Context, hmy *hmy.Harmony, args CallArgs, blockNrOr
 		if err != nil {
 			return 0, err
 		}
+		if overrides != nil {
+			state = state.Copy()
+			if err := overrides.Apply(state); err != nil {
+				return 0, err
+			}
+		}
 		balance := state.GetBalance(*args.From) // from can't be nil
 		available := new(big.Int).Set(balance)
 		if args.Value != nil {
@@ -909,7 +1014,7 @@ func EstimateGas(ctx context.Context, hmy *hmy.Harmony, args CallArgs, blockNrOr
 	executable := func(gas uint64) (bool, *core.ExecutionResult, error) {
 		args.Gas = (*hexutil.Uint64)(&gas)
 
-		result, err := DoEVMCall(ctx, hmy, args, blockNrOrHash, 0)
+		result, err := DoEVMCall(ctx, hmy, args, blockNrOrHash, overrides, blockOverrides, 0)
 		if err != nil {
 			if errors.Is(err, core.ErrIntrinsicGas) {
 				return true, nil, nil // Special case, raise gas limit
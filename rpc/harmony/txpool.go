@@ -0,0 +1,287 @@
+package rpc
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+
+	"github.com/harmony-one/harmony/core/types"
+	"github.com/harmony-one/harmony/eth/rpc"
+	"github.com/harmony-one/harmony/hmy"
+	hmyCommon "github.com/harmony-one/harmony/internal/common"
+	eth "github.com/harmony-one/harmony/rpc/harmony/eth"
+	v2 "github.com/harmony-one/harmony/rpc/harmony/v2"
+	staking "github.com/harmony-one/harmony/staking/types"
+)
+
+// PublicTxPoolService offers the geth compatible txpool RPC methods, with the
+// staking transactions reported apart from the plain transactions.
+type PublicTxPoolService struct {
+	hmy *hmy.Harmony
+}
+
+// NewPublicTxPoolAPI creates a new API for the RPC interface
+func NewPublicTxPoolAPI(hmy *hmy.Harmony) rpc.API {
+	return rpc.API{
+		Namespace: txPoolNamespace,
+		Version:   APIVersion,
+		Service:   &PublicTxPoolService{hmy},
+		Public:    true,
+	}
+}
+
+// NonceGap is an inclusive range of nonces missing in front of queued transactions
+type NonceGap struct {
+	From hexutil.Uint64 `json:"from"`
+	To   hexutil.Uint64 `json:"to"`
+}
+
+// TxPoolContentResponse is the content of the transaction pool, keyed by sender & nonce
+type TxPoolContentResponse struct {
+	Pending        map[string]map[string]interface{} `json:"pending"`
+	Queued         map[string]map[string]interface{} `json:"queued"`
+	StakingPending map[string]map[string]interface{} `json:"stakingPending"`
+	StakingQueued  map[string]map[string]interface{} `json:"stakingQueued"`
+	NonceGaps      map[string][]NonceGap             `json:"nonceGaps"`
+}
+
+// TxPoolContentFromResponse is the content of the transaction pool of a single sender, keyed by nonce
+type TxPoolContentFromResponse struct {
+	Pending        map[string]interface{} `json:"pending"`
+	Queued         map[string]interface{} `json:"queued"`
+	StakingPending map[string]interface{} `json:"stakingPending"`
+	StakingQueued  map[string]interface{} `json:"stakingQueued"`
+	NonceGaps      []NonceGap             `json:"nonceGaps"`
+}
+
+// TxPoolInspectResponse is the summary of the transaction pool content, keyed by sender & nonce
+type TxPoolInspectResponse struct {
+	Pending        map[string]map[string]string `json:"pending"`
+	Queued         map[string]map[string]string `json:"queued"`
+	StakingPending map[string]map[string]string `json:"stakingPending"`
+	StakingQueued  map[string]map[string]string `json:"stakingQueued"`
+	NonceGaps      map[string][]NonceGap        `json:"nonceGaps"`
+}
+
+// Content returns the transactions contained within the transaction pool.
+func (s *PublicTxPoolService) Content(ctx context.Context) (*TxPoolContentResponse, error) {
+	timer := DoMetricRPCRequest(TxPoolContent)
+	defer DoRPCRequestDuration(TxPoolContent, timer)
+
+	content := &TxPoolContentResponse{
+		Pending:        map[string]map[string]interface{}{},
+		Queued:         map[string]map[string]interface{}{},
+		StakingPending: map[string]map[string]interface{}{},
+		StakingQueued:  map[string]map[string]interface{}{},
+		NonceGaps:      map[string][]NonceGap{},
+	}
+	pending, queued := s.hmy.GetPoolContent()
+	for addr, txs := range pending {
+		plainTxs, stakingTxs, err := formatTxPoolTransactions(txs)
+		if err != nil {
+			DoMetricRPCQueryInfo(TxPoolContent, FailedNumber)
+			return nil, err
+		}
+		addPoolTransactions(content.Pending, addr, plainTxs)
+		addPoolTransactions(content.StakingPending, addr, stakingTxs)
+	}
+	for addr, txs := range queued {
+		plainTxs, stakingTxs, err := formatTxPoolTransactions(txs)
+		if err != nil {
+			DoMetricRPCQueryInfo(TxPoolContent, FailedNumber)
+			return nil, err
+		}
+		addPoolTransactions(content.Queued, addr, plainTxs)
+		addPoolTransactions(content.StakingQueued, addr, stakingTxs)
+		if gaps := s.nonceGaps(ctx, addr, txs); len(gaps) > 0 {
+			content.NonceGaps[addr.Hex()] = gaps
+		}
+	}
+	return content, nil
+}
+
+// ContentFrom returns the transactions contained within the transaction pool for the sender.
+func (s *PublicTxPoolService) ContentFrom(ctx context.Context, address string) (*TxPoolContentFromResponse, error) {
+	timer := DoMetricRPCRequest(TxPoolContentFrom)
+	defer DoRPCRequestDuration(TxPoolContentFrom, timer)
+
+	addr, err := hmyCommon.ParseAddr(address)
+	if err != nil {
+		DoMetricRPCQueryInfo(TxPoolContentFrom, FailedNumber)
+		return nil, err
+	}
+	pending, queued := s.hmy.GetPoolContentFrom(addr)
+	content := &TxPoolContentFromResponse{}
+	if content.Pending, content.StakingPending, err = formatTxPoolTransactions(pending); err != nil {
+		DoMetricRPCQueryInfo(TxPoolContentFrom, FailedNumber)
+		return nil, err
+	}
+	if content.Queued, content.StakingQueued, err = formatTxPoolTransactions(queued); err != nil {
+		DoMetricRPCQueryInfo(TxPoolContentFrom, FailedNumber)
+		return nil, err
+	}
+	content.NonceGaps = s.nonceGaps(ctx, addr, queued)
+	return content, nil
+}
+
+// Inspect returns a textual summary of the transactions contained within the transaction pool.
+// It is meant to quickly find stuck nonces.
+func (s *PublicTxPoolService) Inspect(ctx context.Context) (*TxPoolInspectResponse, error) {
+	timer := DoMetricRPCRequest(TxPoolInspect)
+	defer DoRPCRequestDuration(TxPoolInspect, timer)
+
+	inspect := &TxPoolInspectResponse{
+		Pending:        map[string]map[string]string{},
+		Queued:         map[string]map[string]string{},
+		StakingPending: map[string]map[string]string{},
+		StakingQueued:  map[string]map[string]string{},
+		NonceGaps:      map[string][]NonceGap{},
+	}
+	pending, queued := s.hmy.GetPoolContent()
+	for addr, txs := range pending {
+		if err := inspectPoolTransactions(inspect.Pending, inspect.StakingPending, addr, txs); err != nil {
+			DoMetricRPCQueryInfo(TxPoolInspect, FailedNumber)
+			return nil, err
+		}
+	}
+	for addr, txs := range queued {
+		if err := inspectPoolTransactions(inspect.Queued, inspect.StakingQueued, addr, txs); err != nil {
+			DoMetricRPCQueryInfo(TxPoolInspect, FailedNumber)
+			return nil, err
+		}
+		if gaps := s.nonceGaps(ctx, addr, txs); len(gaps) > 0 {
+			inspect.NonceGaps[addr.Hex()] = gaps
+		}
+	}
+	return inspect, nil
+}
+
+// Status returns the number of pending and queued plain & staking transactions in the pool.
+func (s *PublicTxPoolService) Status(ctx context.Context) (map[string]hexutil.Uint, error) {
+	timer := DoMetricRPCRequest(TxPoolStatus)
+	defer DoRPCRequestDuration(TxPoolStatus, timer)
+
+	count := func(content map[common.Address]types.PoolTransactions) (plainCount, stakingCount hexutil.Uint) {
+		for _, txs := range content {
+			for _, tx := range txs {
+				if _, ok := tx.(*staking.StakingTransaction); ok {
+					stakingCount++
+				} else {
+					plainCount++
+				}
+			}
+		}
+		return plainCount, stakingCount
+	}
+	pending, queued := s.hmy.GetPoolContent()
+	pendingCount, stakingPendingCount := count(pending)
+	queuedCount, stakingQueuedCount := count(queued)
+	return map[string]hexutil.Uint{
+		"pending":        pendingCount,
+		"queued":         queuedCount,
+		"stakingPending": stakingPendingCount,
+		"stakingQueued":  stakingQueuedCount,
+	}, nil
+}
+
+// nonceGaps returns the nonces missing in front of the queued transactions of the sender
+func (s *PublicTxPoolService) nonceGaps(
+	ctx context.Context, addr common.Address, queued types.PoolTransactions,
+) []NonceGap {
+	if queued.Len() == 0 {
+		return []NonceGap{}
+	}
+	next, err := s.hmy.GetPoolNonce(ctx, addr)
+	if err != nil {
+		return []NonceGap{}
+	}
+	return queuedNonceGaps(next, queued)
+}
+
+// queuedNonceGaps returns the ranges of nonces missing between the next pending nonce
+// and the queued transactions, which are sorted by nonce.
+func queuedNonceGaps(next uint64, queued types.PoolTransactions) []NonceGap {
+	gaps := []NonceGap{}
+	for _, tx := range queued {
+		if tx.Nonce() > next {
+			gaps = append(gaps, NonceGap{From: hexutil.Uint64(next), To: hexutil.Uint64(tx.Nonce() - 1)})
+		}
+		if tx.Nonce() >= next {
+			next = tx.Nonce() + 1
+		}
+	}
+	return gaps
+}
+
+// formatTxPoolTransactions formats the transactions of a sender keyed by nonce, with
+// plain transactions in the eth format and staking transactions in the v2 format.
+func formatTxPoolTransactions(
+	txs types.PoolTransactions,
+) (plainTxs map[string]interface{}, stakingTxs map[string]interface{}, err error) {
+	plainTxs, stakingTxs = map[string]interface{}{}, map[string]interface{}{}
+	for _, tx := range txs {
+		nonce := fmt.Sprintf("%d", tx.Nonce())
+		switch poolTx := tx.(type) {
+		case *types.Transaction:
+			if plainTxs[nonce], err = eth.NewTransactionFromTransaction(poolTx, common.Hash{}, 0, 0, 0); err != nil {
+				return nil, nil, err
+			}
+		case *staking.StakingTransaction:
+			if stakingTxs[nonce], err = v2.NewStakingTransaction(poolTx, common.Hash{}, 0, 0, 0, true); err != nil {
+				return nil, nil, err
+			}
+		default:
+			return nil, nil, types.ErrUnknownPoolTxType
+		}
+	}
+	return plainTxs, stakingTxs, nil
+}
+
+func addPoolTransactions(content map[string]map[string]interface{}, addr common.Address, txs map[string]interface{}) {
+	if len(txs) > 0 {
+		content[addr.Hex()] = txs
+	}
+}
+
+// inspectPoolTransactions adds the summaries of the transactions of a sender to the
+// plain & staking summaries, keyed by sender & nonce.
+func inspectPoolTransactions(
+	plainSummaries, stakingSummaries map[string]map[string]string, addr common.Address, txs types.PoolTransactions,
+) error {
+	for _, tx := range txs {
+		summaries, summary := plainSummaries, ""
+		switch poolTx := tx.(type) {
+		case *types.Transaction:
+			summary = inspectTransaction(poolTx)
+		case *staking.StakingTransaction:
+			summaries = stakingSummaries
+			summary = fmt.Sprintf(
+				"%v: %v gas × %v wei", poolTx.StakingType(), poolTx.GasLimit(), poolTx.GasPrice(),
+			)
+		default:
+			return types.ErrUnknownPoolTxType
+		}
+		if _, ok := summaries[addr.Hex()]; !ok {
+			summaries[addr.Hex()] = map[string]string{}
+		}
+		summaries[addr.Hex()][fmt.Sprintf("%d", tx.Nonce())] = summary
+	}
+	return nil
+}
+
+// inspectTransaction returns the summary of a plain transaction in the geth format,
+// with the destination shard of cross-shard transactions.
+func inspectTransaction(tx *types.Transaction) string {
+	summary := ""
+	if to := tx.To(); to != nil {
+		summary = fmt.Sprintf("%s: %v wei + %v gas × %v wei", to.Hex(), tx.Value(), tx.GasLimit(), tx.GasPrice())
+	} else {
+		summary = fmt.Sprintf("contract creation: %v wei + %v gas × %v wei", tx.Value(), tx.GasLimit(), tx.GasPrice())
+	}
+	if tx.ShardID() != tx.ToShardID() {
+		summary += fmt.Sprintf(" (shard %d -> %d)", tx.ShardID(), tx.ToShardID())
+	}
+	return summary
+}
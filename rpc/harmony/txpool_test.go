@@ -0,0 +1,61 @@
+package rpc
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/stretchr/testify/require"
+
+	"github.com/harmony-one/harmony/core/types"
+)
+
+func TestQueuedNonceGaps(t *testing.T) {
+	queued := func(nonces ...uint64) types.PoolTransactions {
+		txs := types.PoolTransactions{}
+		for _, nonce := range nonces {
+			txs = append(txs, types.NewTransaction(nonce, testAddr1, 0, big.NewInt(0), 21000, big.NewInt(1e9), nil))
+		}
+		return txs
+	}
+	tests := []struct {
+		next   uint64
+		queued types.PoolTransactions
+		exp    []NonceGap
+	}{
+		{
+			next:   3,
+			queued: queued(),
+			exp:    []NonceGap{},
+		},
+		{
+			next:   3,
+			queued: queued(3, 4),
+			exp:    []NonceGap{},
+		},
+		{
+			next:   3,
+			queued: queued(5),
+			exp:    []NonceGap{{From: 3, To: 4}},
+		},
+		{
+			next:   3,
+			queued: queued(4, 5, 8, 10),
+			exp:    []NonceGap{{From: 3, To: 3}, {From: 6, To: 7}, {From: 9, To: 9}},
+		},
+	}
+	for i, test := range tests {
+		require.Equal(t, test.exp, queuedNonceGaps(test.next, test.queued), "test %v", i)
+	}
+}
+
+func TestInspectTransaction(t *testing.T) {
+	tx := types.NewTransaction(1, testAddr1, 0, big.NewInt(5), 21000, big.NewInt(1e9), nil)
+	require.Equal(t, testAddr1.Hex()+": 5 wei + 21000 gas × 1000000000 wei", inspectTransaction(tx))
+
+	cxTx := types.NewCrossShardTransaction(1, &testAddr1, 0, 1, big.NewInt(5), 21000, big.NewInt(1e9), nil)
+	require.Equal(t, testAddr1.Hex()+": 5 wei + 21000 gas × 1000000000 wei (shard 0 -> 1)", inspectTransaction(cxTx))
+
+	creation := types.NewContractCreation(1, 0, big.NewInt(0), 53000, big.NewInt(1e9), hexutil.MustDecode("0x00"))
+	require.Equal(t, "contract creation: 0 wei + 53000 gas × 1000000000 wei", inspectTransaction(creation))
+}
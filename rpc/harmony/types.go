@@ -34,6 +34,10 @@ type CallArgs struct {
 	GasPrice *hexutil.Big    `json:"gasPrice"`
 	Value    *hexutil.Big    `json:"value"`
 	Data     *hexutil.Bytes  `json:"data"`
+
+	// Dynamic fee parameters, used when no gas price is given.
+	MaxFeePerGas         *hexutil.Big `json:"maxFeePerGas"`
+	MaxPriorityFeePerGas *hexutil.Big `json:"maxPriorityFeePerGas"`
 }
 
 // ToMessage converts CallArgs to the Message type used by the core evm
@@ -57,9 +61,18 @@ func (args *CallArgs) ToMessage(globalGasCap *big.Int) types.Message {
 			Msg("Caller gas above allowance, capping")
 		gas = globalGasCap.Uint64()
 	}
-	gasPrice := new(big.Int)
+	gasPrice, gasFeeCap, gasTipCap := new(big.Int), new(big.Int), new(big.Int)
 	if args.GasPrice != nil {
 		gasPrice = args.GasPrice.ToInt()
+		gasFeeCap, gasTipCap = gasPrice, gasPrice
+	} else {
+		if args.MaxFeePerGas != nil {
+			gasFeeCap = args.MaxFeePerGas.ToInt()
+		}
+		if args.MaxPriorityFeePerGas != nil {
+			gasTipCap = args.MaxPriorityFeePerGas.ToInt()
+		}
+		gasPrice = gasFeeCap
 	}
 
 	value := new(big.Int)
@@ -73,6 +86,7 @@ func (args *CallArgs) ToMessage(globalGasCap *big.Int) types.Message {
 	}
 
 	msg := types.NewMessage(addr, args.To, 0, value, gas, gasPrice, data, false)
+	msg.SetGasFeeCaps(gasFeeCap, gasTipCap)
 	return msg
 }
 
@@ -196,6 +210,35 @@ func (i *TransactionIndex) UnmarshalJSON(data []byte) (err error) {
 	return nil
 }
 
+// BlockCount is the number of blocks requested for a fee history
+type BlockCount uint64
+
+// UnmarshalJSON converts a hex string or integer to a block count
+func (c *BlockCount) UnmarshalJSON(data []byte) error {
+	var i TransactionIndex
+	if err := i.UnmarshalJSON(data); err != nil {
+		return err
+	}
+	*c = BlockCount(i)
+	return nil
+}
+
+// FeeHistoryResult is the fee market history of a range of blocks
+type FeeHistoryResult struct {
+	OldestBlock  *hexutil.Big     `json:"oldestBlock"`
+	Reward       [][]*hexutil.Big `json:"reward,omitempty"`
+	BaseFee      []*hexutil.Big   `json:"baseFeePerGas,omitempty"`
+	GasUsedRatio []float64        `json:"gasUsedRatio"`
+}
+
+// FeeHistoryResultV2 is the fee market history of a range of blocks for the V2 api
+type FeeHistoryResultV2 struct {
+	OldestBlock  *big.Int     `json:"oldestBlock"`
+	Reward       [][]*big.Int `json:"reward,omitempty"`
+	BaseFee      []*big.Int   `json:"baseFeePerGas,omitempty"`
+	GasUsedRatio []float64    `json:"gasUsedRatio"`
+}
+
 // TxHistoryArgs is struct to include optional transaction formatting params.
 type TxHistoryArgs struct {
 	Address   string `json:"address"`
@@ -206,6 +249,22 @@ type TxHistoryArgs struct {
 	Order     string `json:"order"`
 }
 
+// TokenTransferArgs is struct to include the token transfer pagination params.
+type TokenTransferArgs struct {
+	Address   string `json:"address"`
+	PageIndex uint32 `json:"pageIndex"`
+	PageSize  uint32 `json:"pageSize"`
+	Order     string `json:"order"`
+}
+
+// InternalTxHistoryArgs is struct to include the internal transaction history pagination params.
+type InternalTxHistoryArgs struct {
+	Address   string `json:"address"`
+	PageIndex uint32 `json:"pageIndex"`
+	PageSize  uint32 `json:"pageSize"`
+	Order     string `json:"order"`
+}
+
 // UnmarshalFromInterface ..
 func (ta *TxHistoryArgs) UnmarshalFromInterface(blockArgs interface{}) error {
 	var args TxHistoryArgs
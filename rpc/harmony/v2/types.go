@@ -106,24 +106,29 @@ type BlockWithFullTx struct {
 
 // Transaction represents a transaction that will serialize to the RPC representation of a transaction
 type Transaction struct {
-	BlockHash        common.Hash   `json:"blockHash"`
-	BlockNumber      *big.Int      `json:"blockNumber"`
-	From             string        `json:"from"`
-	Timestamp        uint64        `json:"timestamp"`
-	Gas              uint64        `json:"gas"`
-	GasPrice         *big.Int      `json:"gasPrice"`
-	Hash             common.Hash   `json:"hash"`
-	EthHash          common.Hash   `json:"ethHash"`
-	Input            hexutil.Bytes `json:"input"`
-	Nonce            uint64        `json:"nonce"`
-	To               string        `json:"to"`
-	TransactionIndex uint64        `json:"transactionIndex"`
-	Value            *big.Int      `json:"value"`
-	ShardID          uint32        `json:"shardID"`
-	ToShardID        uint32        `json:"toShardID"`
-	V                *hexutil.Big  `json:"v"`
-	R                *hexutil.Big  `json:"r"`
-	S                *hexutil.Big  `json:"s"`
+	BlockHash        common.Hash       `json:"blockHash"`
+	BlockNumber      *big.Int          `json:"blockNumber"`
+	From             string            `json:"from"`
+	Timestamp        uint64            `json:"timestamp"`
+	Gas              uint64            `json:"gas"`
+	GasPrice         *big.Int          `json:"gasPrice"`
+	GasFeeCap        *big.Int          `json:"maxFeePerGas,omitempty"`
+	GasTipCap        *big.Int          `json:"maxPriorityFeePerGas,omitempty"`
+	Hash             common.Hash       `json:"hash"`
+	EthHash          common.Hash       `json:"ethHash"`
+	Input            hexutil.Bytes     `json:"input"`
+	Nonce            uint64            `json:"nonce"`
+	To               string            `json:"to"`
+	TransactionIndex uint64            `json:"transactionIndex"`
+	Value            *big.Int          `json:"value"`
+	ShardID          uint32            `json:"shardID"`
+	ToShardID        uint32            `json:"toShardID"`
+	Type             uint64            `json:"type"`
+	ChainID          *hexutil.Big      `json:"chainId,omitempty"`
+	AccessList       *types.AccessList `json:"accessList,omitempty"`
+	V                *hexutil.Big      `json:"v"`
+	R                *hexutil.Big      `json:"r"`
+	S                *hexutil.Big      `json:"s"`
 }
 
 // StakingTransaction represents a transaction that will serialize to the RPC representation of a staking transaction
@@ -204,21 +209,23 @@ type UndelegateMsg struct {
 
 // TxReceipt represents a transaction receipt that will serialize to the RPC representation.
 type TxReceipt struct {
-	BlockHash         common.Hash    `json:"blockHash"`
-	TransactionHash   common.Hash    `json:"transactionHash"`
-	BlockNumber       uint64         `json:"blockNumber"`
-	TransactionIndex  uint64         `json:"transactionIndex"`
-	GasUsed           uint64         `json:"gasUsed"`
-	CumulativeGasUsed uint64         `json:"cumulativeGasUsed"`
-	ContractAddress   common.Address `json:"contractAddress"`
-	Logs              []*types.Log   `json:"logs"`
-	LogsBloom         ethtypes.Bloom `json:"logsBloom"`
-	ShardID           uint32         `json:"shardID"`
-	From              string         `json:"from"`
-	To                string         `json:"to"`
-	Root              hexutil.Bytes  `json:"root"`
-	Status            uint           `json:"status"`
-	EffectiveGasPrice uint64         `json:"effectiveGasPrice"`
+	BlockHash         common.Hash       `json:"blockHash"`
+	TransactionHash   common.Hash       `json:"transactionHash"`
+	BlockNumber       uint64            `json:"blockNumber"`
+	TransactionIndex  uint64            `json:"transactionIndex"`
+	GasUsed           uint64            `json:"gasUsed"`
+	CumulativeGasUsed uint64            `json:"cumulativeGasUsed"`
+	ContractAddress   common.Address    `json:"contractAddress"`
+	Logs              []*types.Log      `json:"logs"`
+	LogsBloom         ethtypes.Bloom    `json:"logsBloom"`
+	ShardID           uint32            `json:"shardID"`
+	From              string            `json:"from"`
+	To                string            `json:"to"`
+	Root              hexutil.Bytes     `json:"root"`
+	Status            uint              `json:"status"`
+	EffectiveGasPrice uint64            `json:"effectiveGasPrice"`
+	Type              uint64            `json:"type"`
+	AccessList        *types.AccessList `json:"accessList,omitempty"`
 }
 
 // StakingTxReceipt represents a staking transaction receipt that will serialize to the RPC representation.
@@ -304,11 +311,21 @@ func NewTransaction(
 		Value:     tx.Value(),
 		ShardID:   tx.ShardID(),
 		ToShardID: tx.ToShardID(),
+		Type:      uint64(tx.Type()),
 		Timestamp: timestamp,
 		V:         (*hexutil.Big)(v),
 		R:         (*hexutil.Big)(r),
 		S:         (*hexutil.Big)(s),
 	}
+	if tx.Type() != types.LegacyTxType {
+		al := tx.AccessList()
+		result.AccessList = &al
+		result.ChainID = (*hexutil.Big)(tx.ChainID())
+	}
+	if tx.Type() == types.DynamicFeeTxType {
+		result.GasFeeCap = tx.GasFeeCap()
+		result.GasTipCap = tx.GasTipCap()
+	}
 	if blockHash != (common.Hash{}) {
 		result.BlockHash = blockHash
 		result.BlockNumber = new(big.Int).SetUint64(blockNumber)
@@ -391,6 +408,11 @@ func NewTxReceipt(
 		Root:              receipt.PostState,
 		Status:            uint(receipt.Status),
 		EffectiveGasPrice: (*receipt.EffectiveGasPrice).Uint64(),
+		Type:              uint64(tx.Type()),
+	}
+	if tx.Type() != types.LegacyTxType {
+		al := tx.AccessList()
+		txReceipt.AccessList = &al
 	}
 
 	// Set optionals
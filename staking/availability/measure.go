@@ -229,6 +229,56 @@ func ComputeAndMutateEPOSStatus(
 	return nil
 }
 
+// StatusChange is the change of a validator status made by ComputeAndMutateEPOSStatus
+type StatusChange struct {
+	Validator      common.Address
+	Epoch          *big.Int
+	PreviousStatus effective.Eligibility
+	Status         effective.Eligibility
+	Computed       *staking.Computed
+}
+
+// EPOSStatusChanges returns the validators set to inactive by ComputeAndMutateEPOSStatus
+// in the committee selection block, given the states before and after the block.
+func EPOSStatusChanges(
+	bc Reader,
+	parent, state stateValidatorWrapper,
+	addrs []common.Address,
+) []StatusChange {
+	changes := []StatusChange{}
+	for _, addr := range addrs {
+		previous, err := parent.ValidatorWrapper(addr, true, false)
+		if err != nil {
+			continue
+		}
+		current, err := state.ValidatorWrapper(addr, true, false)
+		if err != nil {
+			continue
+		}
+		if current.Status != effective.Inactive || previous.Status == current.Status {
+			continue
+		}
+		snapshot, err := bc.ReadValidatorSnapshot(addr)
+		if err != nil {
+			continue
+		}
+		// the signing counters are only updated after the status is computed,
+		// so the counters before the block give the same result
+		computed := ComputeCurrentSigning(snapshot.Validator, previous, bc.Config().IsHIP32(snapshot.Epoch))
+		if !computed.IsBelowThreshold {
+			continue
+		}
+		changes = append(changes, StatusChange{
+			Validator:      addr,
+			Epoch:          snapshot.Epoch,
+			PreviousStatus: previous.Status,
+			Status:         current.Status,
+			Computed:       computed,
+		})
+	}
+	return changes
+}
+
 // UpdateMinimumCommissionFee update the validator commission fee to the minRate
 // if the validator has a lower commission rate and promoPeriod epochs have passed after
 // the validator was first elected. It returns true if the commission was updated
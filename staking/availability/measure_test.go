@@ -377,6 +377,71 @@ func TestComputeAndMutateEPOSStatus(t *testing.T) {
 	}
 }
 
+func TestEPOSStatusChanges(t *testing.T) {
+	tests := []struct {
+		ctx        *computeEPOSTestCtx
+		expChanged bool
+	}{
+		// active -> inactive
+		{
+			ctx: &computeEPOSTestCtx{
+				addr:       common.Address{20, 20},
+				snapSigned: 100,
+				snapToSign: 100,
+				snapEli:    effective.Active,
+				curSigned:  100,
+				curToSign:  200,
+				curEli:     effective.Active,
+			},
+			expChanged: true,
+		},
+		// active node
+		{
+			ctx: &computeEPOSTestCtx{
+				addr:       common.Address{20, 20},
+				snapSigned: 100,
+				snapToSign: 100,
+				snapEli:    effective.Active,
+				curSigned:  200,
+				curToSign:  200,
+				curEli:     effective.Active,
+			},
+		},
+		// status unchanged: inactive -> inactive
+		{
+			ctx: &computeEPOSTestCtx{
+				addr:       common.Address{20, 20},
+				snapSigned: 100,
+				snapToSign: 100,
+				snapEli:    effective.Active,
+				curSigned:  100,
+				curToSign:  200,
+				curEli:     effective.Inactive,
+			},
+		},
+	}
+	for i, test := range tests {
+		ctx := test.ctx
+		ctx.makeStateAndReader()
+		parent := ctx.state
+		wrapper := *parent[ctx.addr]
+		ctx.state = newTestStateDB()
+		ctx.state.UpdateValidatorWrapper(ctx.addr, &wrapper)
+
+		if err := ComputeAndMutateEPOSStatus(ctx.reader, ctx.state, ctx.addr); err != nil {
+			t.Fatalf("Test %v: %v", i, err)
+		}
+		changes := EPOSStatusChanges(ctx.reader, parent, ctx.state, []common.Address{ctx.addr})
+		if changed := len(changes) != 0; changed != test.expChanged {
+			t.Errorf("Test %v: unexpected status change: %v / %v", i, changed, test.expChanged)
+			continue
+		}
+		if test.expChanged && (changes[0].PreviousStatus != effective.Active || changes[0].Status != effective.Inactive) {
+			t.Errorf("Test %v: unexpected status change: %v -> %v", i, changes[0].PreviousStatus, changes[0].Status)
+		}
+	}
+}
+
 // incStateTestCtx is the helper structure for test case TestIncrementValidatorSigningCounts
 type incStateTestCtx struct {
 	// Initialized fields
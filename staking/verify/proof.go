@@ -0,0 +1,190 @@
+package verify
+
+import (
+	"encoding/hex"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/ethdb/memorydb"
+	"github.com/ethereum/go-ethereum/rlp"
+	"github.com/ethereum/go-ethereum/trie"
+	"github.com/pkg/errors"
+
+	"github.com/harmony-one/harmony/block"
+	"github.com/harmony-one/harmony/core/state"
+	"github.com/harmony-one/harmony/staking"
+	stk "github.com/harmony-one/harmony/staking/types"
+)
+
+var (
+	// ErrNotValidator is returned when the account is not a validator
+	ErrNotValidator = errors.New("account is not a validator")
+	// ErrDelegationNotFound is returned when the delegator has no delegation
+	// with the validator
+	ErrDelegationNotFound = errors.New("delegation not found")
+	// ErrCodeHashMismatch is returned when the validator wrapper does not
+	// match the code hash of the proven account
+	ErrCodeHashMismatch = errors.New("validator wrapper does not match the account code hash")
+)
+
+// ValidatorProof is the validator wrapper of an account, along with the
+// Merkle proofs of the account and of its validator flag up to the state root.
+// The wrapper is stored as the code of the account, so it is checked against
+// the code hash of the proven account.
+type ValidatorProof struct {
+	Address      common.Address  `json:"address"`
+	AccountProof []hexutil.Bytes `json:"accountProof"`
+	FlagProof    []hexutil.Bytes `json:"validatorFlagProof"`
+	// Wrapper is the RLP encoded validator wrapper
+	Wrapper hexutil.Bytes `json:"validatorWrapper"`
+}
+
+// DelegationProof is the proof of a single delegation, which is the proof of
+// the validator wrapper holding it along with its position in the wrapper.
+type DelegationProof struct {
+	ValidatorProof
+	Delegator common.Address `json:"delegator"`
+	Index     uint64         `json:"index"`
+}
+
+// ProveValidator returns the proof of the validator at addr in the state
+func ProveValidator(db *state.DB, addr common.Address) (*ValidatorProof, error) {
+	if !db.IsValidator(addr) {
+		return nil, ErrNotValidator
+	}
+	accountProof, err := db.GetProof(addr)
+	if err != nil {
+		return nil, errors.Wrap(err, "account proof")
+	}
+	flagProof, err := db.GetStorageProof(addr, staking.IsValidatorKey)
+	if err != nil {
+		return nil, errors.Wrap(err, "validator flag proof")
+	}
+	return &ValidatorProof{
+		Address:      addr,
+		AccountProof: toBytesSlice(accountProof),
+		FlagProof:    toBytesSlice(flagProof),
+		Wrapper:      db.GetCode(addr),
+	}, db.Error()
+}
+
+// ProveDelegation returns the proof of the delegation of delegator with the
+// validator at addr in the state
+func ProveDelegation(db *state.DB, addr, delegator common.Address) (*DelegationProof, error) {
+	proof, err := ProveValidator(db, addr)
+	if err != nil {
+		return nil, err
+	}
+	wrapper, err := db.ValidatorWrapper(addr, true, false)
+	if err != nil {
+		return nil, err
+	}
+	for i := range wrapper.Delegations {
+		if wrapper.Delegations[i].DelegatorAddress == delegator {
+			return &DelegationProof{
+				ValidatorProof: *proof,
+				Delegator:      delegator,
+				Index:          uint64(i),
+			}, nil
+		}
+	}
+	return nil, ErrDelegationNotFound
+}
+
+// VerifyValidator checks the proof against the state root of a trusted header
+// and returns the proven validator wrapper
+func VerifyValidator(root common.Hash, proof *ValidatorProof) (*stk.ValidatorWrapper, error) {
+	value, err := verifyProof(root, crypto.Keccak256(proof.Address.Bytes()), proof.AccountProof)
+	if err != nil {
+		return nil, errors.Wrap(err, "account proof")
+	}
+	if len(value) == 0 {
+		return nil, ErrNotValidator
+	}
+	var account ethtypes.StateAccount
+	if err := rlp.DecodeBytes(value, &account); err != nil {
+		return nil, errors.Wrap(err, "decode account")
+	}
+
+	// the validator flag is a slot of the account storage
+	value, err = verifyProof(account.Root, crypto.Keccak256(staking.IsValidatorKey.Bytes()), proof.FlagProof)
+	if err != nil {
+		return nil, errors.Wrap(err, "validator flag proof")
+	}
+	if len(value) == 0 {
+		return nil, ErrNotValidator
+	}
+	_, flag, _, err := rlp.Split(value)
+	if err != nil {
+		return nil, errors.Wrap(err, "decode validator flag")
+	}
+	if common.BytesToHash(flag) == (common.Hash{}) {
+		return nil, ErrNotValidator
+	}
+
+	if crypto.Keccak256Hash(proof.Wrapper) != common.BytesToHash(account.CodeHash) {
+		return nil, ErrCodeHashMismatch
+	}
+	var wrapper stk.ValidatorWrapper
+	if err := rlp.DecodeBytes(proof.Wrapper, &wrapper); err != nil {
+		return nil, errors.Wrap(err, "decode validator wrapper")
+	}
+	if wrapper.Address != proof.Address {
+		return nil, errors.Errorf("validator wrapper of %s proven for %s",
+			wrapper.Address.Hex(), proof.Address.Hex())
+	}
+	return &wrapper, nil
+}
+
+// VerifyDelegation checks the proof against the state root of a trusted
+// header and returns the proven delegation
+func VerifyDelegation(root common.Hash, proof *DelegationProof) (*stk.Delegation, error) {
+	wrapper, err := VerifyValidator(root, &proof.ValidatorProof)
+	if err != nil {
+		return nil, err
+	}
+	if proof.Index >= uint64(len(wrapper.Delegations)) {
+		return nil, ErrDelegationNotFound
+	}
+	delegation := wrapper.Delegations[proof.Index]
+	if delegation.DelegatorAddress != proof.Delegator {
+		return nil, ErrDelegationNotFound
+	}
+	return &delegation, nil
+}
+
+// HeaderFromRLPHex decodes a header returned by hmy_getHeaderByNumberRLPHex
+func HeaderFromRLPHex(s string) (*block.Header, error) {
+	b, err := hex.DecodeString(strings.TrimPrefix(s, "0x"))
+	if err != nil {
+		return nil, err
+	}
+	header := new(block.Header)
+	if err := rlp.DecodeBytes(b, header); err != nil {
+		return nil, errors.Wrap(err, "decode header")
+	}
+	return header, nil
+}
+
+// verifyProof returns the value of key in the trie of root, nil if the proof
+// shows the key is absent
+func verifyProof(root common.Hash, key []byte, proof []hexutil.Bytes) ([]byte, error) {
+	db := memorydb.New()
+	for _, node := range proof {
+		if err := db.Put(crypto.Keccak256(node), node); err != nil {
+			return nil, err
+		}
+	}
+	return trie.VerifyProof(root, key, db)
+}
+
+func toBytesSlice(b [][]byte) []hexutil.Bytes {
+	r := make([]hexutil.Bytes, len(b))
+	for i := range b {
+		r[i] = b[i]
+	}
+	return r
+}
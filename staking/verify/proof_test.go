@@ -0,0 +1,128 @@
+package verify
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/rlp"
+
+	blockfactory "github.com/harmony-one/harmony/block/factory"
+	"github.com/harmony-one/harmony/core/rawdb"
+	"github.com/harmony-one/harmony/core/state"
+	stk "github.com/harmony-one/harmony/staking/types"
+)
+
+var (
+	validatorAddr = common.HexToAddress("0x0a")
+	delegatorAddr = common.HexToAddress("0x0b")
+	contractAddr  = common.HexToAddress("0x0c")
+)
+
+func makeProofState(t *testing.T) (*state.DB, common.Hash) {
+	wrapper := stk.ValidatorWrapper{
+		Validator: stk.Validator{
+			Address:              validatorAddr,
+			LastEpochInCommittee: big.NewInt(1),
+			MinSelfDelegation:    big.NewInt(10),
+			MaxTotalDelegation:   big.NewInt(100),
+			CreationHeight:       big.NewInt(1),
+		},
+		Delegations: stk.Delegations{
+			stk.NewDelegation(validatorAddr, big.NewInt(10)),
+			stk.NewDelegation(delegatorAddr, big.NewInt(20)),
+		},
+		BlockReward: big.NewInt(0),
+	}
+	code, err := rlp.EncodeToBytes(&wrapper)
+	if err != nil {
+		t.Fatal(err)
+	}
+	db := state.NewDatabase(rawdb.NewMemoryDatabase())
+	sdb, _ := state.New(common.Hash{}, db, nil)
+	sdb.SetCode(validatorAddr, code, true)
+	sdb.SetValidatorFlag(validatorAddr)
+	// same code, without the validator flag
+	sdb.SetCode(contractAddr, code, false)
+	sdb.SetState(contractAddr, common.HexToHash("0x01"), common.HexToHash("0x01"))
+	root, err := sdb.Commit(true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	sdb, err = state.New(root, db, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return sdb, root
+}
+
+func TestValidatorProof(t *testing.T) {
+	sdb, root := makeProofState(t)
+
+	proof, err := ProveValidator(sdb, validatorAddr)
+	if err != nil {
+		t.Fatal(err)
+	}
+	wrapper, err := VerifyValidator(root, proof)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if wrapper.Address != validatorAddr || len(wrapper.Delegations) != 2 {
+		t.Fatalf("unexpected validator wrapper %+v", wrapper)
+	}
+
+	if _, err := VerifyValidator(common.HexToHash("0x01"), proof); err == nil {
+		t.Fatal("proof must not verify against another root")
+	}
+	tampered := *proof
+	tampered.Wrapper = append([]byte{}, proof.Wrapper...)
+	tampered.Wrapper[len(tampered.Wrapper)-1] ^= 1
+	if _, err := VerifyValidator(root, &tampered); err != ErrCodeHashMismatch {
+		t.Fatalf("have %v, want %v", err, ErrCodeHashMismatch)
+	}
+	if _, err := ProveValidator(sdb, contractAddr); err != ErrNotValidator {
+		t.Fatalf("have %v, want %v", err, ErrNotValidator)
+	}
+}
+
+func TestDelegationProof(t *testing.T) {
+	sdb, root := makeProofState(t)
+
+	proof, err := ProveDelegation(sdb, validatorAddr, delegatorAddr)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if proof.Index != 1 {
+		t.Fatalf("have index %d, want 1", proof.Index)
+	}
+	delegation, err := VerifyDelegation(root, proof)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if delegation.DelegatorAddress != delegatorAddr || delegation.Amount.Cmp(big.NewInt(20)) != 0 {
+		t.Fatalf("unexpected delegation %+v", delegation)
+	}
+
+	proof.Index = 0
+	if _, err := VerifyDelegation(root, proof); err != ErrDelegationNotFound {
+		t.Fatalf("have %v, want %v", err, ErrDelegationNotFound)
+	}
+	if _, err := ProveDelegation(sdb, validatorAddr, contractAddr); err != ErrDelegationNotFound {
+		t.Fatalf("have %v, want %v", err, ErrDelegationNotFound)
+	}
+}
+
+func TestHeaderFromRLPHex(t *testing.T) {
+	header := blockfactory.NewTestHeader().With().Number(big.NewInt(42)).Header()
+	b, err := rlp.EncodeToBytes(header)
+	if err != nil {
+		t.Fatal(err)
+	}
+	decoded, err := HeaderFromRLPHex(common.Bytes2Hex(b))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if decoded.Hash() != header.Hash() {
+		t.Fatalf("have header %s, want %s", decoded.Hash().Hex(), header.Hash().Hex())
+	}
+}
@@ -0,0 +1,327 @@
+package webhooks
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/harmony-one/harmony/internal/utils"
+)
+
+const (
+	// EventHeader carries the event type of a delivery
+	EventHeader = "X-Harmony-Event"
+	// SignatureHeader carries the HMAC-SHA256 signature of the delivery body
+	SignatureHeader = "X-Harmony-Signature"
+
+	defaultQueueSize       = 1024
+	defaultWorkers         = 4
+	defaultTimeout         = 5 * time.Second
+	defaultMaxRetries      = 5
+	defaultRetryBackoff    = time.Second
+	defaultMaxRetryBackoff = time.Minute
+)
+
+// Endpoint is a webhook receiver along with the events it subscribes to
+type Endpoint struct {
+	URL string `yaml:"url"`
+	// Secret is the HMAC key used to sign deliveries, no signature is sent if empty
+	Secret string `yaml:"secret"`
+	// Events filters the delivered events, all events are delivered if empty
+	Events     []EventType   `yaml:"events"`
+	Timeout    time.Duration `yaml:"timeout"`
+	MaxRetries *int          `yaml:"max-retries"`
+
+	// raw endpoints receive the bare payload instead of the event envelope,
+	// as the legacy single URL hooks did
+	raw bool
+}
+
+// Accepts returns whether the endpoint subscribes to the given event
+func (e *Endpoint) Accepts(event EventType) bool {
+	if len(e.Events) == 0 {
+		return true
+	}
+	for _, ev := range e.Events {
+		if ev == event {
+			return true
+		}
+	}
+	return false
+}
+
+// DispatcherConfig configures the delivery queue of the dispatcher
+type DispatcherConfig struct {
+	QueueSize       int           `yaml:"queue-size"`
+	Workers         int           `yaml:"workers"`
+	RetryBackoff    time.Duration `yaml:"retry-backoff"`
+	MaxRetryBackoff time.Duration `yaml:"max-retry-backoff"`
+}
+
+type delivery struct {
+	endpoint *Endpoint
+	event    EventType
+	body     []byte
+	attempt  int
+}
+
+// Dispatcher delivers events to the webhook endpoints through a bounded
+// queue. Failed deliveries are retried with exponential backoff, and events
+// are dropped when the queue is full so that publishers never block.
+// Delivery is at least once, receivers should be idempotent.
+type Dispatcher struct {
+	config    DispatcherConfig
+	endpoints []*Endpoint
+	client    *http.Client
+	queue     chan *delivery
+	quit      chan struct{}
+	wg        sync.WaitGroup
+	startOnce sync.Once
+	stopOnce  sync.Once
+	// dropped counts the deliveries lost because of a full queue
+	dropped uint64
+}
+
+// NewDispatcher creates a dispatcher for the endpoints of the given hooks.
+// The legacy single URL hooks are turned into endpoints subscribed to their
+// own event only.
+func NewDispatcher(hooks *Hooks) *Dispatcher {
+	d := &Dispatcher{
+		client: &http.Client{},
+		quit:   make(chan struct{}),
+	}
+	if hooks != nil {
+		if hooks.Dispatcher != nil {
+			d.config = *hooks.Dispatcher
+		}
+		d.endpoints = append(d.endpoints, hooks.legacyEndpoints()...)
+		d.endpoints = append(d.endpoints, hooks.Endpoints...)
+	}
+	if d.config.QueueSize <= 0 {
+		d.config.QueueSize = defaultQueueSize
+	}
+	if d.config.Workers <= 0 {
+		d.config.Workers = defaultWorkers
+	}
+	if d.config.RetryBackoff <= 0 {
+		d.config.RetryBackoff = defaultRetryBackoff
+	}
+	if d.config.MaxRetryBackoff < d.config.RetryBackoff {
+		d.config.MaxRetryBackoff = defaultMaxRetryBackoff
+		if d.config.MaxRetryBackoff < d.config.RetryBackoff {
+			d.config.MaxRetryBackoff = d.config.RetryBackoff
+		}
+	}
+	d.queue = make(chan *delivery, d.config.QueueSize)
+	return d
+}
+
+// Start launches the delivery workers
+func (d *Dispatcher) Start() {
+	if d == nil {
+		return
+	}
+	d.startOnce.Do(func() {
+		for i := 0; i < d.config.Workers; i++ {
+			d.wg.Add(1)
+			go d.loop()
+		}
+	})
+}
+
+// Stop terminates the delivery workers. Queued and pending retries are dropped.
+func (d *Dispatcher) Stop() {
+	if d == nil {
+		return
+	}
+	d.stopOnce.Do(func() {
+		close(d.quit)
+		d.wg.Wait()
+	})
+}
+
+// Dropped returns the number of deliveries dropped because the queue was full
+func (d *Dispatcher) Dropped() uint64 {
+	if d == nil {
+		return 0
+	}
+	return atomic.LoadUint64(&d.dropped)
+}
+
+// Subscribed returns whether any endpoint subscribes to the given event, so
+// that publishers can skip building expensive payloads
+func (d *Dispatcher) Subscribed(event EventType) bool {
+	if d == nil {
+		return false
+	}
+	for _, endpoint := range d.endpoints {
+		if endpoint.Accepts(event) {
+			return true
+		}
+	}
+	return false
+}
+
+// Publish queues the event for every endpoint subscribed to it. It never
+// blocks, the delivery is dropped if the queue is full.
+func (d *Dispatcher) Publish(event EventType, payload interface{}) {
+	if d == nil || len(d.endpoints) == 0 {
+		return
+	}
+	var envelope, raw []byte
+	for _, endpoint := range d.endpoints {
+		if !endpoint.Accepts(event) {
+			continue
+		}
+		var err error
+		if endpoint.raw {
+			if raw == nil {
+				raw, err = json.Marshal(payload)
+			}
+		} else if envelope == nil {
+			envelope, err = json.Marshal(Event{
+				Type:      event,
+				Timestamp: time.Now().Unix(),
+				Payload:   payload,
+			})
+		}
+		if err != nil {
+			utils.Logger().Error().Err(err).Str("event", string(event)).
+				Msg("[WebHooks] cannot encode event payload")
+			return
+		}
+		body := envelope
+		if endpoint.raw {
+			body = raw
+		}
+		d.enqueue(&delivery{endpoint: endpoint, event: event, body: body})
+	}
+}
+
+func (d *Dispatcher) enqueue(job *delivery) {
+	select {
+	case <-d.quit:
+		return
+	default:
+	}
+	select {
+	case d.queue <- job:
+	default:
+		atomic.AddUint64(&d.dropped, 1)
+		utils.Logger().Warn().
+			Str("event", string(job.event)).
+			Str("url", job.endpoint.URL).
+			Msg("[WebHooks] delivery queue is full, dropping event")
+	}
+}
+
+func (d *Dispatcher) loop() {
+	defer d.wg.Done()
+	for {
+		select {
+		case <-d.quit:
+			return
+		case job := <-d.queue:
+			if err := d.deliver(job); err != nil {
+				d.retry(job, err)
+			}
+		}
+	}
+}
+
+// retry schedules the delivery again after the backoff of its attempt, until
+// the endpoint retry limit is reached
+func (d *Dispatcher) retry(job *delivery, err error) {
+	maxRetries := defaultMaxRetries
+	if job.endpoint.MaxRetries != nil {
+		maxRetries = *job.endpoint.MaxRetries
+	}
+	logger := utils.Logger().Warn().Err(err).
+		Str("event", string(job.event)).
+		Str("url", job.endpoint.URL).
+		Int("attempt", job.attempt+1)
+	if job.attempt >= maxRetries {
+		logger.Msg("[WebHooks] delivery failed, giving up")
+		return
+	}
+	backoff := d.backoff(job.attempt)
+	logger.Dur("backoff", backoff).Msg("[WebHooks] delivery failed, retrying")
+	job.attempt++
+	time.AfterFunc(backoff, func() { d.enqueue(job) })
+}
+
+func (d *Dispatcher) backoff(attempt int) time.Duration {
+	backoff := d.config.RetryBackoff
+	for i := 0; i < attempt; i++ {
+		backoff *= 2
+		if backoff >= d.config.MaxRetryBackoff {
+			return d.config.MaxRetryBackoff
+		}
+	}
+	return backoff
+}
+
+func (d *Dispatcher) deliver(job *delivery) error {
+	timeout := job.endpoint.Timeout
+	if timeout <= 0 {
+		timeout = defaultTimeout
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, job.endpoint.URL, bytes.NewReader(job.body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(EventHeader, string(job.event))
+	if job.endpoint.Secret != "" {
+		req.Header.Set(SignatureHeader, Sign(job.endpoint.Secret, job.body))
+	}
+	resp, err := d.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	// drain the body so that the connection can be reused
+	io.Copy(io.Discard, resp.Body)
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("unexpected status %s", resp.Status)
+	}
+	return nil
+}
+
+// Sign returns the signature header value of the body for the given secret,
+// the hex encoded HMAC-SHA256 prefixed with the algorithm
+func Sign(secret string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(body)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+var defaultDispatcher atomic.Value
+
+// SetDefaultDispatcher sets the dispatcher used by Publish
+func SetDefaultDispatcher(d *Dispatcher) {
+	defaultDispatcher.Store(d)
+}
+
+// DefaultDispatcher returns the dispatcher used by Publish, nil if unset
+func DefaultDispatcher() *Dispatcher {
+	d, _ := defaultDispatcher.Load().(*Dispatcher)
+	return d
+}
+
+// Publish sends the event through the default dispatcher, if any
+func Publish(event EventType, payload interface{}) {
+	DefaultDispatcher().Publish(event, payload)
+}
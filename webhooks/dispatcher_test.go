@@ -0,0 +1,141 @@
+package webhooks
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+type receivedEvent struct {
+	event     string
+	signature string
+	body      []byte
+}
+
+func newTestServer(t *testing.T, failures int32) (*httptest.Server, chan receivedEvent) {
+	received := make(chan receivedEvent, 16)
+	var calls int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if atomic.AddInt32(&calls, 1) <= failures {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		body, err := io.ReadAll(r.Body)
+		if err != nil {
+			t.Error(err)
+		}
+		received <- receivedEvent{r.Header.Get(EventHeader), r.Header.Get(SignatureHeader), body}
+	}))
+	t.Cleanup(server.Close)
+	return server, received
+}
+
+func waitEvent(t *testing.T, received chan receivedEvent) receivedEvent {
+	select {
+	case ev := <-received:
+		return ev
+	case <-time.After(5 * time.Second):
+		t.Fatal("timeout waiting for webhook delivery")
+	}
+	return receivedEvent{}
+}
+
+func TestDispatcherSignedDelivery(t *testing.T) {
+	server, received := newTestServer(t, 0)
+	d := NewDispatcher(&Hooks{Endpoints: []*Endpoint{{
+		URL:    server.URL,
+		Secret: "secret",
+		Events: []EventType{EventViewChangeStarted},
+	}}})
+	d.Start()
+	defer d.Stop()
+
+	d.Publish(EventLeaderRotated, "filtered")
+	d.Publish(EventViewChangeStarted, map[string]uint64{"view-id": 7})
+
+	ev := waitEvent(t, received)
+	if ev.event != string(EventViewChangeStarted) {
+		t.Fatalf("wrong event header: have %q, want %q", ev.event, EventViewChangeStarted)
+	}
+	if want := Sign("secret", ev.body); ev.signature != want {
+		t.Fatalf("wrong signature: have %q, want %q", ev.signature, want)
+	}
+	var envelope struct {
+		Type    EventType         `json:"type"`
+		Payload map[string]uint64 `json:"payload"`
+	}
+	if err := json.Unmarshal(ev.body, &envelope); err != nil {
+		t.Fatal(err)
+	}
+	if envelope.Type != EventViewChangeStarted || envelope.Payload["view-id"] != 7 {
+		t.Fatalf("wrong envelope: %s", ev.body)
+	}
+	select {
+	case ev := <-received:
+		t.Fatalf("unsubscribed event delivered: %s", ev.body)
+	case <-time.After(100 * time.Millisecond):
+	}
+}
+
+func TestDispatcherRetry(t *testing.T) {
+	server, received := newTestServer(t, 2)
+	d := NewDispatcher(&Hooks{
+		Dispatcher: &DispatcherConfig{RetryBackoff: 10 * time.Millisecond},
+		Endpoints:  []*Endpoint{{URL: server.URL}},
+	})
+	d.Start()
+	defer d.Stop()
+
+	d.Publish(EventOutOfSync, "reason")
+	if ev := waitEvent(t, received); ev.signature != "" {
+		t.Fatalf("unexpected signature without secret: %q", ev.signature)
+	}
+}
+
+func TestDispatcherLegacyHooks(t *testing.T) {
+	server, received := newTestServer(t, 0)
+	d := NewDispatcher(&Hooks{
+		ProtocolIssues: &BadBlockHooks{OnCannotCommit: server.URL},
+	})
+	d.Start()
+	defer d.Stop()
+
+	if d.Subscribed(EventDoubleSign) {
+		t.Fatal("legacy hook subscribed to another event")
+	}
+	d.Publish(EventCannotCommit, map[string]string{"reason": "bad block"})
+	ev := waitEvent(t, received)
+	if string(ev.body) != `{"reason":"bad block"}` {
+		t.Fatalf("legacy hook did not receive the bare payload: %s", ev.body)
+	}
+}
+
+func TestDispatcherQueueFull(t *testing.T) {
+	d := NewDispatcher(&Hooks{
+		Dispatcher: &DispatcherConfig{QueueSize: 2},
+		Endpoints:  []*Endpoint{{URL: "http://localhost:0"}},
+	})
+	// workers are not started, so the queue is never drained
+	for i := 0; i < 5; i++ {
+		d.Publish(EventOutOfSync, i)
+	}
+	if d.Dropped() != 3 {
+		t.Fatalf("wrong number of dropped deliveries: have %d, want 3", d.Dropped())
+	}
+}
+
+func TestDispatcherBackoff(t *testing.T) {
+	d := NewDispatcher(&Hooks{Dispatcher: &DispatcherConfig{
+		RetryBackoff:    time.Second,
+		MaxRetryBackoff: 5 * time.Second,
+	}})
+	for attempt, want := range []time.Duration{time.Second, 2 * time.Second, 4 * time.Second, 5 * time.Second, 5 * time.Second} {
+		if have := d.backoff(attempt); have != want {
+			t.Errorf("attempt %d: have %v, want %v", attempt, have, want)
+		}
+	}
+}
@@ -0,0 +1,35 @@
+package webhooks
+
+// EventType identifies the kind of event carried by a webhook delivery
+type EventType string
+
+// Event types that can be dispatched to webhook endpoints
+const (
+	// EventDoubleSign is fired when consensus notices a double signing validator
+	EventDoubleSign EventType = "double-sign"
+	// EventAvailabilityDropped is fired when a validator drops below the signing threshold
+	EventAvailabilityDropped EventType = "availability-dropped"
+	// EventCannotCommit is fired when a proposed block fails verification
+	EventCannotCommit EventType = "cannot-commit-block"
+	// EventCommitteeElected is fired when the committee of the next epoch is committed
+	EventCommitteeElected EventType = "committee-elected"
+	// EventViewChangeStarted is fired when this node starts a view change
+	EventViewChangeStarted EventType = "view-change-started"
+	// EventViewChangeFinished is fired when a view change completes with a new view
+	EventViewChangeFinished EventType = "view-change-finished"
+	// EventLeaderRotated is fired when the leader rotates to another key
+	EventLeaderRotated EventType = "leader-rotated"
+	// EventValidatorStatusChanged is fired when EPoS changes the status of a validator
+	EventValidatorStatusChanged EventType = "validator-status-changed"
+	// EventSlashingApplied is fired when a committed block applies slashes
+	EventSlashingApplied EventType = "slashing-applied"
+	// EventOutOfSync is fired when consensus falls out of sync and starts syncing
+	EventOutOfSync EventType = "out-of-sync"
+)
+
+// Event is the envelope posted to webhook endpoints
+type Event struct {
+	Type      EventType   `json:"type"`
+	Timestamp int64       `json:"timestamp"`
+	Payload   interface{} `json:"payload"`
+}
@@ -35,6 +35,29 @@ type Hooks struct {
 	Slashing       *DoubleSignWebHooks `yaml:"slashing-hooks"`
 	Availability   *AvailabilityHooks  `yaml:"availability-hooks"`
 	ProtocolIssues *BadBlockHooks      `yaml:"protocol-hooks"`
+	Dispatcher     *DispatcherConfig   `yaml:"dispatcher"`
+	Endpoints      []*Endpoint         `yaml:"endpoints"`
+}
+
+// legacyEndpoints returns the single URL hooks as endpoints, each subscribed
+// to its own event and receiving the bare payload
+func (h *Hooks) legacyEndpoints() []*Endpoint {
+	var endpoints []*Endpoint
+	add := func(url string, event EventType) {
+		if url != "" {
+			endpoints = append(endpoints, &Endpoint{URL: url, Events: []EventType{event}, raw: true})
+		}
+	}
+	if h.Slashing != nil {
+		add(h.Slashing.OnNoticeDoubleSign, EventDoubleSign)
+	}
+	if h.Availability != nil {
+		add(h.Availability.OnDroppedBelowThreshold, EventAvailabilityDropped)
+	}
+	if h.ProtocolIssues != nil {
+		add(h.ProtocolIssues.OnCannotCommit, EventCannotCommit)
+	}
+	return endpoints
 }
 
 // ReportResult ..